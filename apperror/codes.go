@@ -0,0 +1,221 @@
+package apperror
+
+import "net/http"
+
+const (
+	CodeUndefinedUser                                = "undefined_user"
+	CodeUndefinedUserRequest                         = "undefined_user_request"
+	CodeUserRequestHasBeenAlreadyApproved            = "user_request_has_been_already_approved"
+	CodeUndefinedPlayer                              = "undefined_player"
+	CodeUndefinedReceiverPlayer                      = "undefined_receiver_player"
+	CodeUndefinedGame                                = "undefined_game"
+	CodeGameIsFull                                   = "game_is_full"
+	CodeGameIsStarted                                = "game_is_started"
+	CodeGameIsFinished                               = "game_is_finished"
+	CodeGameIsNotStarted                             = "game_is_not_started"
+	CodeGameIsPaused                                 = "game_is_paused"
+	CodeGameIsNotPaused                              = "game_is_not_paused"
+	CodeUndefinedLobby                               = "undefined_lobby"
+	CodeCannotCreatedRace                            = "cannot_created_race"
+	CodeCannotTakeBigDeals                           = "cannot_take_big_deals"
+	CodeForbidden                                    = "forbidden"
+	CodeProcessFailed                                = "process_failed"
+	CodeIsNotValidCountValue                         = "is_not_valid_count_value"
+	CodeIsNotValidRealEstate                         = "is_not_valid_real_estate"
+	CodeIsNotValidBusiness                           = "is_not_valid_business"
+	CodeIsNotValidOtherAssets                        = "is_not_valid_other_assets"
+	CodeInvalidTypeOfCard                            = "invalid_type_of_card"
+	CodeWrongAmount                                  = "wrong_amount"
+	CodeTooManyAssets                                = "too_many_assets"
+	CodeNotFoundAssets                               = "not_found_assets"
+	CodeNotEnoughMoney                               = "not_enough_money"
+	CodeNotFoundStocks                               = "not_found_stocks"
+	CodeNotEnoughStocks                              = "not_enough_stocks"
+	CodeUndefinedProfession                          = "undefined_profession"
+	CodeInvalidCard                                  = "invalid_card"
+	CodeDreamPlaceHasAlreadyTaken                    = "dream_place_has_already_taken"
+	CodeNotEnoughAsset                               = "not_enough_asset"
+	CodePermissionDenied                             = "permission_denied"
+	CodeTransactionDeclined                          = "transaction_declined"
+	CodeWrongAmountForTakingLoan                     = "wrong_amount_for_taking_loan"
+	CodeWrongAmountForPayingLoan                     = "wrong_amount_for_paying_loan"
+	CodeCommonPassiveIncomeGreaterThanCashFlowOfCard = "common_passive_income_greater_than_cash_flow_of_the_card"
+	CodeYouHaveNoProperties                          = "you_have_no_properties"
+	CodeNotSuitableBuilding                          = "not_suitable_building"
+	CodeLimitedPartnership                           = "limited_partnership"
+	CodeIncorrectCount                               = "incorrect_count"
+	CodeCardsNotFound                                = "cards_not_found"
+	CodeProfessionsNotFound                          = "professions_not_found"
+	CodeNotFoundTheRealEstate                        = "not_found_the_real_estate"
+	CodeNotFoundTheBusiness                          = "not_found_the_business"
+	CodeNotFoundTheAsset                             = "not_found_the_asset"
+	CodeTransactionAlreadyExists                     = "transaction_already_exists"
+	CodeItsNotYourMoveNow                            = "its_not_your_move_now"
+	CodeInsufficientPlayers                          = "insufficient_players"
+	CodeMovingBigRaceDeclined                        = "moving_big_race_declined"
+	CodeYouAreBankrupt                               = "you_are_bankrupt"
+	CodeForbiddenByOwner                             = "forbidden_by_owner"
+	CodeIfHadBeenInsurance                           = "if_had_been_insurance"
+	CodeUserIsNotOnWaitList                          = "user_is_not_on_wait_list"
+	CodeEmptyMessage                                 = "empty_message"
+	CodeMessageIsTooLong                             = "message_is_too_long"
+	CodeUndefinedMessage                             = "undefined_message"
+	CodeIncorrectReaction                            = "incorrect_reaction"
+	CodeUndefinedTradeOffer                          = "undefined_trade_offer"
+	CodeTradeOfferIsNotPending                       = "trade_offer_is_not_pending"
+	CodeEmptyTradeOffer                              = "empty_trade_offer"
+	CodeCannotTradeWithYourself                      = "cannot_trade_with_yourself"
+	CodeAssetAlreadyOwned                            = "asset_already_owned"
+	CodeUndefinedAuction                             = "undefined_auction"
+	CodeAuctionIsFinished                            = "auction_is_finished"
+	CodeBidIsTooLow                                  = "bid_is_too_low"
+	CodeBadRequest                                   = "bad_request"
+	CodeValidationFailed                             = "validation_failed"
+	CodeIdempotencyKeyInUse                          = "idempotency_key_in_use"
+	CodeIdempotencyKeyReused                         = "idempotency_key_reused"
+	CodeTooManyRequests                              = "too_many_requests"
+	CodeLoanLimitExceeded                            = "loan_limit_exceeded"
+	CodePartialRepaymentNotAllowed                   = "partial_repayment_not_allowed"
+	CodeUndefinedStockSymbol                         = "undefined_stock_symbol"
+	CodeUndefinedSharedAsset                         = "undefined_shared_asset"
+	CodeUndefinedBuyout                              = "undefined_buyout"
+	CodeBuyoutIsNotPending                           = "buyout_is_not_pending"
+	CodeNotAPartner                                  = "not_a_partner"
+	CodeUndefinedInsurance                           = "undefined_insurance"
+	CodeInsuranceAlreadyActive                       = "insurance_already_active"
+	CodeManagerModeDisabled                          = "manager_mode_disabled"
+	CodeUndefinedDeck                                = "undefined_deck"
+	CodeDeckCannotBeEmpty                            = "deck_cannot_be_empty"
+	CodeUserRequestIsNotPending                      = "user_request_is_not_pending"
+	CodeUndefinedUserRequestType                     = "undefined_user_request_type"
+	CodeInvalidUserRequestStatus                     = "invalid_user_request_status"
+	CodeUndefinedNotification                        = "undefined_notification"
+	CodeUndefinedRaceTemplate                        = "undefined_race_template"
+	CodeUnsupportedLanguage                          = "unsupported_language"
+	CodeUndefinedBigRaceRule                         = "undefined_big_race_rule"
+	CodeUndefinedCardCollection                      = "undefined_card_collection"
+	CodeHandModeDisabled                             = "hand_mode_disabled"
+	CodeInvalidDiceValue                             = "invalid_dice_value"
+	CodeTooManyDice                                  = "too_many_dice"
+	CodeRollAwaitsConfirmation                       = "roll_awaits_confirmation"
+	CodeUndefinedPendingRoll                         = "undefined_pending_roll"
+	CodeTeamModeDisabled                             = "team_mode_disabled"
+	CodeInvalidTeam                                  = "invalid_team"
+	CodeUndefinedTeamDecision                        = "undefined_team_decision"
+	CodeTeamDecisionIsNotPending                     = "team_decision_is_not_pending"
+	CodeUndefinedTournament                          = "undefined_tournament"
+	CodeInvalidTournament                            = "invalid_tournament"
+	CodeTournamentIsStarted                          = "tournament_is_started"
+	CodeTournamentIsFinished                         = "tournament_is_finished"
+	CodeTournamentRoundInProgress                    = "tournament_round_in_progress"
+	CodeUndefinedTournamentTable                     = "undefined_tournament_table"
+)
+
+var (
+	ErrUndefinedUser                                = New(CodeUndefinedUser, http.StatusNotFound)
+	ErrUndefinedUserRequest                         = New(CodeUndefinedUserRequest, http.StatusNotFound)
+	ErrUserRequestHasBeenAlreadyApproved            = New(CodeUserRequestHasBeenAlreadyApproved, http.StatusConflict)
+	ErrUndefinedPlayer                              = New(CodeUndefinedPlayer, http.StatusNotFound)
+	ErrUndefinedReceiverPlayer                      = New(CodeUndefinedReceiverPlayer, http.StatusNotFound)
+	ErrUndefinedGame                                = New(CodeUndefinedGame, http.StatusNotFound)
+	ErrGameIsFull                                   = New(CodeGameIsFull, http.StatusConflict)
+	ErrGameIsStarted                                = New(CodeGameIsStarted, http.StatusConflict)
+	ErrGameIsFinished                               = New(CodeGameIsFinished, http.StatusConflict)
+	ErrGameIsNotStarted                             = New(CodeGameIsNotStarted, http.StatusConflict)
+	ErrGameIsPaused                                 = New(CodeGameIsPaused, http.StatusConflict)
+	ErrGameIsNotPaused                              = New(CodeGameIsNotPaused, http.StatusConflict)
+	ErrUndefinedLobby                               = New(CodeUndefinedLobby, http.StatusNotFound)
+	ErrCannotCreatedRace                            = New(CodeCannotCreatedRace, http.StatusInternalServerError)
+	ErrCannotTakeBigDeals                           = New(CodeCannotTakeBigDeals, http.StatusUnprocessableEntity)
+	ErrForbidden                                    = New(CodeForbidden, http.StatusForbidden)
+	ErrProcessFailed                                = New(CodeProcessFailed, http.StatusInternalServerError)
+	ErrIsNotValidCountValue                         = New(CodeIsNotValidCountValue, http.StatusUnprocessableEntity)
+	ErrIsNotValidRealEstate                         = New(CodeIsNotValidRealEstate, http.StatusUnprocessableEntity)
+	ErrIsNotValidBusiness                           = New(CodeIsNotValidBusiness, http.StatusUnprocessableEntity)
+	ErrIsNotValidOtherAssets                        = New(CodeIsNotValidOtherAssets, http.StatusUnprocessableEntity)
+	ErrInvalidTypeOfCard                            = New(CodeInvalidTypeOfCard, http.StatusUnprocessableEntity)
+	ErrWrongAmount                                  = New(CodeWrongAmount, http.StatusUnprocessableEntity)
+	ErrTooManyAssets                                = New(CodeTooManyAssets, http.StatusUnprocessableEntity)
+	ErrNotFoundAssets                               = New(CodeNotFoundAssets, http.StatusNotFound)
+	ErrNotEnoughMoney                               = New(CodeNotEnoughMoney, http.StatusUnprocessableEntity)
+	ErrNotFoundStocks                               = New(CodeNotFoundStocks, http.StatusNotFound)
+	ErrNotEnoughStocks                              = New(CodeNotEnoughStocks, http.StatusUnprocessableEntity)
+	ErrUndefinedProfession                          = New(CodeUndefinedProfession, http.StatusNotFound)
+	ErrInvalidCard                                  = New(CodeInvalidCard, http.StatusUnprocessableEntity)
+	ErrDreamPlaceHasAlreadyTaken                    = New(CodeDreamPlaceHasAlreadyTaken, http.StatusConflict)
+	ErrNotEnoughAsset                               = New(CodeNotEnoughAsset, http.StatusUnprocessableEntity)
+	ErrPermissionDenied                             = New(CodePermissionDenied, http.StatusForbidden)
+	ErrTransactionDeclined                          = New(CodeTransactionDeclined, http.StatusUnprocessableEntity)
+	ErrWrongAmountForTakingLoan                     = New(CodeWrongAmountForTakingLoan, http.StatusUnprocessableEntity)
+	ErrWrongAmountForPayingLoan                     = New(CodeWrongAmountForPayingLoan, http.StatusUnprocessableEntity)
+	ErrCommonPassiveIncomeGreaterThanCashFlowOfCard = New(CodeCommonPassiveIncomeGreaterThanCashFlowOfCard, http.StatusUnprocessableEntity)
+	ErrYouHaveNoProperties                          = New(CodeYouHaveNoProperties, http.StatusUnprocessableEntity)
+	ErrNotSuitableBuilding                          = New(CodeNotSuitableBuilding, http.StatusUnprocessableEntity)
+	ErrLimitedPartnership                           = New(CodeLimitedPartnership, http.StatusUnprocessableEntity)
+	ErrIncorrectCount                               = New(CodeIncorrectCount, http.StatusUnprocessableEntity)
+	ErrCardsNotFound                                = New(CodeCardsNotFound, http.StatusNotFound)
+	ErrProfessionsNotFound                          = New(CodeProfessionsNotFound, http.StatusNotFound)
+	ErrNotFoundTheRealEstate                        = New(CodeNotFoundTheRealEstate, http.StatusNotFound)
+	ErrNotFoundTheBusiness                          = New(CodeNotFoundTheBusiness, http.StatusNotFound)
+	ErrNotFoundTheAsset                             = New(CodeNotFoundTheAsset, http.StatusNotFound)
+	ErrTransactionAlreadyExists                     = New(CodeTransactionAlreadyExists, http.StatusConflict)
+	ErrItsNotYourMoveNow                            = New(CodeItsNotYourMoveNow, http.StatusConflict)
+	ErrInsufficientPlayers                          = New(CodeInsufficientPlayers, http.StatusConflict)
+	ErrMovingBigRaceDeclined                        = New(CodeMovingBigRaceDeclined, http.StatusConflict)
+	ErrYouAreBankrupt                               = New(CodeYouAreBankrupt, http.StatusConflict)
+	ErrForbiddenByOwner                             = New(CodeForbiddenByOwner, http.StatusForbidden)
+	ErrIfHadBeenInsurance                           = New(CodeIfHadBeenInsurance, http.StatusUnprocessableEntity)
+	ErrUserIsNotOnWaitList                          = New(CodeUserIsNotOnWaitList, http.StatusConflict)
+	ErrEmptyMessage                                 = New(CodeEmptyMessage, http.StatusUnprocessableEntity)
+	ErrMessageIsTooLong                             = New(CodeMessageIsTooLong, http.StatusUnprocessableEntity)
+	ErrUndefinedMessage                             = New(CodeUndefinedMessage, http.StatusNotFound)
+	ErrIncorrectReaction                            = New(CodeIncorrectReaction, http.StatusUnprocessableEntity)
+	ErrUndefinedTradeOffer                          = New(CodeUndefinedTradeOffer, http.StatusNotFound)
+	ErrTradeOfferIsNotPending                       = New(CodeTradeOfferIsNotPending, http.StatusConflict)
+	ErrEmptyTradeOffer                              = New(CodeEmptyTradeOffer, http.StatusUnprocessableEntity)
+	ErrCannotTradeWithYourself                      = New(CodeCannotTradeWithYourself, http.StatusUnprocessableEntity)
+	ErrAssetAlreadyOwned                            = New(CodeAssetAlreadyOwned, http.StatusConflict)
+	ErrUndefinedAuction                             = New(CodeUndefinedAuction, http.StatusNotFound)
+	ErrAuctionIsFinished                            = New(CodeAuctionIsFinished, http.StatusConflict)
+	ErrBidIsTooLow                                  = New(CodeBidIsTooLow, http.StatusUnprocessableEntity)
+	ErrBadRequest                                   = New(CodeBadRequest, http.StatusBadRequest)
+	ErrValidationFailed                             = New(CodeValidationFailed, http.StatusUnprocessableEntity)
+	ErrIdempotencyKeyInUse                          = New(CodeIdempotencyKeyInUse, http.StatusConflict)
+	ErrIdempotencyKeyReused                         = New(CodeIdempotencyKeyReused, http.StatusUnprocessableEntity)
+	ErrTooManyRequests                              = New(CodeTooManyRequests, http.StatusTooManyRequests)
+	ErrLoanLimitExceeded                            = New(CodeLoanLimitExceeded, http.StatusUnprocessableEntity)
+	ErrPartialRepaymentNotAllowed                   = New(CodePartialRepaymentNotAllowed, http.StatusUnprocessableEntity)
+	ErrUndefinedStockSymbol                         = New(CodeUndefinedStockSymbol, http.StatusNotFound)
+	ErrUndefinedSharedAsset                         = New(CodeUndefinedSharedAsset, http.StatusNotFound)
+	ErrUndefinedBuyout                              = New(CodeUndefinedBuyout, http.StatusNotFound)
+	ErrBuyoutIsNotPending                           = New(CodeBuyoutIsNotPending, http.StatusConflict)
+	ErrNotAPartner                                  = New(CodeNotAPartner, http.StatusForbidden)
+	ErrUndefinedInsurance                           = New(CodeUndefinedInsurance, http.StatusNotFound)
+	ErrInsuranceAlreadyActive                       = New(CodeInsuranceAlreadyActive, http.StatusConflict)
+	ErrManagerModeDisabled                          = New(CodeManagerModeDisabled, http.StatusConflict)
+	ErrUndefinedDeck                                = New(CodeUndefinedDeck, http.StatusNotFound)
+	ErrDeckCannotBeEmpty                            = New(CodeDeckCannotBeEmpty, http.StatusUnprocessableEntity)
+	ErrUserRequestIsNotPending                      = New(CodeUserRequestIsNotPending, http.StatusConflict)
+	ErrUndefinedUserRequestType                     = New(CodeUndefinedUserRequestType, http.StatusUnprocessableEntity)
+	ErrInvalidUserRequestStatus                     = New(CodeInvalidUserRequestStatus, http.StatusUnprocessableEntity)
+	ErrUndefinedNotification                        = New(CodeUndefinedNotification, http.StatusNotFound)
+	ErrUndefinedRaceTemplate                        = New(CodeUndefinedRaceTemplate, http.StatusNotFound)
+	ErrUnsupportedLanguage                          = New(CodeUnsupportedLanguage, http.StatusUnprocessableEntity)
+	ErrUndefinedBigRaceRule                         = New(CodeUndefinedBigRaceRule, http.StatusUnprocessableEntity)
+	ErrUndefinedCardCollection                      = New(CodeUndefinedCardCollection, http.StatusUnprocessableEntity)
+	ErrHandModeDisabled                             = New(CodeHandModeDisabled, http.StatusConflict)
+	ErrInvalidDiceValue                             = New(CodeInvalidDiceValue, http.StatusUnprocessableEntity)
+	ErrTooManyDice                                  = New(CodeTooManyDice, http.StatusUnprocessableEntity)
+	ErrRollAwaitsConfirmation                       = New(CodeRollAwaitsConfirmation, http.StatusConflict)
+	ErrUndefinedPendingRoll                         = New(CodeUndefinedPendingRoll, http.StatusNotFound)
+	ErrTeamModeDisabled                             = New(CodeTeamModeDisabled, http.StatusConflict)
+	ErrInvalidTeam                                  = New(CodeInvalidTeam, http.StatusUnprocessableEntity)
+	ErrUndefinedTeamDecision                        = New(CodeUndefinedTeamDecision, http.StatusNotFound)
+	ErrTeamDecisionIsNotPending                     = New(CodeTeamDecisionIsNotPending, http.StatusConflict)
+	ErrUndefinedTournament                          = New(CodeUndefinedTournament, http.StatusNotFound)
+	ErrInvalidTournament                            = New(CodeInvalidTournament, http.StatusUnprocessableEntity)
+	ErrTournamentIsStarted                          = New(CodeTournamentIsStarted, http.StatusConflict)
+	ErrTournamentIsFinished                         = New(CodeTournamentIsFinished, http.StatusConflict)
+	ErrTournamentRoundInProgress                    = New(CodeTournamentRoundInProgress, http.StatusConflict)
+	ErrUndefinedTournamentTable                     = New(CodeUndefinedTournamentTable, http.StatusNotFound)
+)
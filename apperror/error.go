@@ -0,0 +1,90 @@
+package apperror
+
+import (
+	"encoding/json"
+	"errors"
+	"github.com/go-playground/validator/v10"
+	"net/http"
+)
+
+type Details map[string]interface{}
+
+// Error is a domain error with a stable machine-readable code. The code doubles as the i18n catalog key
+// of its message, so clients may rely on the code while showing the localized message.
+type Error struct {
+	Code    string
+	Status  int
+	Details Details
+}
+
+func New(code string, status int) *Error {
+	return &Error{
+		Code:   code,
+		Status: status,
+	}
+}
+
+func (e *Error) Error() string {
+	return e.Code
+}
+
+// Is matches errors by code, so copies created by WithDetails still match their sentinel.
+func (e *Error) Is(target error) bool {
+	var appErr *Error
+
+	if !errors.As(target, &appErr) {
+		return false
+	}
+
+	return e.Code == appErr.Code
+}
+
+// WithDetails returns a copy of the error carrying the details, the sentinel itself stays untouched.
+func (e *Error) WithDetails(details Details) *Error {
+	return &Error{
+		Code:    e.Code,
+		Status:  e.Status,
+		Details: details,
+	}
+}
+
+// From converts any error into a domain error: request binding failures become validation errors,
+// everything unknown is reported as a bad request with the original message in the details.
+func From(err error) *Error {
+	if err == nil {
+		return nil
+	}
+
+	var appErr *Error
+
+	if errors.As(err, &appErr) {
+		return appErr
+	}
+
+	var validationErrors validator.ValidationErrors
+
+	if errors.As(err, &validationErrors) {
+		fields := Details{}
+
+		for _, fieldError := range validationErrors {
+			fields[fieldError.Field()] = fieldError.Tag()
+		}
+
+		return ErrValidationFailed.WithDetails(Details{
+			"fields": fields,
+		})
+	}
+
+	var syntaxError *json.SyntaxError
+	var typeError *json.UnmarshalTypeError
+
+	if errors.As(err, &syntaxError) || errors.As(err, &typeError) {
+		return ErrValidationFailed.WithDetails(Details{
+			"error": err.Error(),
+		})
+	}
+
+	return New(CodeBadRequest, http.StatusBadRequest).WithDetails(Details{
+		"error": err.Error(),
+	})
+}
@@ -0,0 +1,42 @@
+package apperror_test
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"github.com/webjohny/cashflow-go/apperror"
+	"net/http"
+	"testing"
+)
+
+func TestStatuses(t *testing.T) {
+	assert.Equal(t, http.StatusNotFound, apperror.ErrUndefinedGame.Status)
+	assert.Equal(t, http.StatusNotFound, apperror.ErrUndefinedPlayer.Status)
+	assert.Equal(t, http.StatusForbidden, apperror.ErrPermissionDenied.Status)
+	assert.Equal(t, http.StatusConflict, apperror.ErrTransactionAlreadyExists.Status)
+	assert.Equal(t, http.StatusUnprocessableEntity, apperror.ErrNotEnoughMoney.Status)
+}
+
+func TestIs(t *testing.T) {
+	err := fmt.Errorf("wrapped: %w", apperror.ErrNotEnoughMoney.WithDetails(apperror.Details{"amount": 100}))
+
+	assert.True(t, errors.Is(err, apperror.ErrNotEnoughMoney))
+	assert.False(t, errors.Is(err, apperror.ErrWrongAmount))
+	assert.Nil(t, apperror.ErrNotEnoughMoney.Details)
+}
+
+func TestFrom(t *testing.T) {
+	assert.Nil(t, apperror.From(nil))
+	assert.Equal(t, apperror.ErrUndefinedGame, apperror.From(apperror.ErrUndefinedGame))
+
+	var body struct{ Amount int }
+	syntaxErr := json.Unmarshal([]byte("{"), &body)
+	assert.Equal(t, apperror.CodeValidationFailed, apperror.From(syntaxErr).Code)
+	assert.Equal(t, http.StatusUnprocessableEntity, apperror.From(syntaxErr).Status)
+
+	unknown := apperror.From(errors.New("record not found"))
+	assert.Equal(t, apperror.CodeBadRequest, unknown.Code)
+	assert.Equal(t, http.StatusBadRequest, unknown.Status)
+	assert.Equal(t, "record not found", unknown.Details["error"])
+}
@@ -42,7 +42,7 @@ func SetupDatabaseConnection() *gorm.DB {
 	}
 
 	//Isi model / table disini
-	db.AutoMigrate(&entity.User{}, &entity.UserRequest{}, &entity.Race{}, &entity.Lobby{}, &entity.Player{}, &entity.Transaction{})
+	db.AutoMigrate(&entity.User{}, &entity.UserRequest{}, &entity.Race{}, &entity.Lobby{}, &entity.Player{}, &entity.Transaction{}, &entity.ChatMessage{}, &entity.TradeOffer{}, &entity.IdempotencyKey{}, &entity.SharedAsset{}, &entity.ModeratorAudit{}, &entity.Notification{}, &entity.NotificationReceipt{}, &entity.RaceTemplate{}, &entity.TeamMember{}, &entity.TeamDecision{}, &entity.Tournament{}, &entity.TournamentTable{})
 	return db
 }
 
@@ -0,0 +1,88 @@
+package controller
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/webjohny/cashflow-go/dto"
+	"github.com/webjohny/cashflow-go/entity"
+	"github.com/webjohny/cashflow-go/helper"
+	"github.com/webjohny/cashflow-go/objects"
+	"github.com/webjohny/cashflow-go/request"
+	"github.com/webjohny/cashflow-go/service"
+	"time"
+)
+
+type AuctionController interface {
+	GetAuction(ctx *gin.Context)
+	Bid(ctx *gin.Context)
+	Close(ctx *gin.Context)
+}
+
+type auctionController struct {
+	auctionService service.AuctionService
+	mutex          *objects.MutexMap
+}
+
+func NewAuctionController(auctionService service.AuctionService) AuctionController {
+	return &auctionController{
+		auctionService: auctionService,
+		mutex:          &objects.MutexMap{},
+	}
+}
+
+func (c *auctionController) GetAuction(ctx *gin.Context) {
+	raceId := helper.GetRaceId(ctx)
+	userId := helper.GetUserId(ctx)
+
+	var err error
+	var auction *entity.RaceAuction
+
+	if raceId != 0 && userId != 0 {
+		err, auction = c.auctionService.GetAuction(raceId, userId)
+	}
+
+	request.FinalResponse(ctx, err, auction)
+}
+
+func (c *auctionController) Bid(ctx *gin.Context) {
+	raceId := helper.GetRaceId(ctx)
+	userId := helper.GetUserId(ctx)
+
+	if !c.mutex.LockMethodRace("AuctionBid", raceId, time.Second) {
+		request.TooManyRequests(ctx)
+		return
+	}
+
+	var err error
+	var body dto.AuctionBidBodyDTO
+	var auction *entity.RaceAuction
+
+	if err = ctx.ShouldBindJSON(&body); err != nil {
+		request.FinalResponse(ctx, err, nil)
+		return
+	}
+
+	if raceId != 0 && userId != 0 {
+		err, auction = c.auctionService.Bid(raceId, userId, body)
+	}
+
+	request.FinalResponse(ctx, err, auction)
+}
+
+func (c *auctionController) Close(ctx *gin.Context) {
+	raceId := helper.GetRaceId(ctx)
+	userId := helper.GetUserId(ctx)
+
+	if !c.mutex.LockMethodRace("AuctionBid", raceId, time.Second) {
+		request.TooManyRequests(ctx)
+		return
+	}
+
+	var err error
+	var auction *entity.RaceAuction
+
+	if raceId != 0 && userId != 0 {
+		err, auction = c.auctionService.Close(raceId, userId)
+	}
+
+	request.FinalResponse(ctx, err, auction)
+}
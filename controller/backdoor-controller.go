@@ -36,7 +36,7 @@ func (c *backdoorController) ChangeCard(ctx *gin.Context) {
 
 	var body dto.BackdoorCardBodyDTO
 
-	if err = ctx.BindJSON(&body); err != nil {
+	if err = ctx.ShouldBindJSON(&body); err != nil {
 		request.FinalResponse(ctx, err, nil)
 		return
 	}
@@ -44,7 +44,7 @@ func (c *backdoorController) ChangeCard(ctx *gin.Context) {
 	race := c.raceService.GetRaceByRaceId(raceId)
 	race.CurrentCard = body.Card
 
-	err = c.cardService.ProcessCard(race)
+	err = c.cardService.ProcessCard(&race)
 
 	if race.CurrentCard.Family == "market" || race.CurrentCard.Type == "stock" {
 		race.IsMultiFlow = race.CurrentCard.OnlyYou == false
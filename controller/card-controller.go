@@ -1,14 +1,13 @@
 package controller
 
 import (
-	"errors"
 	"github.com/gin-gonic/gin"
+	"github.com/webjohny/cashflow-go/apperror"
 	"github.com/webjohny/cashflow-go/dto"
 	"github.com/webjohny/cashflow-go/helper"
 	"github.com/webjohny/cashflow-go/objects"
 	"github.com/webjohny/cashflow-go/request"
 	"github.com/webjohny/cashflow-go/service"
-	"github.com/webjohny/cashflow-go/storage"
 	"time"
 )
 
@@ -26,12 +25,14 @@ type CardController interface {
 
 type cardController struct {
 	cardService service.CardService
+	teamService service.TeamService
 	mutex       *objects.MutexMap
 }
 
-func NewCardController(cardService service.CardService) CardController {
+func NewCardController(cardService service.CardService, teamService service.TeamService) CardController {
 	return &cardController{
 		cardService: cardService,
+		teamService: teamService,
 		mutex:       &objects.MutexMap{},
 	}
 }
@@ -51,9 +52,9 @@ func (c *cardController) Type(ctx *gin.Context) {
 	var response interface{}
 
 	if userId == 0 {
-		err = errors.New(storage.ErrorUndefinedPlayer)
+		err = apperror.ErrUndefinedPlayer
 	} else if raceId == 0 {
-		err = errors.New(storage.ErrorUndefinedGame)
+		err = apperror.ErrUndefinedGame
 	} else {
 		err, response = c.cardService.GetCard("", raceId, userId, cardType)
 	}
@@ -70,9 +71,9 @@ func (c *cardController) TestCard(ctx *gin.Context) {
 	var response interface{}
 
 	if userId == 0 {
-		err = errors.New(storage.ErrorUndefinedPlayer)
+		err = apperror.ErrUndefinedPlayer
 	} else if raceId == 0 {
-		err = errors.New(storage.ErrorUndefinedGame)
+		err = apperror.ErrUndefinedGame
 	} else {
 		err, response = c.cardService.TestCard(ctx.Param("action"), raceId, userId, bigRace)
 	}
@@ -93,7 +94,7 @@ func (c *cardController) SetCards(ctx *gin.Context) {
 
 	var body dto.CreateCardsDTO
 
-	if err = ctx.BindJSON(&body); err != nil {
+	if err = ctx.ShouldBindJSON(&body); err != nil {
 		request.FinalResponse(ctx, err, nil)
 		return
 	}
@@ -114,15 +115,15 @@ func (c *cardController) Prepare(ctx *gin.Context) {
 	var response interface{}
 	var body dto.PrepareCardBodyDTO
 
-	if err = ctx.BindJSON(&body); err != nil {
+	if err = ctx.ShouldBindJSON(&body); err != nil {
 		request.FinalResponse(ctx, err, nil)
 		return
 	}
 
 	if raceId == 0 {
-		err = errors.New(storage.ErrorUndefinedGame)
+		err = apperror.ErrUndefinedGame
 	} else if userId == 0 {
-		err = errors.New(storage.ErrorUndefinedPlayer)
+		err = apperror.ErrUndefinedPlayer
 	} else {
 		err, response = c.cardService.Prepare(actionType, raceId, family, userId, body)
 	}
@@ -144,7 +145,7 @@ func (c *cardController) Selling(ctx *gin.Context) {
 
 	var body dto.CardSellingActionDTO
 
-	if err := ctx.BindJSON(&body); err != nil {
+	if err := ctx.ShouldBindJSON(&body); err != nil {
 		request.FinalResponse(ctx, err, nil)
 		return
 	}
@@ -153,11 +154,11 @@ func (c *cardController) Selling(ctx *gin.Context) {
 	var response interface{}
 
 	if raceId == 0 {
-		err = errors.New(storage.ErrorUndefinedGame)
+		err = apperror.ErrUndefinedGame
 	} else if userId == 0 {
-		err = errors.New(storage.ErrorUndefinedUser)
+		err = apperror.ErrUndefinedUser
 	} else {
-		err, response = c.cardService.Selling(actionType, raceId, userId, bigRace, body)
+		err, response = c.teamService.Selling(actionType, raceId, userId, bigRace, body)
 	}
 
 	request.FinalResponse(ctx, err, response)
@@ -180,9 +181,9 @@ func (c *cardController) Accept(ctx *gin.Context) {
 	var response interface{}
 
 	if raceId == 0 {
-		err = errors.New(storage.ErrorUndefinedGame)
+		err = apperror.ErrUndefinedGame
 	} else if userId == 0 {
-		err = errors.New(storage.ErrorUndefinedUser)
+		err = apperror.ErrUndefinedUser
 	} else {
 		err, response = c.cardService.Accept(actionType, raceId, family, userId, bigRace)
 	}
@@ -204,9 +205,9 @@ func (c *cardController) Skip(ctx *gin.Context) {
 	var response interface{}
 
 	if raceId == 0 {
-		err = errors.New(storage.ErrorUndefinedGame)
+		err = apperror.ErrUndefinedGame
 	} else if userId == 0 {
-		err = errors.New(storage.ErrorUndefinedUser)
+		err = apperror.ErrUndefinedUser
 	} else {
 		err, response = c.cardService.Skip(raceId, userId, bigRace)
 	}
@@ -228,7 +229,7 @@ func (c *cardController) Purchase(ctx *gin.Context) {
 
 	var body dto.CardPurchaseActionDTO
 
-	if err := ctx.BindJSON(&body); err != nil {
+	if err := ctx.ShouldBindJSON(&body); err != nil {
 		request.FinalResponse(ctx, err, nil)
 		return
 	}
@@ -236,11 +237,11 @@ func (c *cardController) Purchase(ctx *gin.Context) {
 	var err error
 	var response interface{}
 	if raceId == 0 {
-		err = errors.New(storage.ErrorUndefinedGame)
+		err = apperror.ErrUndefinedGame
 	} else if userId == 0 {
-		err = errors.New(storage.ErrorUndefinedUser)
+		err = apperror.ErrUndefinedUser
 	} else {
-		err, response = c.cardService.Purchase(actionType, raceId, userId, bigRace, body)
+		err, response = c.teamService.Purchase(actionType, raceId, userId, bigRace, body)
 	}
 
 	request.FinalResponse(ctx, err, response)
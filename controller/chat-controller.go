@@ -0,0 +1,99 @@
+package controller
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/webjohny/cashflow-go/dto"
+	"github.com/webjohny/cashflow-go/entity"
+	"github.com/webjohny/cashflow-go/helper"
+	"github.com/webjohny/cashflow-go/request"
+	"github.com/webjohny/cashflow-go/service"
+	"strconv"
+)
+
+type ChatController interface {
+	GetMessages(ctx *gin.Context)
+	Send(ctx *gin.Context)
+	React(ctx *gin.Context)
+	Delete(ctx *gin.Context)
+}
+
+type chatController struct {
+	chatService service.ChatService
+}
+
+func NewChatController(chatService service.ChatService) ChatController {
+	return &chatController{
+		chatService: chatService,
+	}
+}
+
+func (c *chatController) GetMessages(ctx *gin.Context) {
+	raceId := helper.GetRaceId(ctx)
+	userId := helper.GetUserId(ctx)
+	cursor, _ := strconv.ParseUint(ctx.Query("cursor"), 10, 64)
+	limit, _ := strconv.Atoi(ctx.Query("limit"))
+
+	var err error
+	var response interface{}
+
+	if raceId != 0 && userId != 0 {
+		err, response = c.chatService.GetMessages(raceId, userId, cursor, limit)
+	}
+
+	request.FinalResponse(ctx, err, response)
+}
+
+func (c *chatController) Send(ctx *gin.Context) {
+	raceId := helper.GetRaceId(ctx)
+	userId := helper.GetUserId(ctx)
+
+	var err error
+	var body dto.SendChatMessageBodyDTO
+	var message entity.ChatMessage
+
+	if err = ctx.ShouldBindJSON(&body); err != nil {
+		request.FinalResponse(ctx, err, nil)
+		return
+	}
+
+	if raceId != 0 && userId != 0 {
+		err, message = c.chatService.Send(raceId, userId, body.Message)
+	}
+
+	request.FinalResponse(ctx, err, message)
+}
+
+func (c *chatController) React(ctx *gin.Context) {
+	raceId := helper.GetRaceId(ctx)
+	userId := helper.GetUserId(ctx)
+	messageId, _ := strconv.ParseUint(ctx.Param("messageId"), 10, 64)
+
+	var err error
+	var body dto.ReactChatMessageBodyDTO
+	var message entity.ChatMessage
+
+	if err = ctx.ShouldBindJSON(&body); err != nil {
+		request.FinalResponse(ctx, err, nil)
+		return
+	}
+
+	if raceId != 0 && userId != 0 {
+		err, message = c.chatService.React(raceId, userId, messageId, body.Emoji)
+	}
+
+	request.FinalResponse(ctx, err, message)
+}
+
+func (c *chatController) Delete(ctx *gin.Context) {
+	raceId := helper.GetRaceId(ctx)
+	userId := helper.GetUserId(ctx)
+	messageId, _ := strconv.ParseUint(ctx.Param("messageId"), 10, 64)
+
+	var err error
+
+	if raceId != 0 && userId != 0 {
+		err = c.chatService.Delete(raceId, userId, messageId)
+	}
+
+	request.FinalResponse(ctx, err, nil)
+}
@@ -15,6 +15,12 @@ type FinanceController interface {
 	SendMoney(ctx *gin.Context)
 	SendAssets(ctx *gin.Context)
 	TakeLoan(ctx *gin.Context)
+	PayLoan(ctx *gin.Context)
+	GetLoans(ctx *gin.Context)
+	GetBalanceSheet(ctx *gin.Context)
+	GetInsurances(ctx *gin.Context)
+	BuyInsurance(ctx *gin.Context)
+	CancelInsurance(ctx *gin.Context)
 	AskMoney(ctx *gin.Context)
 }
 
@@ -46,14 +52,14 @@ func (c *financeController) SendMoney(ctx *gin.Context) {
 	errDTO := ctx.ShouldBind(&sendMoneyBodyDTO)
 
 	if errDTO != nil {
-		response = request.BuildErrorResponse("Failed to process request", errDTO.Error(), request.EmptyObj{})
+		err = errDTO
 	} else if raceId != 0 && userId != 0 {
 		if sendMoneyBodyDTO.Player == "bankLoan" {
-			err = c.financeService.PayLoan(raceId, userId, sendMoneyBodyDTO.Amount)
+			err = c.financeService.PayLoan(raceId, userId, "bankLoan", sendMoneyBodyDTO.Amount)
 		} else if sendMoneyBodyDTO.Player == "tax" {
 			err = c.financeService.PayTax(raceId, userId, sendMoneyBodyDTO.Amount)
 		} else {
-			err = c.financeService.SendMoney(raceId, userId, sendMoneyBodyDTO.Amount, sendMoneyBodyDTO.Player)
+			err = c.financeService.SendMoney(raceId, userId, sendMoneyBodyDTO.Amount, sendMoneyBodyDTO.Player, helper.GetIdempotencyKey(ctx))
 		}
 	}
 
@@ -77,7 +83,7 @@ func (c *financeController) AskMoney(ctx *gin.Context) {
 	errDTO := ctx.ShouldBind(&askMoneyBodyDTO)
 
 	if errDTO != nil {
-		response = request.BuildErrorResponse("Failed to process request", errDTO.Error(), request.EmptyObj{})
+		err = errDTO
 	} else if raceId != 0 && userId != 0 {
 		err, result = c.financeService.AskMoney(raceId, userId, askMoneyBodyDTO)
 
@@ -108,9 +114,9 @@ func (c *financeController) SendAssets(ctx *gin.Context) {
 	errDTO := ctx.ShouldBind(&sendAssetsBodyDTO)
 
 	if errDTO != nil {
-		response = request.BuildErrorResponse("Failed to process request", errDTO.Error(), request.EmptyObj{})
+		err = errDTO
 	} else if raceId != 0 && userId != 0 {
-		err = c.financeService.SendAssets(raceId, userId, sendAssetsBodyDTO)
+		err = c.financeService.SendAssets(raceId, userId, sendAssetsBodyDTO, helper.GetIdempotencyKey(ctx))
 	}
 
 	request.FinalResponse(ctx, err, response)
@@ -127,10 +133,113 @@ func (c *financeController) TakeLoan(ctx *gin.Context) {
 	errDTO := ctx.ShouldBind(&takeLoanBodyDTO)
 
 	if errDTO != nil {
-		response = request.BuildErrorResponse("Failed to process request", errDTO.Error(), request.EmptyObj{})
+		err = errDTO
 	} else if raceId != 0 && userId != 0 {
 		err = c.financeService.TakeLoan(raceId, userId, takeLoanBodyDTO.Amount)
 	}
 
 	request.FinalResponse(ctx, err, response)
 }
+
+func (c *financeController) PayLoan(ctx *gin.Context) {
+	raceId := helper.GetRaceId(ctx)
+	userId := helper.GetUserId(ctx)
+
+	if !c.mutex.LockMethodRace("PayLoan", raceId, time.Second) {
+		request.TooManyRequests(ctx)
+		return
+	}
+
+	var body dto.PayLoanBodyDTO
+	var err error
+
+	if err = ctx.ShouldBindJSON(&body); err != nil {
+		request.FinalResponse(ctx, err, nil)
+		return
+	}
+
+	if raceId != 0 && userId != 0 {
+		err = c.financeService.PayLoan(raceId, userId, body.Liability, body.Amount)
+	}
+
+	request.FinalResponse(ctx, err, nil)
+}
+
+func (c *financeController) GetLoans(ctx *gin.Context) {
+	raceId := helper.GetRaceId(ctx)
+	userId := helper.GetUserId(ctx)
+
+	var err error
+	var response interface{}
+
+	if raceId != 0 && userId != 0 {
+		err, response = c.financeService.GetLoans(raceId, userId)
+	}
+
+	request.FinalResponse(ctx, err, response)
+}
+
+func (c *financeController) GetBalanceSheet(ctx *gin.Context) {
+	raceId := helper.GetRaceId(ctx)
+	userId := helper.GetUserId(ctx)
+
+	var err error
+	var response interface{}
+
+	if raceId != 0 && userId != 0 {
+		err, response = c.financeService.GetBalanceSheet(raceId, userId)
+	}
+
+	request.FinalResponse(ctx, err, response)
+}
+
+func (c *financeController) GetInsurances(ctx *gin.Context) {
+	raceId := helper.GetRaceId(ctx)
+	userId := helper.GetUserId(ctx)
+
+	var err error
+	var response interface{}
+
+	if raceId != 0 && userId != 0 {
+		err, response = c.financeService.GetInsurances(raceId, userId)
+	}
+
+	request.FinalResponse(ctx, err, response)
+}
+
+func (c *financeController) BuyInsurance(ctx *gin.Context) {
+	raceId := helper.GetRaceId(ctx)
+	userId := helper.GetUserId(ctx)
+
+	if !c.mutex.LockMethodRace("BuyInsurance", raceId, time.Second) {
+		request.TooManyRequests(ctx)
+		return
+	}
+
+	var body dto.BuyInsuranceBodyDTO
+	var err error
+
+	if err = ctx.ShouldBindJSON(&body); err != nil {
+		request.FinalResponse(ctx, err, nil)
+		return
+	}
+
+	if raceId != 0 && userId != 0 {
+		err = c.financeService.BuyInsurance(raceId, userId, body.Type)
+	}
+
+	request.FinalResponse(ctx, err, nil)
+}
+
+func (c *financeController) CancelInsurance(ctx *gin.Context) {
+	raceId := helper.GetRaceId(ctx)
+	userId := helper.GetUserId(ctx)
+
+	var err error
+
+	if raceId != 0 && userId != 0 {
+		err = c.financeService.CancelInsurance(raceId, userId, ctx.Param("insuranceType"))
+	}
+
+	request.FinalResponse(ctx, err, nil)
+}
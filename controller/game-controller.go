@@ -19,8 +19,13 @@ type GameController interface {
 	Reset(ctx *gin.Context)
 	RollDice(ctx *gin.Context)
 	GetGame(ctx *gin.Context)
+	Spectate(ctx *gin.Context)
 	ChangeTurn(ctx *gin.Context)
 	GetTiles(ctx *gin.Context)
+	PromoteWaitList(ctx *gin.Context)
+	Pause(ctx *gin.Context)
+	Resume(ctx *gin.Context)
+	GetPausedRaces(ctx *gin.Context)
 }
 
 type gameController struct {
@@ -81,6 +86,20 @@ func (c *gameController) Start(ctx *gin.Context) {
 	request.FinalResponse(ctx, err, response)
 }
 
+func (c *gameController) Spectate(ctx *gin.Context) {
+	userId := helper.GetUserId(ctx)
+	raceId := helper.GetRaceId(ctx)
+
+	var err error
+	var response interface{}
+
+	if userId != 0 {
+		err, response = c.gameService.Spectate(raceId, userId)
+	}
+
+	request.FinalResponse(ctx, err, response)
+}
+
 func (c *gameController) RollDice(ctx *gin.Context) {
 	raceId := helper.GetRaceId(ctx)
 
@@ -94,7 +113,7 @@ func (c *gameController) RollDice(ctx *gin.Context) {
 	var response dto.RollDiceResponseDto
 	var body dto.RollDiceDto
 
-	if err := ctx.BindJSON(&body); err != nil {
+	if err := ctx.ShouldBindJSON(&body); err != nil {
 		request.FinalResponse(ctx, err, nil)
 		return
 	}
@@ -132,6 +151,61 @@ func (c *gameController) ChangeTurn(ctx *gin.Context) {
 	request.FinalResponse(ctx, err, nil)
 }
 
+func (c *gameController) PromoteWaitList(ctx *gin.Context) {
+	raceId := helper.GetRaceId(ctx)
+	userId := helper.GetUserId(ctx)
+
+	if !c.mutex.LockMethodRace("PromoteWaitList", raceId, time.Second) {
+		request.TooManyRequests(ctx)
+		return
+	}
+
+	var body dto.PromoteWaitListBodyDTO
+
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		request.FinalResponse(ctx, err, nil)
+		return
+	}
+
+	err, player := c.gameService.PromoteWaitList(raceId, userId, body.UserId)
+
+	request.FinalResponse(ctx, err, map[string]interface{}{
+		"player": player,
+	})
+}
+
+func (c *gameController) Pause(ctx *gin.Context) {
+	raceId := helper.GetRaceId(ctx)
+	userId := helper.GetUserId(ctx)
+
+	err, race := c.gameService.Pause(raceId, userId)
+
+	request.FinalResponse(ctx, err, map[string]interface{}{
+		"race": race,
+	})
+}
+
+func (c *gameController) Resume(ctx *gin.Context) {
+	raceId := helper.GetRaceId(ctx)
+	userId := helper.GetUserId(ctx)
+
+	err, race := c.gameService.Resume(raceId, userId)
+
+	request.FinalResponse(ctx, err, map[string]interface{}{
+		"race": race,
+	})
+}
+
+func (c *gameController) GetPausedRaces(ctx *gin.Context) {
+	userId := helper.GetUserId(ctx)
+
+	var err error
+
+	request.FinalResponse(ctx, err, map[string]interface{}{
+		"races": c.gameService.GetPausedRaces(userId),
+	})
+}
+
 func (c *gameController) GetTiles(ctx *gin.Context) {
 	raceId := helper.GetRaceId(ctx)
 	bigRace := helper.GetBigRace(ctx)
@@ -149,7 +223,7 @@ func (c *gameController) SetProfessions(ctx *gin.Context) {
 
 	var body dto.ProfessionsSetBodyDTO
 
-	if err = ctx.BindJSON(&body); err != nil {
+	if err = ctx.ShouldBindJSON(&body); err != nil {
 		request.FinalResponse(ctx, err, nil)
 		return
 	}
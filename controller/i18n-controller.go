@@ -0,0 +1,26 @@
+package controller
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/webjohny/cashflow-go/i18n"
+	"github.com/webjohny/cashflow-go/request"
+)
+
+type I18nController interface {
+	Catalog(ctx *gin.Context)
+}
+
+type i18nController struct{}
+
+func NewI18nController() I18nController {
+	return &i18nController{}
+}
+
+func (c *i18nController) Catalog(ctx *gin.Context) {
+	language := i18n.Normalize(ctx.Param("language"))
+
+	request.FinalResponse(ctx, nil, map[string]interface{}{
+		"language": language,
+		"messages": i18n.Catalog(language),
+	})
+}
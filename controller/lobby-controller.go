@@ -3,30 +3,37 @@ package controller
 import (
 	"errors"
 	"github.com/gin-gonic/gin"
+	"github.com/webjohny/cashflow-go/apperror"
 	"github.com/webjohny/cashflow-go/dto"
 	"github.com/webjohny/cashflow-go/entity"
 	"github.com/webjohny/cashflow-go/helper"
 	"github.com/webjohny/cashflow-go/request"
 	"github.com/webjohny/cashflow-go/service"
-	"github.com/webjohny/cashflow-go/storage"
+	"io"
 )
 
 type LobbyController interface {
 	Create(ctx *gin.Context)
 	Join(ctx *gin.Context)
+	Spectate(ctx *gin.Context)
 	Leave(ctx *gin.Context)
 	Cancel(ctx *gin.Context)
 	SetOptions(ctx *gin.Context)
+	ReplaceOptions(ctx *gin.Context)
+	ApplyTemplate(ctx *gin.Context)
+	SetTeams(ctx *gin.Context)
 	GetLobby(ctx *gin.Context)
 }
 
 type lobbyController struct {
-	lobbyService service.LobbyService
+	lobbyService        service.LobbyService
+	raceTemplateService service.RaceTemplateService
 }
 
-func NewLobbyController(lobbyService service.LobbyService) LobbyController {
+func NewLobbyController(lobbyService service.LobbyService, raceTemplateService service.RaceTemplateService) LobbyController {
 	return &lobbyController{
-		lobbyService: lobbyService,
+		lobbyService:        lobbyService,
+		raceTemplateService: raceTemplateService,
 	}
 }
 
@@ -50,11 +57,29 @@ func (c *lobbyController) Create(ctx *gin.Context) {
 
 	var err error
 	var lobby entity.Lobby
+	var body dto.CreateLobbyBodyDTO
 
-	if userId != 0 {
+	if bindErr := ctx.ShouldBindJSON(&body); bindErr != nil && !errors.Is(bindErr, io.EOF) {
+		request.FinalResponse(ctx, bindErr, nil)
+		return
+	}
+
+	if userId != 0 && body.TemplateCode != "" {
+		var template entity.RaceTemplate
+
+		if err, template = c.raceTemplateService.GetTemplate(body.TemplateCode, userId); err == nil {
+			err = c.raceTemplateService.Validate(template.Options)
+		}
+	}
+
+	if userId != 0 && err == nil {
 		err, lobby = c.lobbyService.Create(username, userId)
 	}
 
+	if lobby.ID != 0 && body.TemplateCode != "" {
+		err, lobby = c.raceTemplateService.ApplyToLobby(lobby.ID, userId, body.TemplateCode)
+	}
+
 	request.FinalResponse(ctx, err, lobby)
 }
 
@@ -65,18 +90,78 @@ func (c *lobbyController) SetOptions(ctx *gin.Context) {
 	var err error
 	var body dto.SetOptionsLobbyRequestDTO
 
-	if err = ctx.BindJSON(&body); err != nil {
+	if err = ctx.ShouldBindJSON(&body); err != nil {
 		request.FinalResponse(ctx, err, nil)
 		return
 	}
 
 	if userId != 0 {
-		err = c.lobbyService.SetOptions(lobbyId, body)
+		err, _ = c.raceTemplateService.UpdateLobbyOptions(lobbyId, userId, body)
 	}
 
 	request.FinalResponse(ctx, err, nil)
 }
 
+func (c *lobbyController) ReplaceOptions(ctx *gin.Context) {
+	userId := helper.GetUserId(ctx)
+	lobbyId := helper.GetLobbyId(ctx)
+
+	var err error
+	var lobby entity.Lobby
+	var body dto.ReplaceOptionsLobbyRequestDTO
+
+	if err = ctx.ShouldBindJSON(&body); err != nil {
+		request.FinalResponse(ctx, err, nil)
+		return
+	}
+
+	if userId != 0 {
+		err, lobby = c.raceTemplateService.SetLobbyOptions(lobbyId, userId, body.RaceOptions)
+	}
+
+	request.FinalResponse(ctx, err, lobby)
+}
+
+func (c *lobbyController) ApplyTemplate(ctx *gin.Context) {
+	userId := helper.GetUserId(ctx)
+	lobbyId := helper.GetLobbyId(ctx)
+
+	var err error
+	var lobby entity.Lobby
+	var body dto.ApplyRaceTemplateBodyDTO
+
+	if err = ctx.ShouldBindJSON(&body); err != nil {
+		request.FinalResponse(ctx, err, nil)
+		return
+	}
+
+	if userId != 0 {
+		err, lobby = c.raceTemplateService.ApplyToLobby(lobbyId, userId, body.TemplateCode)
+	}
+
+	request.FinalResponse(ctx, err, lobby)
+}
+
+func (c *lobbyController) SetTeams(ctx *gin.Context) {
+	userId := helper.GetUserId(ctx)
+	lobbyId := helper.GetLobbyId(ctx)
+
+	var err error
+	var lobby entity.Lobby
+	var body dto.SetTeamsBodyDTO
+
+	if err = ctx.ShouldBindJSON(&body); err != nil {
+		request.FinalResponse(ctx, err, nil)
+		return
+	}
+
+	if userId != 0 {
+		err, lobby = c.lobbyService.SetTeams(lobbyId, userId, body.Teams)
+	}
+
+	request.FinalResponse(ctx, err, lobby)
+}
+
 func (c *lobbyController) Join(ctx *gin.Context) {
 	userId := helper.GetUserId(ctx)
 	username := ctx.GetString("name")
@@ -92,6 +177,21 @@ func (c *lobbyController) Join(ctx *gin.Context) {
 	request.FinalResponse(ctx, err, player)
 }
 
+func (c *lobbyController) Spectate(ctx *gin.Context) {
+	userId := helper.GetUserId(ctx)
+	username := ctx.GetString("name")
+	lobbyId := helper.GetLobbyId(ctx)
+
+	var err error
+	var player entity.LobbyPlayer
+
+	if userId != 0 {
+		err, player = c.lobbyService.Spectate(lobbyId, username, userId)
+	}
+
+	request.FinalResponse(ctx, err, player)
+}
+
 func (c *lobbyController) Leave(ctx *gin.Context) {
 	userId := helper.GetUserId(ctx)
 	lobbyId := helper.GetLobbyId(ctx)
@@ -101,7 +201,7 @@ func (c *lobbyController) Leave(ctx *gin.Context) {
 	if userId > 0 {
 		err, _ = c.lobbyService.Leave(lobbyId, userId)
 	} else {
-		err = errors.New(storage.ErrorUndefinedUser)
+		err = apperror.ErrUndefinedUser
 	}
 
 	request.FinalResponse(ctx, err, nil)
@@ -116,7 +216,7 @@ func (c *lobbyController) Cancel(ctx *gin.Context) {
 	if userId != 0 {
 		err, _ = c.lobbyService.Cancel(lobbyId, userId)
 	} else {
-		err = errors.New(storage.ErrorUndefinedUser)
+		err = apperror.ErrUndefinedUser
 	}
 
 	request.FinalResponse(ctx, err, nil)
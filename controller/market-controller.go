@@ -0,0 +1,66 @@
+package controller
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/webjohny/cashflow-go/helper"
+	"github.com/webjohny/cashflow-go/request"
+	"github.com/webjohny/cashflow-go/service"
+)
+
+type MarketController interface {
+	GetMarket(ctx *gin.Context)
+	GetChart(ctx *gin.Context)
+	GetPortfolio(ctx *gin.Context)
+}
+
+type marketController struct {
+	marketService service.MarketService
+}
+
+func NewMarketController(marketService service.MarketService) MarketController {
+	return &marketController{
+		marketService: marketService,
+	}
+}
+
+func (c *marketController) GetMarket(ctx *gin.Context) {
+	raceId := helper.GetRaceId(ctx)
+	userId := helper.GetUserId(ctx)
+
+	var err error
+	var response interface{}
+
+	if raceId != 0 && userId != 0 {
+		err, response = c.marketService.GetMarket(raceId, userId)
+	}
+
+	request.FinalResponse(ctx, err, response)
+}
+
+func (c *marketController) GetChart(ctx *gin.Context) {
+	raceId := helper.GetRaceId(ctx)
+	userId := helper.GetUserId(ctx)
+
+	var err error
+	var response interface{}
+
+	if raceId != 0 && userId != 0 {
+		err, response = c.marketService.GetChart(raceId, userId, ctx.Param("symbol"))
+	}
+
+	request.FinalResponse(ctx, err, response)
+}
+
+func (c *marketController) GetPortfolio(ctx *gin.Context) {
+	raceId := helper.GetRaceId(ctx)
+	userId := helper.GetUserId(ctx)
+
+	var err error
+	var response interface{}
+
+	if raceId != 0 && userId != 0 {
+		err, response = c.marketService.GetPortfolio(raceId, userId)
+	}
+
+	request.FinalResponse(ctx, err, response)
+}
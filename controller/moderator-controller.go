@@ -8,6 +8,7 @@ import (
 	"github.com/webjohny/cashflow-go/helper"
 	"github.com/webjohny/cashflow-go/request"
 	"github.com/webjohny/cashflow-go/service"
+	"github.com/webjohny/cashflow-go/storage"
 	"strconv"
 )
 
@@ -19,14 +20,26 @@ type ModeratorController interface {
 	UpdatePlayer(ctx *gin.Context)
 	UpdateRace(ctx *gin.Context)
 	UpdateStatusRace(ctx *gin.Context)
+	SetBigRaceConditions(ctx *gin.Context)
 	HandleUserRequest(ctx *gin.Context)
+	GetAudit(ctx *gin.Context)
+	GetDecks(ctx *gin.Context)
+	PeekCards(ctx *gin.Context)
+	DealCard(ctx *gin.Context)
+	ShuffleDeck(ctx *gin.Context)
+	RemoveCards(ctx *gin.Context)
+	ConfirmRoll(ctx *gin.Context)
+	RejectRoll(ctx *gin.Context)
 }
 
 type moderatorController struct {
-	playerService      service.PlayerService
-	raceService        service.RaceService
-	lobbyService       service.LobbyService
-	userRequestService service.UserRequestService
+	playerService         service.PlayerService
+	raceService           service.RaceService
+	lobbyService          service.LobbyService
+	userRequestService    service.UserRequestService
+	moderatorAuditService service.ModeratorAuditService
+	cardService           service.CardService
+	gameService           service.GameService
 }
 
 func NewModeratorController(
@@ -34,12 +47,18 @@ func NewModeratorController(
 	raceService service.RaceService,
 	lobbyService service.LobbyService,
 	userRequestService service.UserRequestService,
+	moderatorAuditService service.ModeratorAuditService,
+	cardService service.CardService,
+	gameService service.GameService,
 ) ModeratorController {
 	return &moderatorController{
-		playerService:      playerService,
-		raceService:        raceService,
-		lobbyService:       lobbyService,
-		userRequestService: userRequestService,
+		playerService:         playerService,
+		raceService:           raceService,
+		lobbyService:          lobbyService,
+		userRequestService:    userRequestService,
+		moderatorAuditService: moderatorAuditService,
+		cardService:           cardService,
+		gameService:           gameService,
 	}
 }
 
@@ -64,6 +83,10 @@ func (c *moderatorController) GetRacePlayer(ctx *gin.Context) {
 	userIdParam, _ := strconv.Atoi(ctx.Query("playerId"))
 	userId := uint64(userIdParam)
 
+	if ctx.Param("userId") != "" {
+		userId = helper.ConvertToUInt64(ctx.Param("userId"))
+	}
+
 	raceId := helper.GetRaceId(ctx)
 
 	logger.Info("Moderator.GetRacePlayer", map[string]interface{}{
@@ -107,7 +130,7 @@ func (c *moderatorController) UpdatePlayer(ctx *gin.Context) {
 	var body dto.ModeratorUpdatePlayerDto
 	var isUpdateRace bool
 
-	if err = ctx.BindJSON(&body); err != nil {
+	if err = ctx.ShouldBindJSON(&body); err != nil {
 		request.FinalResponse(ctx, err, nil)
 		return
 	}
@@ -119,6 +142,7 @@ func (c *moderatorController) UpdatePlayer(ctx *gin.Context) {
 		return
 	}
 	isUpdateRace = player.IsActive != body.IsActive
+	before := helper.Clone(player)
 
 	player.Cash = body.Cash
 	player.CashFlow = body.CashFlow
@@ -130,13 +154,8 @@ func (c *moderatorController) UpdatePlayer(ctx *gin.Context) {
 	player.IsActive = body.IsActive
 	player.Assets.Savings = body.Savings
 
-	if body.Liabilities.BankLoan > 0 {
-		player.Expenses["bankLoan"] = body.Liabilities.BankLoan / 10
-	} else {
-		player.Expenses["bankLoan"] = 0
-	}
-
 	player.Liabilities.BankLoan = body.Liabilities.BankLoan
+	player.SyncLoans()
 
 	for _, realEstate := range player.Assets.RealEstates {
 		if item, ok := body.RealEstate[realEstate.ID]; ok {
@@ -177,6 +196,17 @@ func (c *moderatorController) UpdatePlayer(ctx *gin.Context) {
 		return
 	}
 
+	c.audit(ctx, entity.ModeratorAudit{
+		RaceID:   raceId,
+		PlayerID: player.ID,
+		Action:   entity.ModeratorAuditActions.UpdatePlayer,
+		Reason:   body.Reason,
+	}, before, player)
+
+	if amount := player.Cash - before.Cash; amount != 0 {
+		c.setAdjustment(player, amount, body.Reason)
+	}
+
 	if isUpdateRace {
 		race := c.raceService.GetRaceByRaceId(raceId)
 		user := race.GetNextPlayer()
@@ -201,12 +231,13 @@ func (c *moderatorController) UpdateRace(ctx *gin.Context) {
 
 	var body dto.ModeratorUpdateRaceDto
 
-	if err = ctx.BindJSON(&body); err != nil {
+	if err = ctx.ShouldBindJSON(&body); err != nil {
 		request.FinalResponse(ctx, err, nil)
 		return
 	}
 
 	race := c.raceService.GetRaceByRaceId(raceId)
+	before := helper.Clone(race)
 
 	race.Options.EnableCardCategory = body.EnableCardCategory
 	race.Options.EnableManager = body.EnableManager
@@ -233,6 +264,14 @@ func (c *moderatorController) UpdateRace(ctx *gin.Context) {
 		err = c.raceService.ChangeTurn(race, true, body.CurrentPlayer)
 	}
 
+	if err == nil {
+		c.audit(ctx, entity.ModeratorAudit{
+			RaceID: raceId,
+			Action: entity.ModeratorAuditActions.UpdateRace,
+			Reason: body.Reason,
+		}, before, c.raceService.GetRaceByRaceId(raceId))
+	}
+
 	request.FinalResponse(ctx, err, map[string]interface{}{
 		"race": race,
 	})
@@ -245,12 +284,13 @@ func (c *moderatorController) UpdateStatusRace(ctx *gin.Context) {
 
 	var body dto.ModeratorUpdateStatusRaceDto
 
-	if err = ctx.BindJSON(&body); err != nil {
+	if err = ctx.ShouldBindJSON(&body); err != nil {
 		request.FinalResponse(ctx, err, nil)
 		return
 	}
 
 	race := c.raceService.GetRaceByRaceId(raceId)
+	before := helper.Clone(race)
 
 	race.Status = body.Status
 
@@ -265,56 +305,78 @@ func (c *moderatorController) UpdateStatusRace(ctx *gin.Context) {
 		return
 	}
 
+	c.audit(ctx, entity.ModeratorAudit{
+		RaceID: raceId,
+		Action: entity.ModeratorAuditActions.UpdateStatusRace,
+		Reason: body.Reason,
+	}, before, race)
+
 	request.FinalResponse(ctx, err, nil)
 }
 
-func (c *moderatorController) HandleUserRequest(ctx *gin.Context) {
+func (c *moderatorController) SetBigRaceConditions(ctx *gin.Context) {
+	raceId := helper.GetRaceId(ctx)
+	userId := helper.GetUserId(ctx)
+
 	var err error
+	var body dto.BigRaceConditionsBodyDTO
 
-	var body dto.HandleUserRequestBodyDto
+	if err = ctx.ShouldBindJSON(&body); err != nil {
+		request.FinalResponse(ctx, err, nil)
+		return
+	}
+
+	err, before := c.gameService.GetManagedRace(raceId, userId)
 
-	if err = ctx.BindJSON(&body); err != nil {
+	if err != nil {
 		request.FinalResponse(ctx, err, nil)
 		return
 	}
 
-	var userRequest entity.UserRequest
+	err, race := c.raceService.SetBigRaceConditions(raceId, body.Conditions)
+
+	if race.ID != 0 {
+		c.audit(ctx, entity.ModeratorAudit{
+			RaceID: raceId,
+			Action: entity.ModeratorAuditActions.BigRaceConditions,
+			Reason: body.Reason,
+		}, before, race)
+	}
+
+	request.FinalResponse(ctx, err, map[string]interface{}{
+		"conditions": race.Options.BigRaceConditions,
+	})
+}
+
+func (c *moderatorController) HandleUserRequest(ctx *gin.Context) {
+	var err error
 
-	err, userRequest = c.userRequestService.HandleUserRequest(body)
+	var body dto.HandleUserRequestBodyDto
 
-	if err != nil {
+	if err = ctx.ShouldBindJSON(&body); err != nil {
 		request.FinalResponse(ctx, err, nil)
 		return
 	}
 
-	var player entity.Player
+	userRequest := c.userRequestService.GetOneById(body.UserRequestId)
+	_, before := c.playerService.GetPlayerByUserIdAndRaceId(userRequest.RaceID, userRequest.UserID)
 
-	if userRequest.ID > 0 && userRequest.Status == 1 {
-		err, player = c.playerService.GetPlayerByUserIdAndRaceId(userRequest.RaceID, userRequest.UserID)
-
-		if player.ID > 0 && err == nil {
-			if userRequest.Type == entity.TransactionCardType.TakeLoan {
-				err = c.playerService.TakeLoan(player, userRequest.Amount)
-			} else {
-				err = c.playerService.UpdateCash(
-					&player,
-					userRequest.Amount,
-					&dto.TransactionDTO{
-						CardType: userRequest.Type,
-						Details:  userRequest.Message,
-					},
-				)
-			}
-		}
-	} else if userRequest.Status == 2 {
-		err, player = c.playerService.GetPlayerByUserIdAndRaceId(userRequest.RaceID, userRequest.UserID)
+	err, userRequest = c.userRequestService.HandleUserRequest(body, helper.GetUserId(ctx))
 
-		player.SetNotification(userRequest.RejectMessage, entity.NotificationTypes.Error)
+	if err == nil && before.ID > 0 {
+		_, after := c.playerService.GetPlayerByPlayerIdAndRaceId(before.RaceID, before.ID)
 
-		err, _ = c.playerService.UpdatePlayer(&player)
+		c.audit(ctx, entity.ModeratorAudit{
+			RaceID:   before.RaceID,
+			PlayerID: before.ID,
+			Action:   entity.ModeratorAuditActions.HandleUserRequest,
+			Reason:   body.Message,
+		}, before, after)
 	}
 
-	request.FinalResponse(ctx, err, nil)
+	request.FinalResponse(ctx, err, map[string]interface{}{
+		"userRequest": userRequest,
+	})
 }
 
 func (c *moderatorController) SendMoney(ctx *gin.Context) {
@@ -323,7 +385,7 @@ func (c *moderatorController) SendMoney(ctx *gin.Context) {
 
 	var body dto.ModeratorSendMoneyDTO
 
-	if err = ctx.BindJSON(&body); err != nil {
+	if err = ctx.ShouldBindJSON(&body); err != nil {
 		request.FinalResponse(ctx, err, nil)
 		return
 	}
@@ -335,6 +397,13 @@ func (c *moderatorController) SendMoney(ctx *gin.Context) {
 		return
 	}
 
+	before := helper.Clone(player)
+	reason := body.Reason
+
+	if reason == "" {
+		reason = body.Message
+	}
+
 	player.SetNotification(body.Message, entity.NotificationTypes.Success)
 
 	err = c.playerService.UpdateCash(
@@ -343,8 +412,238 @@ func (c *moderatorController) SendMoney(ctx *gin.Context) {
 		&dto.TransactionDTO{
 			CardType: entity.TransactionCardType.SendMoneyFromBank,
 			Details:  body.Message,
+			Code:     storage.TransactionModeratorAdjusted,
+			Params: map[string]interface{}{
+				"amount": body.Amount,
+				"reason": reason,
+			},
 		},
 	)
 
+	if err == nil {
+		c.audit(ctx, entity.ModeratorAudit{
+			RaceID:   raceId,
+			PlayerID: player.ID,
+			Action:   entity.ModeratorAuditActions.SendMoney,
+			Reason:   reason,
+		}, before, player)
+	}
+
+	request.FinalResponse(ctx, err, nil)
+}
+
+func (c *moderatorController) GetAudit(ctx *gin.Context) {
+	raceId := helper.GetRaceId(ctx)
+	userId := helper.GetUserId(ctx)
+	playerId := helper.ConvertToUInt64(ctx.Query("playerId"))
+
+	audit := make([]entity.ModeratorAudit, 0)
+
+	err, _ := c.gameService.GetManagedRace(raceId, userId)
+
+	if err == nil {
+		audit = c.moderatorAuditService.GetAudit(raceId, playerId)
+	}
+
+	request.FinalResponse(ctx, err, map[string]interface{}{
+		"audit": audit,
+	})
+}
+
+func (c *moderatorController) GetDecks(ctx *gin.Context) {
+	raceId := helper.GetRaceId(ctx)
+	userId := helper.GetUserId(ctx)
+
+	err, decks := c.cardService.GetDecks(raceId, userId)
+
+	request.FinalResponse(ctx, err, map[string]interface{}{
+		"decks": decks,
+	})
+}
+
+func (c *moderatorController) PeekCards(ctx *gin.Context) {
+	raceId := helper.GetRaceId(ctx)
+	userId := helper.GetUserId(ctx)
+	count, _ := strconv.Atoi(ctx.DefaultQuery("count", "5"))
+
+	err, cards := c.cardService.PeekCards(raceId, userId, ctx.Param("deck"), count)
+
+	request.FinalResponse(ctx, err, map[string]interface{}{
+		"cards": cards,
+	})
+}
+
+func (c *moderatorController) DealCard(ctx *gin.Context) {
+	raceId := helper.GetRaceId(ctx)
+	userId := helper.GetUserId(ctx)
+
+	var err error
+	var body dto.DealCardBodyDTO
+
+	if err = ctx.ShouldBindJSON(&body); err != nil {
+		request.FinalResponse(ctx, err, nil)
+		return
+	}
+
+	before := helper.Clone(c.raceService.GetRaceByRaceId(raceId))
+
+	err, race := c.cardService.DealCard(raceId, userId, ctx.Param("deck"), body.Card)
+
+	if err == nil {
+		c.audit(ctx, entity.ModeratorAudit{
+			RaceID: raceId,
+			Action: entity.ModeratorAuditActions.DealCard,
+			Reason: body.Reason,
+		}, before, race)
+	}
+
+	request.FinalResponse(ctx, err, map[string]interface{}{
+		"card": race.CurrentCard,
+	})
+}
+
+func (c *moderatorController) ShuffleDeck(ctx *gin.Context) {
+	raceId := helper.GetRaceId(ctx)
+	userId := helper.GetUserId(ctx)
+
+	var err error
+	var body dto.ShuffleDeckBodyDTO
+
+	// The reason is optional, a shuffle without a body is allowed.
+	if ctx.Request.ContentLength > 0 {
+		err = ctx.ShouldBindJSON(&body)
+	}
+
+	if err != nil {
+		request.FinalResponse(ctx, err, nil)
+		return
+	}
+
+	before := helper.Clone(c.raceService.GetRaceByRaceId(raceId))
+
+	err, race := c.cardService.ShuffleDeck(raceId, userId, ctx.Param("deck"))
+
+	if err == nil {
+		c.audit(ctx, entity.ModeratorAudit{
+			RaceID: raceId,
+			Action: entity.ModeratorAuditActions.ShuffleDeck,
+			Reason: body.Reason,
+		}, before, race)
+	}
+
+	request.FinalResponse(ctx, err, nil)
+}
+
+func (c *moderatorController) RemoveCards(ctx *gin.Context) {
+	raceId := helper.GetRaceId(ctx)
+	userId := helper.GetUserId(ctx)
+
+	var err error
+	var body dto.RemoveCardsBodyDTO
+
+	if err = ctx.ShouldBindJSON(&body); err != nil {
+		request.FinalResponse(ctx, err, nil)
+		return
+	}
+
+	before := helper.Clone(c.raceService.GetRaceByRaceId(raceId))
+
+	err, race := c.cardService.RemoveCards(raceId, userId, ctx.Param("deck"), body.Cards)
+
+	if err == nil {
+		c.audit(ctx, entity.ModeratorAudit{
+			RaceID: raceId,
+			Action: entity.ModeratorAuditActions.RemoveCards,
+			Reason: body.Reason,
+		}, before, race)
+	}
+
 	request.FinalResponse(ctx, err, nil)
 }
+
+func (c *moderatorController) ConfirmRoll(ctx *gin.Context) {
+	raceId := helper.GetRaceId(ctx)
+	userId := helper.GetUserId(ctx)
+
+	var err error
+	var body dto.ConfirmRollBodyDTO
+
+	if err = ctx.ShouldBindJSON(&body); err != nil {
+		request.FinalResponse(ctx, err, nil)
+		return
+	}
+
+	before := helper.Clone(c.raceService.GetRaceByRaceId(raceId))
+
+	err, race := c.gameService.ConfirmRoll(raceId, userId, body.Dice)
+
+	if err == nil {
+		c.audit(ctx, entity.ModeratorAudit{
+			RaceID: raceId,
+			Action: entity.ModeratorAuditActions.ConfirmRoll,
+			Reason: body.Reason,
+		}, before, race)
+	}
+
+	request.FinalResponse(ctx, err, map[string]interface{}{
+		"dice": race.Dice,
+	})
+}
+
+func (c *moderatorController) RejectRoll(ctx *gin.Context) {
+	raceId := helper.GetRaceId(ctx)
+	userId := helper.GetUserId(ctx)
+
+	var err error
+	var body dto.RejectRollBodyDTO
+
+	// The reason is optional, a rejection without a body is allowed.
+	if ctx.Request.ContentLength > 0 {
+		err = ctx.ShouldBindJSON(&body)
+	}
+
+	if err != nil {
+		request.FinalResponse(ctx, err, nil)
+		return
+	}
+
+	before := helper.Clone(c.raceService.GetRaceByRaceId(raceId))
+
+	err, race := c.gameService.RejectRoll(raceId, userId)
+
+	if err == nil {
+		c.audit(ctx, entity.ModeratorAudit{
+			RaceID: raceId,
+			Action: entity.ModeratorAuditActions.RejectRoll,
+			Reason: body.Reason,
+		}, before, race)
+	}
+
+	request.FinalResponse(ctx, err, nil)
+}
+
+// audit records the intervention of the moderator, a failure is only logged as the change is already saved.
+func (c *moderatorController) audit(ctx *gin.Context, audit entity.ModeratorAudit, before interface{}, after interface{}) {
+	audit.ModeratorID = helper.GetUserId(ctx)
+
+	if err := c.moderatorAuditService.Record(audit, before, after); err != nil {
+		logger.Error("Moderator.audit", err, audit.Action, audit.RaceID)
+	}
+}
+
+// setAdjustment puts the balance change made by the moderator into the player's log.
+func (c *moderatorController) setAdjustment(player entity.Player, amount int, reason string) {
+	err := c.playerService.SetTransaction(player, dto.TransactionDTO{
+		CardType: entity.TransactionCardType.Moderator,
+		Amount:   amount,
+		Code:     storage.TransactionModeratorAdjusted,
+		Params: map[string]interface{}{
+			"amount": amount,
+			"reason": reason,
+		},
+	})
+
+	if err != nil {
+		logger.Error("Moderator.setAdjustment", err, player.ID, amount)
+	}
+}
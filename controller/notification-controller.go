@@ -0,0 +1,130 @@
+package controller
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/webjohny/cashflow-go/dto"
+	"github.com/webjohny/cashflow-go/entity"
+	"github.com/webjohny/cashflow-go/helper"
+	"github.com/webjohny/cashflow-go/request"
+	"github.com/webjohny/cashflow-go/service"
+	"io"
+	"strconv"
+)
+
+type NotificationController interface {
+	GetNotifications(ctx *gin.Context)
+	MarkRead(ctx *gin.Context)
+	MarkAllRead(ctx *gin.Context)
+	Stream(ctx *gin.Context)
+	Send(ctx *gin.Context)
+}
+
+type notificationController struct {
+	notificationService service.NotificationService
+	gameService         service.GameService
+}
+
+func NewNotificationController(notificationService service.NotificationService, gameService service.GameService) NotificationController {
+	return &notificationController{
+		notificationService: notificationService,
+		gameService:         gameService,
+	}
+}
+
+func (c *notificationController) GetNotifications(ctx *gin.Context) {
+	raceId := helper.GetRaceId(ctx)
+	userId := helper.GetUserId(ctx)
+	cursor, _ := strconv.ParseUint(ctx.Query("cursor"), 10, 64)
+	limit, _ := strconv.Atoi(ctx.Query("limit"))
+
+	var err error
+	var response interface{}
+
+	if raceId != 0 && userId != 0 {
+		err, response = c.notificationService.GetNotifications(raceId, userId, cursor, limit)
+	}
+
+	request.FinalResponse(ctx, err, response)
+}
+
+func (c *notificationController) MarkRead(ctx *gin.Context) {
+	raceId := helper.GetRaceId(ctx)
+	userId := helper.GetUserId(ctx)
+
+	var err error
+
+	if raceId != 0 && userId != 0 {
+		err = c.notificationService.MarkRead(raceId, userId, helper.ConvertToUInt64(ctx.Param("notificationId")))
+	}
+
+	request.FinalResponse(ctx, err, nil)
+}
+
+func (c *notificationController) MarkAllRead(ctx *gin.Context) {
+	raceId := helper.GetRaceId(ctx)
+	userId := helper.GetUserId(ctx)
+
+	var err error
+
+	if raceId != 0 && userId != 0 {
+		err = c.notificationService.MarkAllRead(raceId, userId)
+	}
+
+	request.FinalResponse(ctx, err, nil)
+}
+
+// Stream pushes the notifications of the player as server-sent events while the connection is open.
+func (c *notificationController) Stream(ctx *gin.Context) {
+	raceId := helper.GetRaceId(ctx)
+	userId := helper.GetUserId(ctx)
+
+	err, subscription := c.notificationService.Subscribe(raceId, userId)
+
+	if err != nil {
+		request.FinalResponse(ctx, err, nil)
+		return
+	}
+
+	defer c.notificationService.Unsubscribe(subscription)
+
+	ctx.Stream(func(w io.Writer) bool {
+		select {
+		case notification, ok := <-subscription.Notifications:
+			if ok {
+				ctx.SSEvent("notification", notification)
+			}
+			return ok
+		case <-ctx.Request.Context().Done():
+			return false
+		}
+	})
+}
+
+func (c *notificationController) Send(ctx *gin.Context) {
+	raceId := helper.GetRaceId(ctx)
+	userId := helper.GetUserId(ctx)
+
+	var err error
+	var body dto.SendNotificationBodyDTO
+	var notification entity.Notification
+
+	if err = ctx.ShouldBindJSON(&body); err != nil {
+		request.FinalResponse(ctx, err, nil)
+		return
+	}
+
+	if err, _ = c.gameService.GetManagedRace(raceId, userId); err == nil {
+		err, notification = c.notificationService.Notify(entity.Notification{
+			RaceID:   raceId,
+			PlayerID: body.PlayerId,
+			Role:     body.Role,
+			Type:     body.Type,
+			Message:  body.Message,
+			Params:   body.Params,
+		})
+	}
+
+	request.FinalResponse(ctx, err, map[string]interface{}{
+		"notification": notification,
+	})
+}
@@ -0,0 +1,46 @@
+package controller
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/webjohny/cashflow-go/openapi"
+	"net/http"
+)
+
+const swaggerPage = `<!DOCTYPE html>
+<html lang="en">
+<head>
+  <meta charset="utf-8"/>
+  <title>Cashflow game API</title>
+  <link rel="stylesheet" href="https://unpkg.com/swagger-ui-dist@5/swagger-ui.css"/>
+</head>
+<body>
+<div id="swagger-ui"></div>
+<script src="https://unpkg.com/swagger-ui-dist@5/swagger-ui-bundle.js"></script>
+<script>
+  window.ui = SwaggerUIBundle({url: "/openapi.json", dom_id: "#swagger-ui"});
+</script>
+</body>
+</html>`
+
+type OpenAPIController interface {
+	Spec(ctx *gin.Context)
+	UI(ctx *gin.Context)
+}
+
+type openAPIController struct {
+	document openapi.Document
+}
+
+func NewOpenAPIController(routes gin.RoutesInfo) OpenAPIController {
+	return &openAPIController{
+		document: openapi.Generate(routes),
+	}
+}
+
+func (c *openAPIController) Spec(ctx *gin.Context) {
+	ctx.JSON(http.StatusOK, c.document)
+}
+
+func (c *openAPIController) UI(ctx *gin.Context) {
+	ctx.Data(http.StatusOK, "text/html; charset=utf-8", []byte(swaggerPage))
+}
@@ -2,12 +2,11 @@ package controller
 
 import (
 	"github.com/gin-gonic/gin"
+	"github.com/webjohny/cashflow-go/apperror"
 	"github.com/webjohny/cashflow-go/entity"
 	"github.com/webjohny/cashflow-go/helper"
 	"github.com/webjohny/cashflow-go/request"
 	"github.com/webjohny/cashflow-go/service"
-	"github.com/webjohny/cashflow-go/storage"
-	"gopkg.in/errgo.v2/errors"
 )
 
 type PlayerController interface {
@@ -15,26 +14,30 @@ type PlayerController interface {
 	GetPlayerData(ctx *gin.Context)
 	SetPlayerData(ctx *gin.Context)
 	MoveOnBigRace(ctx *gin.Context)
+	GetBigRaceProgress(ctx *gin.Context)
 	SetDream(ctx *gin.Context)
 	BecomeModerator(ctx *gin.Context)
 	IsReadNotification(ctx *gin.Context)
 }
 
 type playerController struct {
-	playerService service.PlayerService
-	raceService   service.RaceService
-	lobbyService  service.LobbyService
+	playerService       service.PlayerService
+	raceService         service.RaceService
+	lobbyService        service.LobbyService
+	notificationService service.NotificationService
 }
 
 func NewPlayerController(
 	playerService service.PlayerService,
 	raceService service.RaceService,
 	lobbyService service.LobbyService,
+	notificationService service.NotificationService,
 ) PlayerController {
 	return &playerController{
-		playerService: playerService,
-		raceService:   raceService,
-		lobbyService:  lobbyService,
+		playerService:       playerService,
+		raceService:         raceService,
+		lobbyService:        lobbyService,
+		notificationService: notificationService,
 	}
 }
 
@@ -49,9 +52,9 @@ func (c *playerController) GetRacePlayer(ctx *gin.Context) {
 		race := c.raceService.GetRaceByRaceId(raceId)
 
 		if race.ID == 0 {
-			err = errors.New(storage.ErrorUndefinedGame)
+			err = apperror.ErrUndefinedGame
 		} else if race.Status == entity.RaceStatus.FINISHED {
-			err = errors.New(storage.ErrorGameIsFinished)
+			err = apperror.ErrGameIsFinished
 		} else {
 			err, response = c.playerService.GetRacePlayer(raceId, userId, true)
 		}
@@ -71,9 +74,9 @@ func (c *playerController) GetPlayerData(ctx *gin.Context) {
 		race := c.raceService.GetRaceByRaceId(raceId)
 
 		if race.ID == 0 {
-			err = errors.New(storage.ErrorUndefinedGame)
+			err = apperror.ErrUndefinedGame
 		} else if race.Status == entity.RaceStatus.FINISHED {
-			err = errors.New(storage.ErrorGameIsFinished)
+			err = apperror.ErrGameIsFinished
 		} else {
 			var player entity.Player
 			err, player = c.playerService.GetPlayerByUserIdAndRaceId(raceId, userId)
@@ -98,7 +101,7 @@ func (c *playerController) SetPlayerData(ctx *gin.Context) {
 	errDTO := ctx.ShouldBind(&setPlayerDataDTO)
 
 	if errDTO != nil {
-		response = request.BuildErrorResponse("Failed to process request", errDTO.Error(), request.EmptyObj{})
+		err = errDTO
 		request.FinalResponse(ctx, err, response)
 		return
 	}
@@ -107,9 +110,9 @@ func (c *playerController) SetPlayerData(ctx *gin.Context) {
 		race := c.raceService.GetRaceByRaceId(raceId)
 
 		if race.ID == 0 {
-			err = errors.New(storage.ErrorUndefinedGame)
+			err = apperror.ErrUndefinedGame
 		} else if race.Status == entity.RaceStatus.FINISHED {
-			err = errors.New(storage.ErrorGameIsFinished)
+			err = apperror.ErrGameIsFinished
 		} else {
 			err = c.playerService.SetPlayerData(raceId, userId, setPlayerDataDTO)
 
@@ -140,6 +143,15 @@ func (c *playerController) MoveOnBigRace(ctx *gin.Context) {
 	request.FinalResponse(ctx, err, response)
 }
 
+func (c *playerController) GetBigRaceProgress(ctx *gin.Context) {
+	userId := helper.GetUserId(ctx)
+	raceId := helper.GetRaceId(ctx)
+
+	err, progress := c.playerService.GetBigRaceProgress(raceId, userId)
+
+	request.FinalResponse(ctx, err, progress)
+}
+
 func (c *playerController) SetDream(ctx *gin.Context) {
 	userId := helper.GetUserId(ctx)
 	raceId := helper.GetRaceId(ctx)
@@ -151,7 +163,7 @@ func (c *playerController) SetDream(ctx *gin.Context) {
 	errDTO := ctx.ShouldBind(&playerDream)
 
 	if errDTO != nil {
-		response = request.BuildErrorResponse("Failed to process request", errDTO.Error(), request.EmptyObj{})
+		err = errDTO
 	} else if userId != 0 {
 		err = c.playerService.SetDream(raceId, userId, playerDream)
 	}
@@ -176,6 +188,10 @@ func (c *playerController) IsReadNotification(ctx *gin.Context) {
 
 	err, _ = c.playerService.UpdatePlayer(&player)
 
+	if err == nil {
+		err = c.notificationService.MarkReadByUID(player, notificationId)
+	}
+
 	request.FinalResponse(ctx, err, nil)
 }
 
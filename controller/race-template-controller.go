@@ -0,0 +1,96 @@
+package controller
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/webjohny/cashflow-go/dto"
+	"github.com/webjohny/cashflow-go/entity"
+	"github.com/webjohny/cashflow-go/helper"
+	"github.com/webjohny/cashflow-go/request"
+	"github.com/webjohny/cashflow-go/service"
+)
+
+type RaceTemplateController interface {
+	GetTemplates(ctx *gin.Context)
+	GetTemplate(ctx *gin.Context)
+	Create(ctx *gin.Context)
+	Update(ctx *gin.Context)
+	Delete(ctx *gin.Context)
+}
+
+type raceTemplateController struct {
+	raceTemplateService service.RaceTemplateService
+}
+
+func NewRaceTemplateController(raceTemplateService service.RaceTemplateService) RaceTemplateController {
+	return &raceTemplateController{
+		raceTemplateService: raceTemplateService,
+	}
+}
+
+func (c *raceTemplateController) GetTemplates(ctx *gin.Context) {
+	userId := helper.GetUserId(ctx)
+
+	var err error
+
+	request.FinalResponse(ctx, err, map[string]interface{}{
+		"templates": c.raceTemplateService.GetTemplates(userId),
+	})
+}
+
+func (c *raceTemplateController) GetTemplate(ctx *gin.Context) {
+	userId := helper.GetUserId(ctx)
+
+	err, template := c.raceTemplateService.GetTemplate(ctx.Param("templateCode"), userId)
+
+	request.FinalResponse(ctx, err, template)
+}
+
+func (c *raceTemplateController) Create(ctx *gin.Context) {
+	userId := helper.GetUserId(ctx)
+
+	var err error
+	var template entity.RaceTemplate
+	var body dto.RaceTemplateBodyDTO
+
+	if err = ctx.ShouldBindJSON(&body); err != nil {
+		request.FinalResponse(ctx, err, nil)
+		return
+	}
+
+	if userId != 0 {
+		err, template = c.raceTemplateService.Create(userId, body)
+	}
+
+	request.FinalResponse(ctx, err, template)
+}
+
+func (c *raceTemplateController) Update(ctx *gin.Context) {
+	userId := helper.GetUserId(ctx)
+
+	var err error
+	var template entity.RaceTemplate
+	var body dto.RaceTemplateBodyDTO
+
+	if err = ctx.ShouldBindJSON(&body); err != nil {
+		request.FinalResponse(ctx, err, nil)
+		return
+	}
+
+	if userId != 0 {
+		err, template = c.raceTemplateService.Update(ctx.Param("templateCode"), userId, body)
+	}
+
+	request.FinalResponse(ctx, err, template)
+}
+
+func (c *raceTemplateController) Delete(ctx *gin.Context) {
+	userId := helper.GetUserId(ctx)
+
+	var err error
+
+	if userId != 0 {
+		err = c.raceTemplateService.Delete(ctx.Param("templateCode"), userId)
+	}
+
+	request.FinalResponse(ctx, err, nil)
+}
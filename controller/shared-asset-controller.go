@@ -0,0 +1,109 @@
+package controller
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/webjohny/cashflow-go/dto"
+	"github.com/webjohny/cashflow-go/entity"
+	"github.com/webjohny/cashflow-go/helper"
+	"github.com/webjohny/cashflow-go/objects"
+	"github.com/webjohny/cashflow-go/request"
+	"github.com/webjohny/cashflow-go/service"
+	"strconv"
+	"time"
+)
+
+type SharedAssetController interface {
+	GetSharedAssets(ctx *gin.Context)
+	ProposeBuyout(ctx *gin.Context)
+	AcceptBuyout(ctx *gin.Context)
+	DeclineBuyout(ctx *gin.Context)
+}
+
+type sharedAssetController struct {
+	sharedAssetService service.SharedAssetService
+	mutex              *objects.MutexMap
+}
+
+func NewSharedAssetController(sharedAssetService service.SharedAssetService) SharedAssetController {
+	return &sharedAssetController{
+		sharedAssetService: sharedAssetService,
+		mutex:              &objects.MutexMap{},
+	}
+}
+
+func (c *sharedAssetController) GetSharedAssets(ctx *gin.Context) {
+	raceId := helper.GetRaceId(ctx)
+	userId := helper.GetUserId(ctx)
+
+	var err error
+	var sharedAssets []entity.SharedAsset
+
+	if raceId != 0 && userId != 0 {
+		err, sharedAssets = c.sharedAssetService.GetSharedAssets(raceId, userId)
+	}
+
+	request.FinalResponse(ctx, err, map[string]interface{}{
+		"sharedAssets": sharedAssets,
+	})
+}
+
+func (c *sharedAssetController) ProposeBuyout(ctx *gin.Context) {
+	raceId := helper.GetRaceId(ctx)
+	userId := helper.GetUserId(ctx)
+	sharedAssetId, _ := strconv.ParseUint(ctx.Param("sharedAssetId"), 10, 64)
+
+	if !c.mutex.LockMethodRace("SharedAssetProposeBuyout", raceId, time.Second) {
+		request.TooManyRequests(ctx)
+		return
+	}
+
+	var err error
+	var body dto.SharedAssetBuyoutBodyDTO
+	var sharedAsset entity.SharedAsset
+
+	if err = ctx.ShouldBindJSON(&body); err != nil {
+		request.FinalResponse(ctx, err, nil)
+		return
+	}
+
+	if raceId != 0 && userId != 0 {
+		err, sharedAsset = c.sharedAssetService.ProposeBuyout(raceId, userId, sharedAssetId, body)
+	}
+
+	request.FinalResponse(ctx, err, sharedAsset)
+}
+
+func (c *sharedAssetController) AcceptBuyout(ctx *gin.Context) {
+	raceId := helper.GetRaceId(ctx)
+	userId := helper.GetUserId(ctx)
+	sharedAssetId, _ := strconv.ParseUint(ctx.Param("sharedAssetId"), 10, 64)
+
+	if !c.mutex.LockMethodRace("SharedAssetAcceptBuyout", raceId, time.Second) {
+		request.TooManyRequests(ctx)
+		return
+	}
+
+	var err error
+	var sharedAsset entity.SharedAsset
+
+	if raceId != 0 && userId != 0 {
+		err, sharedAsset = c.sharedAssetService.AcceptBuyout(raceId, userId, sharedAssetId, ctx.Param("buyoutId"))
+	}
+
+	request.FinalResponse(ctx, err, sharedAsset)
+}
+
+func (c *sharedAssetController) DeclineBuyout(ctx *gin.Context) {
+	raceId := helper.GetRaceId(ctx)
+	userId := helper.GetUserId(ctx)
+	sharedAssetId, _ := strconv.ParseUint(ctx.Param("sharedAssetId"), 10, 64)
+
+	var err error
+	var sharedAsset entity.SharedAsset
+
+	if raceId != 0 && userId != 0 {
+		err, sharedAsset = c.sharedAssetService.DeclineBuyout(raceId, userId, sharedAssetId, ctx.Param("buyoutId"))
+	}
+
+	request.FinalResponse(ctx, err, sharedAsset)
+}
@@ -0,0 +1,72 @@
+package controller
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/webjohny/cashflow-go/dto"
+	"github.com/webjohny/cashflow-go/entity"
+	"github.com/webjohny/cashflow-go/helper"
+	"github.com/webjohny/cashflow-go/objects"
+	"github.com/webjohny/cashflow-go/request"
+	"github.com/webjohny/cashflow-go/service"
+	"strconv"
+	"time"
+)
+
+type TeamController interface {
+	GetDecisions(ctx *gin.Context)
+	Vote(ctx *gin.Context)
+}
+
+type teamController struct {
+	teamService service.TeamService
+	mutex       *objects.MutexMap
+}
+
+func NewTeamController(teamService service.TeamService) TeamController {
+	return &teamController{
+		teamService: teamService,
+		mutex:       &objects.MutexMap{},
+	}
+}
+
+func (c *teamController) GetDecisions(ctx *gin.Context) {
+	raceId := helper.GetRaceId(ctx)
+	userId := helper.GetUserId(ctx)
+
+	var err error
+	var decisions []entity.TeamDecision
+
+	if raceId != 0 && userId != 0 {
+		err, decisions = c.teamService.GetDecisions(raceId, userId)
+	}
+
+	request.FinalResponse(ctx, err, map[string]interface{}{
+		"decisions": decisions,
+	})
+}
+
+func (c *teamController) Vote(ctx *gin.Context) {
+	raceId := helper.GetRaceId(ctx)
+	userId := helper.GetUserId(ctx)
+	decisionId, _ := strconv.ParseUint(ctx.Param("decisionId"), 10, 64)
+
+	if !c.mutex.LockMethodRace("TeamVote", raceId, time.Second*3) {
+		request.TooManyRequests(ctx)
+		return
+	}
+
+	var err error
+	var body dto.TeamDecisionVoteBodyDTO
+	var decision entity.TeamDecision
+
+	if err = ctx.ShouldBindJSON(&body); err != nil {
+		request.FinalResponse(ctx, err, nil)
+		return
+	}
+
+	if raceId != 0 && userId != 0 {
+		err, decision = c.teamService.Vote(raceId, userId, decisionId, body.Approve)
+	}
+
+	request.FinalResponse(ctx, err, decision)
+}
@@ -0,0 +1,167 @@
+package controller
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/webjohny/cashflow-go/dto"
+	"github.com/webjohny/cashflow-go/entity"
+	"github.com/webjohny/cashflow-go/helper"
+	"github.com/webjohny/cashflow-go/objects"
+	"github.com/webjohny/cashflow-go/request"
+	"github.com/webjohny/cashflow-go/service"
+	"time"
+)
+
+type TournamentController interface {
+	GetTournaments(ctx *gin.Context)
+	GetTournament(ctx *gin.Context)
+	GetStandings(ctx *gin.Context)
+	Create(ctx *gin.Context)
+	Join(ctx *gin.Context)
+	Leave(ctx *gin.Context)
+	Cancel(ctx *gin.Context)
+	StartRound(ctx *gin.Context)
+	CloseTable(ctx *gin.Context)
+}
+
+type tournamentController struct {
+	tournamentService service.TournamentService
+	mutex             *objects.MutexMap
+}
+
+func NewTournamentController(tournamentService service.TournamentService) TournamentController {
+	return &tournamentController{
+		tournamentService: tournamentService,
+		mutex:             &objects.MutexMap{},
+	}
+}
+
+func (c *tournamentController) GetTournaments(ctx *gin.Context) {
+	var err error
+
+	request.FinalResponse(ctx, err, map[string]interface{}{
+		"tournaments": c.tournamentService.GetTournaments(),
+	})
+}
+
+func (c *tournamentController) GetTournament(ctx *gin.Context) {
+	err, response := c.tournamentService.GetTournament(helper.ConvertToUInt64(ctx.Param("tournamentId")))
+
+	request.FinalResponse(ctx, err, response)
+}
+
+func (c *tournamentController) GetStandings(ctx *gin.Context) {
+	err, standings := c.tournamentService.GetStandings(helper.ConvertToUInt64(ctx.Param("tournamentId")))
+
+	request.FinalResponse(ctx, err, map[string]interface{}{
+		"standings": standings,
+	})
+}
+
+func (c *tournamentController) Create(ctx *gin.Context) {
+	userId := helper.GetUserId(ctx)
+
+	var err error
+	var tournament entity.Tournament
+	var body dto.TournamentBodyDTO
+
+	if err = ctx.ShouldBindJSON(&body); err != nil {
+		request.FinalResponse(ctx, err, nil)
+		return
+	}
+
+	if userId != 0 {
+		err, tournament = c.tournamentService.Create(userId, body)
+	}
+
+	request.FinalResponse(ctx, err, tournament)
+}
+
+func (c *tournamentController) Join(ctx *gin.Context) {
+	userId := helper.GetUserId(ctx)
+	username := ctx.GetString("name")
+	tournamentId := helper.ConvertToUInt64(ctx.Param("tournamentId"))
+
+	if !c.mutex.LockMethodRace("TournamentJoin", tournamentId, time.Second) {
+		request.TooManyRequests(ctx)
+		return
+	}
+
+	var err error
+	var tournament entity.Tournament
+
+	if userId != 0 {
+		err, tournament = c.tournamentService.Join(tournamentId, userId, username)
+	}
+
+	request.FinalResponse(ctx, err, tournament)
+}
+
+func (c *tournamentController) Leave(ctx *gin.Context) {
+	userId := helper.GetUserId(ctx)
+	tournamentId := helper.ConvertToUInt64(ctx.Param("tournamentId"))
+
+	var err error
+	var tournament entity.Tournament
+
+	if userId != 0 {
+		err, tournament = c.tournamentService.Leave(tournamentId, userId)
+	}
+
+	request.FinalResponse(ctx, err, tournament)
+}
+
+func (c *tournamentController) Cancel(ctx *gin.Context) {
+	userId := helper.GetUserId(ctx)
+	tournamentId := helper.ConvertToUInt64(ctx.Param("tournamentId"))
+
+	var err error
+	var tournament entity.Tournament
+
+	if userId != 0 {
+		err, tournament = c.tournamentService.Cancel(tournamentId, userId)
+	}
+
+	request.FinalResponse(ctx, err, tournament)
+}
+
+func (c *tournamentController) StartRound(ctx *gin.Context) {
+	userId := helper.GetUserId(ctx)
+	username := ctx.GetString("name")
+	tournamentId := helper.ConvertToUInt64(ctx.Param("tournamentId"))
+
+	if !c.mutex.LockMethodRace("TournamentRound", tournamentId, time.Second*5) {
+		request.TooManyRequests(ctx)
+		return
+	}
+
+	var err error
+	var tables []entity.TournamentTable
+
+	if userId != 0 {
+		err, tables = c.tournamentService.StartRound(tournamentId, userId, username)
+	}
+
+	request.FinalResponse(ctx, err, map[string]interface{}{
+		"tables": tables,
+	})
+}
+
+func (c *tournamentController) CloseTable(ctx *gin.Context) {
+	userId := helper.GetUserId(ctx)
+	tournamentId := helper.ConvertToUInt64(ctx.Param("tournamentId"))
+	tableId := helper.ConvertToUInt64(ctx.Param("tableId"))
+
+	if !c.mutex.LockMethodRace("TournamentRound", tournamentId, time.Second*5) {
+		request.TooManyRequests(ctx)
+		return
+	}
+
+	var err error
+	var table entity.TournamentTable
+
+	if userId != 0 {
+		err, table = c.tournamentService.CloseTable(tournamentId, tableId, userId)
+	}
+
+	request.FinalResponse(ctx, err, table)
+}
@@ -0,0 +1,146 @@
+package controller
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/webjohny/cashflow-go/dto"
+	"github.com/webjohny/cashflow-go/entity"
+	"github.com/webjohny/cashflow-go/helper"
+	"github.com/webjohny/cashflow-go/objects"
+	"github.com/webjohny/cashflow-go/request"
+	"github.com/webjohny/cashflow-go/service"
+	"strconv"
+	"time"
+)
+
+type TradeController interface {
+	GetOffers(ctx *gin.Context)
+	Propose(ctx *gin.Context)
+	Counter(ctx *gin.Context)
+	Accept(ctx *gin.Context)
+	Decline(ctx *gin.Context)
+	Cancel(ctx *gin.Context)
+}
+
+type tradeController struct {
+	tradeService service.TradeService
+	mutex        *objects.MutexMap
+}
+
+func NewTradeController(tradeService service.TradeService) TradeController {
+	return &tradeController{
+		tradeService: tradeService,
+		mutex:        &objects.MutexMap{},
+	}
+}
+
+func (c *tradeController) GetOffers(ctx *gin.Context) {
+	raceId := helper.GetRaceId(ctx)
+	userId := helper.GetUserId(ctx)
+
+	var err error
+	var offers []entity.TradeOffer
+
+	if raceId != 0 && userId != 0 {
+		err, offers = c.tradeService.GetOffers(raceId, userId)
+	}
+
+	request.FinalResponse(ctx, err, map[string]interface{}{
+		"offers": offers,
+	})
+}
+
+func (c *tradeController) Propose(ctx *gin.Context) {
+	raceId := helper.GetRaceId(ctx)
+	userId := helper.GetUserId(ctx)
+
+	var err error
+	var body dto.TradeOfferBodyDTO
+	var offer entity.TradeOffer
+
+	if err = ctx.ShouldBindJSON(&body); err != nil {
+		request.FinalResponse(ctx, err, nil)
+		return
+	}
+
+	if raceId != 0 && userId != 0 {
+		err, offer = c.tradeService.Propose(raceId, userId, body)
+	}
+
+	request.FinalResponse(ctx, err, offer)
+}
+
+func (c *tradeController) Counter(ctx *gin.Context) {
+	raceId := helper.GetRaceId(ctx)
+	userId := helper.GetUserId(ctx)
+	offerId, _ := strconv.ParseUint(ctx.Param("offerId"), 10, 64)
+
+	if !c.mutex.LockMethodRace("TradeCounter", raceId, time.Second) {
+		request.TooManyRequests(ctx)
+		return
+	}
+
+	var err error
+	var body dto.TradeOfferBodyDTO
+	var offer entity.TradeOffer
+
+	if err = ctx.ShouldBindJSON(&body); err != nil {
+		request.FinalResponse(ctx, err, nil)
+		return
+	}
+
+	if raceId != 0 && userId != 0 {
+		err, offer = c.tradeService.Counter(raceId, userId, offerId, body)
+	}
+
+	request.FinalResponse(ctx, err, offer)
+}
+
+func (c *tradeController) Accept(ctx *gin.Context) {
+	raceId := helper.GetRaceId(ctx)
+	userId := helper.GetUserId(ctx)
+	offerId, _ := strconv.ParseUint(ctx.Param("offerId"), 10, 64)
+
+	if !c.mutex.LockMethodRace("TradeAccept", raceId, time.Second) {
+		request.TooManyRequests(ctx)
+		return
+	}
+
+	var err error
+	var offer entity.TradeOffer
+
+	if raceId != 0 && userId != 0 {
+		err, offer = c.tradeService.Accept(raceId, userId, offerId)
+	}
+
+	request.FinalResponse(ctx, err, offer)
+}
+
+func (c *tradeController) Decline(ctx *gin.Context) {
+	raceId := helper.GetRaceId(ctx)
+	userId := helper.GetUserId(ctx)
+	offerId, _ := strconv.ParseUint(ctx.Param("offerId"), 10, 64)
+
+	var err error
+	var offer entity.TradeOffer
+
+	if raceId != 0 && userId != 0 {
+		err, offer = c.tradeService.Decline(raceId, userId, offerId)
+	}
+
+	request.FinalResponse(ctx, err, offer)
+}
+
+func (c *tradeController) Cancel(ctx *gin.Context) {
+	raceId := helper.GetRaceId(ctx)
+	userId := helper.GetUserId(ctx)
+	offerId, _ := strconv.ParseUint(ctx.Param("offerId"), 10, 64)
+
+	var err error
+	var offer entity.TradeOffer
+
+	if raceId != 0 && userId != 0 {
+		err, offer = c.tradeService.Cancel(raceId, userId, offerId)
+	}
+
+	request.FinalResponse(ctx, err, offer)
+}
@@ -0,0 +1,81 @@
+package controller
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/webjohny/cashflow-go/dto"
+	"github.com/webjohny/cashflow-go/entity"
+	"github.com/webjohny/cashflow-go/helper"
+	"github.com/webjohny/cashflow-go/objects"
+	"github.com/webjohny/cashflow-go/request"
+	"github.com/webjohny/cashflow-go/service"
+	"time"
+)
+
+type UserRequestController interface {
+	GetUserRequests(ctx *gin.Context)
+	Create(ctx *gin.Context)
+	Cancel(ctx *gin.Context)
+}
+
+type userRequestController struct {
+	userRequestService service.UserRequestService
+	mutex              *objects.MutexMap
+}
+
+func NewUserRequestController(userRequestService service.UserRequestService) UserRequestController {
+	return &userRequestController{
+		userRequestService: userRequestService,
+		mutex:              &objects.MutexMap{},
+	}
+}
+
+func (c *userRequestController) GetUserRequests(ctx *gin.Context) {
+	raceId := helper.GetRaceId(ctx)
+	userId := helper.GetUserId(ctx)
+
+	var err error
+
+	request.FinalResponse(ctx, err, map[string]interface{}{
+		"userRequests": c.userRequestService.GetAllByPlayer(raceId, userId),
+	})
+}
+
+func (c *userRequestController) Create(ctx *gin.Context) {
+	raceId := helper.GetRaceId(ctx)
+	userId := helper.GetUserId(ctx)
+
+	if !c.mutex.LockMethodRace("CreateUserRequest", raceId, time.Second) {
+		request.TooManyRequests(ctx)
+		return
+	}
+
+	var body dto.UserRequestBodyDTO
+	var err error
+	var userRequest entity.UserRequest
+
+	if err = ctx.ShouldBindJSON(&body); err != nil {
+		request.FinalResponse(ctx, err, nil)
+		return
+	}
+
+	if raceId != 0 && userId != 0 {
+		err, userRequest = c.userRequestService.Create(raceId, userId, body)
+	}
+
+	request.FinalResponse(ctx, err, map[string]interface{}{
+		"userRequest": userRequest,
+	})
+}
+
+func (c *userRequestController) Cancel(ctx *gin.Context) {
+	raceId := helper.GetRaceId(ctx)
+	userId := helper.GetUserId(ctx)
+
+	var err error
+
+	if raceId != 0 && userId != 0 {
+		err = c.userRequestService.Cancel(raceId, userId, helper.ConvertToUInt64(ctx.Param("userRequestId")))
+	}
+
+	request.FinalResponse(ctx, err, nil)
+}
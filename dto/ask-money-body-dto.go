@@ -4,4 +4,6 @@ type AskMoneyBodyDto struct {
 	Amount  int    `json:"amount" form:"amount"`
 	Message string `json:"message" form:"message"`
 	Type    string `json:"type" form:"type"`
+	// Paydays is the number of paydays a salary request covers, worked out from the amount when it is omitted.
+	Paydays int `json:"paydays" form:"paydays"`
 }
@@ -0,0 +1,6 @@
+package dto
+
+type AuctionBidBodyDTO struct {
+	Amount int `json:"amount" form:"amount" binding:"required"`
+	Count  int `json:"count" form:"count"`
+}
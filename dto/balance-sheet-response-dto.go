@@ -0,0 +1,8 @@
+package dto
+
+import "github.com/webjohny/cashflow-go/entity"
+
+type BalanceSheetResponseDTO struct {
+	entity.BalanceSheet
+	History []entity.NetWorthPoint `json:"history"`
+}
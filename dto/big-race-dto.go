@@ -0,0 +1,14 @@
+package dto
+
+import "github.com/webjohny/cashflow-go/entity"
+
+type BigRaceConditionsBodyDTO struct {
+	Conditions entity.BigRaceConditions `json:"conditions" form:"conditions"`
+	Reason     string                   `json:"reason" form:"reason"`
+}
+
+type BigRaceProgressResponseDTO struct {
+	Rules     []entity.BigRaceRuleProgress `json:"rules"`
+	Allowed   bool                         `json:"allowed"`
+	OnBigRace bool                         `json:"on_big_race"`
+}
@@ -0,0 +1,29 @@
+package dto
+
+import "github.com/webjohny/cashflow-go/entity"
+
+type CardDeckDTO struct {
+	Cards []entity.Card `json:"cards"`
+	// Order holds the indexes of the cards in play in the order they are drawn.
+	Order  []int `json:"order"`
+	Active int   `json:"active"`
+}
+
+type UpcomingCardDTO struct {
+	Index int         `json:"index"`
+	Card  entity.Card `json:"card"`
+}
+
+type DealCardBodyDTO struct {
+	Card   int    `json:"card" form:"card" binding:"min=0"`
+	Reason string `json:"reason" form:"reason"`
+}
+
+type ShuffleDeckBodyDTO struct {
+	Reason string `json:"reason" form:"reason"`
+}
+
+type RemoveCardsBodyDTO struct {
+	Cards  []int  `json:"cards" form:"cards" binding:"required,min=1"`
+	Reason string `json:"reason" form:"reason"`
+}
@@ -0,0 +1,17 @@
+package dto
+
+import "github.com/webjohny/cashflow-go/entity"
+
+type SendChatMessageBodyDTO struct {
+	Message string `json:"message" form:"message" binding:"required"`
+}
+
+type ReactChatMessageBodyDTO struct {
+	Emoji string `json:"emoji" form:"emoji" binding:"required"`
+}
+
+type GetChatMessagesResponseDTO struct {
+	Messages   []entity.ChatMessage `json:"messages"`
+	NextCursor uint64               `json:"next_cursor"`
+	HasMore    bool                 `json:"has_more"`
+}
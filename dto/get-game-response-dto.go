@@ -14,6 +14,8 @@ type GetGameResponseDTO struct {
 	DiceValues        []int                      `json:"dice_values"`
 	CurrentPlayer     *GetRacePlayerResponseDTO  `json:"current_player"`
 	CurrentCard       *entity.Card               `json:"current_card"`
+	Auction           *entity.RaceAuction        `json:"auction,omitempty"`
+	PendingRoll       *entity.RacePendingRoll    `json:"pending_roll,omitempty"`
 	GameId            uint64                     `json:"game_id"`
 	IsMultiFlow       bool                       `json:"is_multi_flow"`
 	IsTurnEnded       bool                       `json:"is_turn_ended"`
@@ -29,6 +31,8 @@ type GetRaceResponseDTO struct {
 	DiceValues    []int                      `json:"dice_values"`
 	CurrentPlayer GetRacePlayerResponseDTO   `json:"current_player"`
 	CurrentCard   entity.Card                `json:"current_card"`
+	Auction       *entity.RaceAuction        `json:"auction,omitempty"`
+	PendingRoll   *entity.RacePendingRoll    `json:"pending_roll,omitempty"`
 	Options       entity.RaceOptions         `json:"options,omitempty"`
 	GameId        uint64                     `json:"game_id"`
 	IsMultiFlow   bool                       `json:"is_multi_flow"`
@@ -46,5 +50,6 @@ type GetLobbyResponseDTO struct {
 	LobbyId  uint64               `json:"lobby_id"`
 	Options  entity.RaceOptions   `json:"options"`
 	Players  []entity.LobbyPlayer `json:"players"`
+	Teams    []entity.LobbyTeam   `json:"teams,omitempty"`
 	Status   string               `json:"status"`
 }
@@ -4,4 +4,6 @@ type HandleUserRequestBodyDto struct {
 	UserRequestId uint64 `json:"userRequestId"`
 	Status        int    `json:"status"`
 	Message       string `json:"message"`
+	// Amount approves the request partially, the requested amount is used when it is zero.
+	Amount int `json:"amount" binding:"min=0"`
 }
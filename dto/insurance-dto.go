@@ -0,0 +1,15 @@
+package dto
+
+import "github.com/webjohny/cashflow-go/entity"
+
+type BuyInsuranceBodyDTO struct {
+	// Type is one of property, income or business.
+	Type string `json:"type" form:"type"`
+}
+
+type PlayerInsurancesResponseDTO struct {
+	Products   []entity.InsuranceProduct `json:"products"`
+	Insurances []entity.PlayerInsurance  `json:"insurances"`
+	// Premiums is what the policies cost on every payday.
+	Premiums int `json:"premiums"`
+}
@@ -0,0 +1,23 @@
+package dto
+
+import "github.com/webjohny/cashflow-go/entity"
+
+type MarketResponseDTO struct {
+	Symbols []entity.MarketSymbol `json:"symbols"`
+}
+
+type PortfolioHoldingDTO struct {
+	Symbol string `json:"symbol"`
+	Count  int    `json:"count"`
+	Cost   int    `json:"cost"`
+	Price  int    `json:"price"`
+	Value  int    `json:"value"`
+	Gain   int    `json:"gain"`
+}
+
+type PortfolioResponseDTO struct {
+	Holdings []PortfolioHoldingDTO `json:"holdings"`
+	Cost     int                   `json:"cost"`
+	Value    int                   `json:"value"`
+	Gain     int                   `json:"gain"`
+}
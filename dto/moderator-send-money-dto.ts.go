@@ -4,4 +4,5 @@ type ModeratorSendMoneyDTO struct {
 	Message string `json:"message" form:"message"`
 	Amount  int    `json:"amount" form:"amount"`
 	Player  int    `json:"player" form:"player"`
+	Reason  string `json:"reason,omitempty" form:"reason"`
 }
@@ -20,6 +20,7 @@ type ModeratorUpdatePlayerDto struct {
 	Other           map[string]entity.CardOtherAssets `json:"other,omitempty" binding:"omitempty,dive"`
 	Expenses        ModeratorUpdatePlayerExpenseDto   `json:"expenses" binding:"required"`
 	Liabilities     ModeratorUpdatePlayerLiabilityDto `json:"liabilities" binding:"required"`
+	Reason          string                            `json:"reason,omitempty"`
 }
 
 type ModeratorUpdatePlayerAssetDto struct {
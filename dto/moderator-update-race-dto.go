@@ -11,4 +11,5 @@ type ModeratorUpdateRaceDto struct {
 	EnableCardCategory bool   `json:"enable_card_category" binding:"boolean"`
 	CurrentPlayer      int    `json:"current_player" binding:"numeric"`
 	Responses          []bool `json:"responses" binding:"required"`
+	Reason             string `json:"reason,omitempty"`
 }
@@ -2,4 +2,5 @@ package dto
 
 type ModeratorUpdateStatusRaceDto struct {
 	Status string `json:"status" binding:"required"`
+	Reason string `json:"reason,omitempty"`
 }
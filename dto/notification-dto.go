@@ -0,0 +1,29 @@
+package dto
+
+import (
+	"github.com/webjohny/cashflow-go/entity"
+	"time"
+)
+
+type NotificationDTO struct {
+	entity.Notification
+	Read   bool       `json:"read"`
+	ReadAt *time.Time `json:"read_at,omitempty"`
+}
+
+type GetNotificationsResponseDTO struct {
+	Notifications []NotificationDTO `json:"notifications"`
+	Unread        int               `json:"unread"`
+	NextCursor    uint64            `json:"next_cursor"`
+	HasMore       bool              `json:"has_more"`
+}
+
+// SendNotificationBodyDTO targets a single player when PlayerId is set, the players of a role when Role is set,
+// otherwise everyone in the race.
+type SendNotificationBodyDTO struct {
+	PlayerId uint64                 `json:"playerId" form:"playerId"`
+	Role     string                 `json:"role" form:"role"`
+	Type     string                 `json:"type" form:"type" binding:"required"`
+	Message  string                 `json:"message" form:"message" binding:"required"`
+	Params   map[string]interface{} `json:"params" form:"params"`
+}
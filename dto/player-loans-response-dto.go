@@ -0,0 +1,21 @@
+package dto
+
+import "github.com/webjohny/cashflow-go/entity"
+
+// LoanScheduleLimit is how many paydays of an interest-only loan the schedule projects.
+const LoanScheduleLimit = 12
+
+type PlayerLoanDTO struct {
+	entity.PlayerLoan
+	Payment  int                  `json:"payment"`
+	Schedule []entity.LoanPayment `json:"schedule"`
+}
+
+type PlayerLoansResponseDTO struct {
+	Policy  entity.LoanPolicy `json:"policy"`
+	Balance int               `json:"balance"`
+	Payment int               `json:"payment"`
+	// Limit is how much can still be borrowed, -1 without a cap.
+	Limit int             `json:"limit"`
+	Loans []PlayerLoanDTO `json:"loans"`
+}
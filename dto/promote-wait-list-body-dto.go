@@ -0,0 +1,5 @@
+package dto
+
+type PromoteWaitListBodyDTO struct {
+	UserId uint64 `json:"userId" form:"userId" binding:"required"`
+}
@@ -79,4 +79,5 @@ type GetRacePlayerResponseDTO struct {
 	HasBankrupt       bool                            `json:"has_bankrupt"`
 	AboutToBankrupt   string                          `json:"about_to_bankrupt"`
 	HasMlm            bool                            `json:"has_mlm"`
+	Team              *entity.PlayerTeam              `json:"team,omitempty"`
 }
@@ -0,0 +1,19 @@
+package dto
+
+import "github.com/webjohny/cashflow-go/entity"
+
+type RaceTemplateBodyDTO struct {
+	Name        string             `json:"name" form:"name" binding:"required,max=100"`
+	Description string             `json:"description" form:"description" binding:"max=500"`
+	Options     entity.RaceOptions `json:"options" form:"options"`
+	IsShared    bool               `json:"isShared" form:"isShared"`
+}
+
+// CreateLobbyBodyDTO is optional, the lobby starts with the options of the template when its code is given.
+type CreateLobbyBodyDTO struct {
+	TemplateCode string `json:"templateCode" form:"templateCode"`
+}
+
+type ApplyRaceTemplateBodyDTO struct {
+	TemplateCode string `json:"templateCode" form:"templateCode" binding:"required"`
+}
@@ -4,4 +4,16 @@ type RollDiceDto struct {
 	IsFinished bool `json:"is_finished"`
 	Dices      int  `json:"dices"`
 	DiceValue  int  `json:"dice_value,omitempty"`
+	// DiceValues are the faces of the physical dice entered in hand mode.
+	DiceValues []int `json:"dice_values,omitempty"`
+}
+
+// ConfirmRollBodyDTO accepts the pending roll, Dice corrects the faces the player entered when set.
+type ConfirmRollBodyDTO struct {
+	Dice   []int  `json:"dice" form:"dice"`
+	Reason string `json:"reason" form:"reason"`
+}
+
+type RejectRollBodyDTO struct {
+	Reason string `json:"reason" form:"reason"`
 }
@@ -1,5 +1,8 @@
 package dto
 
+import "github.com/webjohny/cashflow-go/entity"
+
+// SetOptionsLobbyRequestDTO changes the room settings of the lobby, the other options are kept.
 type SetOptionsLobbyRequestDTO struct {
 	HandMode    bool   `json:"handMode" binding:"boolean"`
 	MeetLink    string `json:"meetLink,omitempty"`
@@ -7,3 +10,8 @@ type SetOptionsLobbyRequestDTO struct {
 	BannerImage string `json:"bannerImage,omitempty"`
 	Language    string `json:"language,omitempty"`
 }
+
+// ReplaceOptionsLobbyRequestDTO replaces all the options of the lobby, so every knob of the race can be set before the start.
+type ReplaceOptionsLobbyRequestDTO struct {
+	entity.RaceOptions
+}
@@ -0,0 +1,7 @@
+package dto
+
+type SharedAssetBuyoutBodyDTO struct {
+	SellerId uint64 `json:"sellerId" form:"sellerId"`
+	Percent  int    `json:"percent" form:"percent"`
+	Price    int    `json:"price" form:"price"`
+}
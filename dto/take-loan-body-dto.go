@@ -3,3 +3,9 @@ package dto
 type TakeLoanBodyDTO struct {
 	Amount int `json:"amount" form:"amount"`
 }
+
+type PayLoanBodyDTO struct {
+	Amount int `json:"amount" form:"amount"`
+	// Liability is one of bankLoan (default), homeMortgage, schoolLoans, carLoans or creditCardDebt.
+	Liability string `json:"liability" form:"liability"`
+}
@@ -0,0 +1,11 @@
+package dto
+
+import "github.com/webjohny/cashflow-go/entity"
+
+type SetTeamsBodyDTO struct {
+	Teams []entity.LobbyTeam `json:"teams" form:"teams" binding:"dive"`
+}
+
+type TeamDecisionVoteBodyDTO struct {
+	Approve bool `json:"approve" form:"approve"`
+}
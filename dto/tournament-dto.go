@@ -0,0 +1,19 @@
+package dto
+
+import "github.com/webjohny/cashflow-go/entity"
+
+type TournamentBodyDTO struct {
+	Name      string             `json:"name" form:"name" binding:"required,max=100"`
+	Format    string             `json:"format" form:"format" binding:"required"`
+	AdvanceBy string             `json:"advance_by" form:"advance_by" binding:"required"`
+	TableSize int                `json:"table_size" form:"table_size" binding:"required"`
+	Advancing int                `json:"advancing" form:"advancing"`
+	Rounds    int                `json:"rounds" form:"rounds"`
+	Options   entity.RaceOptions `json:"options" form:"options"`
+}
+
+type TournamentResponseDTO struct {
+	Tournament entity.Tournament              `json:"tournament"`
+	Tables     []entity.TournamentTable       `json:"tables"`
+	Standings  []entity.TournamentParticipant `json:"standings"`
+}
@@ -0,0 +1,10 @@
+package dto
+
+import "github.com/webjohny/cashflow-go/entity"
+
+type TradeOfferBodyDTO struct {
+	PlayerId uint64             `json:"playerId" form:"playerId"`
+	Offer    entity.TradeBundle `json:"offer" form:"offer"`
+	Request  entity.TradeBundle `json:"request" form:"request"`
+	Message  string             `json:"message" form:"message"`
+}
@@ -0,0 +1,8 @@
+package dto
+
+type UserRequestBodyDTO struct {
+	Type    string                 `json:"type" form:"type" binding:"required"`
+	Amount  int                    `json:"amount" form:"amount" binding:"required,min=1"`
+	Message string                 `json:"message" form:"message"`
+	Data    map[string]interface{} `json:"data" form:"data"`
+}
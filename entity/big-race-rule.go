@@ -0,0 +1,100 @@
+package entity
+
+import (
+	"github.com/webjohny/cashflow-go/helper"
+	"sort"
+)
+
+var BigRaceRuleTypes = struct {
+	PassiveIncome string
+	CashFlow      string
+	CashMonths    string
+	Business      string
+	RealEstate    string
+	OtherAssets   string
+	Stocks        string
+	NoLiability   string
+}{
+	PassiveIncome: "passiveIncome",
+	CashFlow:      "cashFlow",
+	CashMonths:    "cashMonths",
+	Business:      "business",
+	RealEstate:    "realEstate",
+	OtherAssets:   "otherAssets",
+	Stocks:        "stocks",
+	NoLiability:   "noLiability",
+}
+
+var bigRaceRuleLiabilities = []string{"bankLoan", "homeMortgage", "schoolLoans", "carLoans", "creditCardDebt"}
+
+// BigRaceRule is one condition to enter the fast track, Count is the amount of assets, months of expenses
+// in cash or the cash flow it asks for depending on the type.
+type BigRaceRule struct {
+	Type      string `json:"type"`
+	Count     int    `json:"count,omitempty"`
+	Liability string `json:"liability,omitempty"`
+}
+
+type BigRaceRuleProgress struct {
+	BigRaceRule
+	Current  int  `json:"current"`
+	Required int  `json:"required"`
+	Done     bool `json:"done"`
+}
+
+func (r BigRaceRule) IsValid() bool {
+	if r.Count < 0 {
+		return false
+	}
+
+	switch r.Type {
+	case BigRaceRuleTypes.PassiveIncome, BigRaceRuleTypes.CashFlow, BigRaceRuleTypes.CashMonths,
+		BigRaceRuleTypes.Business, BigRaceRuleTypes.RealEstate, BigRaceRuleTypes.OtherAssets, BigRaceRuleTypes.Stocks:
+		return true
+	case BigRaceRuleTypes.NoLiability:
+		return r.Liability == "" || helper.Contains[string](bigRaceRuleLiabilities, r.Liability)
+	}
+
+	return false
+}
+
+// GetLiability returns the liability the rule forbids, a bank loan unless another one is set.
+func (r BigRaceRule) GetLiability() string {
+	if r.Liability == "" {
+		return "bankLoan"
+	}
+
+	return r.Liability
+}
+
+// GetRules returns the rules of the race, without them the old fields are turned into rules: a passive income
+// above the expenses, the months of expenses in cash and one asset of each required deal.
+func (c BigRaceConditions) GetRules() []BigRaceRule {
+	if len(c.Rules) > 0 {
+		return c.Rules
+	}
+
+	rules := []BigRaceRule{{Type: BigRaceRuleTypes.PassiveIncome}}
+
+	if c.CashPerMonths > 0 {
+		rules = append(rules, BigRaceRule{Type: BigRaceRuleTypes.CashMonths, Count: c.CashPerMonths})
+	}
+
+	deals := make([]string, 0)
+
+	for deal := range c.RequiredDeals {
+		deals = append(deals, deal)
+	}
+
+	sort.Strings(deals)
+
+	for _, deal := range deals {
+		rule := BigRaceRule{Type: deal, Count: 1}
+
+		if rule.IsValid() {
+			rules = append(rules, rule)
+		}
+	}
+
+	return rules
+}
@@ -57,6 +57,44 @@ func (c *Card) MultiUserFlow() bool {
 	return false
 }
 
+func (c *Card) IsAuctionable() bool {
+	if c.Name != "smallDeal" && c.Name != "bigDeal" {
+		return false
+	}
+
+	if c.Type == "stock" {
+		return c.AssetType != StockTypes.Manipulation
+	}
+
+	return c.Type == "business" || c.Type == "realEstate"
+}
+
+// GetCost returns what was paid for the holding, splits do not change it.
+func (c *CardStocks) GetCost() int {
+	cost := 0
+
+	for _, history := range c.History {
+		cost += history.Cost
+	}
+
+	if cost == 0 {
+		return c.Price * c.Count
+	}
+
+	return cost
+}
+
+// GetLastPrice returns the price of the last purchase, the card price without purchases.
+func (c *CardStocks) GetLastPrice() int {
+	for i := len(c.History) - 1; i >= 0; i-- {
+		if c.History[i].Price > 0 {
+			return c.History[i].Price
+		}
+	}
+
+	return c.Price
+}
+
 func (c *CardStocks) SetCardHistory(history CardHistory) {
 	history.SumCost()
 
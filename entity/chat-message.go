@@ -0,0 +1,64 @@
+package entity
+
+import (
+	"time"
+)
+
+var ChatMessageTypes = struct {
+	Message string
+	System  string
+}{
+	Message: "message",
+	System:  "system",
+}
+
+type ChatMessage struct {
+	ID        uint64                 `gorm:"primaryKey;autoIncrement" json:"id"`
+	RaceID    uint64                 `gorm:"index:idx_chat_race" json:"race_id"`
+	PlayerID  uint64                 `json:"player_id"`
+	UserID    uint64                 `json:"user_id"`
+	Username  string                 `gorm:"type:varchar(255)" json:"username"`
+	Color     string                 `gorm:"type:varchar(255)" json:"color"`
+	Type      string                 `gorm:"type:varchar(20)" json:"type"`
+	Message   string                 `gorm:"type:text" json:"message"`
+	Params    map[string]interface{} `gorm:"type:json;serializer:json" json:"params,omitempty"`
+	Reactions map[string][]uint64    `gorm:"type:json;serializer:json" json:"reactions"`
+	IsDeleted bool                   `gorm:"default:false" json:"is_deleted"`
+	DeletedBy uint64                 `json:"deleted_by,omitempty"`
+	CreatedAt time.Time              `gorm:"column:created_at;type:datetime;default:current_timestamp;not null" json:"created_at"`
+}
+
+func (m *ChatMessage) IsSystem() bool {
+	return m.Type == ChatMessageTypes.System
+}
+
+func (m *ChatMessage) ToggleReaction(emoji string, userId uint64) {
+	if m.Reactions == nil {
+		m.Reactions = make(map[string][]uint64)
+	}
+
+	users := m.Reactions[emoji]
+
+	for i, id := range users {
+		if id == userId {
+			users = append(users[:i], users[i+1:]...)
+
+			if len(users) == 0 {
+				delete(m.Reactions, emoji)
+			} else {
+				m.Reactions[emoji] = users
+			}
+
+			return
+		}
+	}
+
+	m.Reactions[emoji] = append(users, userId)
+}
+
+func (m *ChatMessage) Delete(userId uint64) {
+	m.IsDeleted = true
+	m.DeletedBy = userId
+	m.Message = ""
+	m.Reactions = make(map[string][]uint64)
+}
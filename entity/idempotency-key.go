@@ -0,0 +1,14 @@
+package entity
+
+import "time"
+
+// IdempotencyKey stores the response of a request sent with an Idempotency-Key header, so a retry gets
+// the same response instead of running the operation again.
+type IdempotencyKey struct {
+	ID          uint64    `gorm:"primary_key:auto_increment" json:"id"`
+	Key         string    `gorm:"uniqueIndex;type:varchar(300)" json:"key"`
+	Fingerprint string    `gorm:"type:varchar(64)" json:"fingerprint"`
+	Status      int       `gorm:"type:int(3)" json:"status"`
+	Body        []byte    `gorm:"type:mediumblob" json:"body"`
+	CreatedAt   time.Time `gorm:"column:created_at;type:datetime;default:CURRENT_TIMESTAMP();not null" json:"created_at"`
+}
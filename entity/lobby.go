@@ -22,6 +22,14 @@ type LobbyPlayer struct {
 	Color    string `json:"color"`
 }
 
+func (p *LobbyPlayer) IsSpectator() bool {
+	return p.Role == PlayerRoles.Spectator
+}
+
+func (p *LobbyPlayer) CanWatch() bool {
+	return p.Role == PlayerRoles.Spectator || p.Role == PlayerRoles.WaitList
+}
+
 type Lobby struct {
 	ID         uint64        `gorm:"primary_key:auto_increment" json:"id"`
 	GameId     uint64        `gorm:"index;type:int(11)" json:"game_id"`
@@ -29,6 +37,7 @@ type Lobby struct {
 	MaxPlayers int8          `gorm:"max_players:int(3)" json:"max_players"`
 	Status     string        `gorm:"status;type:enum('new','started','cancelled')" json:"status"`
 	Options    RaceOptions   `gorm:"type:json;serializer:json" json:"options"`
+	Teams      []LobbyTeam   `gorm:"type:json;serializer:json" json:"teams"`
 	CreatedAt  time.Time     `gorm:"column:created_at;type:datetime;default:current_timestamp;not null" json:"created_at"`
 }
 
@@ -90,6 +99,18 @@ func (l *Lobby) CountPlayers() int {
 	return len(l.Players)
 }
 
+func (l *Lobby) CountParticipants() int {
+	var count int
+
+	for _, player := range l.Players {
+		if !player.IsSpectator() {
+			count++
+		}
+	}
+
+	return count
+}
+
 func (l *Lobby) AddWaitList(userId uint64, username string) {
 	l.AddPlayer(userId, username, PlayerRoles.WaitList)
 }
@@ -98,6 +119,10 @@ func (l *Lobby) AddGuest(userId uint64, username string) {
 	l.AddPlayer(userId, username, PlayerRoles.Guest)
 }
 
+func (l *Lobby) AddSpectator(userId uint64, username string) {
+	l.AddPlayer(userId, username, PlayerRoles.Spectator)
+}
+
 func (l *Lobby) AddOwner(userId uint64, username string) {
 	l.AddPlayer(userId, username, PlayerRoles.Owner)
 }
@@ -116,8 +141,83 @@ func (l *Lobby) GetPlayer(userId uint64) LobbyPlayer {
 	return LobbyPlayer{}
 }
 
+// GetTeam returns the team the user plays in, nil when the user has no team.
+func (l *Lobby) GetTeam(userId uint64) *LobbyTeam {
+	for i := range l.Teams {
+		if helper.Contains[uint64](l.Teams[i].Members, userId) {
+			return &l.Teams[i]
+		}
+	}
+
+	return nil
+}
+
+// RemoveFromTeams takes the user out of its team, a team left without members is dropped and a team left
+// without its captain is led by the first remaining member.
+func (l *Lobby) RemoveFromTeams(userId uint64) {
+	teams := make([]LobbyTeam, 0)
+
+	for _, team := range l.Teams {
+		members := make([]uint64, 0)
+
+		for _, member := range team.Members {
+			if member != userId {
+				members = append(members, member)
+			}
+		}
+
+		if len(members) == 0 {
+			continue
+		}
+
+		team.Members = members
+
+		if team.CaptainID == userId {
+			team.CaptainID = members[0]
+		}
+
+		teams = append(teams, team)
+	}
+
+	l.Teams = teams
+}
+
+func (l *Lobby) GetWaitList() []LobbyPlayer {
+	players := make([]LobbyPlayer, 0)
+
+	for _, player := range l.Players {
+		if player.Role == PlayerRoles.WaitList {
+			players = append(players, player)
+		}
+	}
+
+	return players
+}
+
+func (l *Lobby) IsWaitListed(userId uint64) bool {
+	return l.GetPlayer(userId).Role == PlayerRoles.WaitList
+}
+
+func (l *Lobby) IsManagedBy(userId uint64) bool {
+	role := l.GetPlayer(userId).Role
+
+	return role == PlayerRoles.Owner || role == PlayerRoles.Moderator
+}
+
+func (l *Lobby) IsSpectator(userId uint64) bool {
+	player := l.GetPlayer(userId)
+
+	return player.IsSpectator()
+}
+
+func (l *Lobby) CanWatch(userId uint64) bool {
+	player := l.GetPlayer(userId)
+
+	return player.CanWatch()
+}
+
 func (l *Lobby) IsFull() bool {
-	return len(l.Players) == int(l.MaxPlayers)
+	return l.CountParticipants() >= int(l.MaxPlayers)
 }
 
 func (l *Lobby) IsStarted() bool {
@@ -143,7 +243,7 @@ func (l *Lobby) AvailableToStart() bool {
 
 	for i := 0; i < len(l.Players); i++ {
 		player := l.Players[i]
-		if player.Role != PlayerRoles.Moderator {
+		if player.Role != PlayerRoles.Moderator && !player.IsSpectator() {
 			count++
 		}
 	}
@@ -164,4 +264,6 @@ func (l *Lobby) RemovePlayer(userId uint64) {
 	if index != -1 {
 		l.Players = append(l.Players[:index], l.Players[index+1:]...)
 	}
+
+	l.RemoveFromTeams(userId)
 }
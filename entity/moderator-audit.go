@@ -0,0 +1,44 @@
+package entity
+
+import (
+	"github.com/webjohny/cashflow-go/helper"
+	"time"
+)
+
+var ModeratorAuditActions = struct {
+	UpdatePlayer      string
+	UpdateRace        string
+	UpdateStatusRace  string
+	SendMoney         string
+	HandleUserRequest string
+	DealCard          string
+	ShuffleDeck       string
+	RemoveCards       string
+	BigRaceConditions string
+	ConfirmRoll       string
+	RejectRoll        string
+}{
+	UpdatePlayer:      "updatePlayer",
+	UpdateRace:        "updateRace",
+	UpdateStatusRace:  "updateStatusRace",
+	SendMoney:         "sendMoney",
+	HandleUserRequest: "handleUserRequest",
+	DealCard:          "dealCard",
+	ShuffleDeck:       "shuffleDeck",
+	RemoveCards:       "removeCards",
+	BigRaceConditions: "bigRaceConditions",
+	ConfirmRoll:       "confirmRoll",
+	RejectRoll:        "rejectRoll",
+}
+
+// ModeratorAudit records a manual intervention of a moderator with the changes it made to the player or the race.
+type ModeratorAudit struct {
+	ID          uint64              `gorm:"primaryKey;autoIncrement" json:"id"`
+	RaceID      uint64              `gorm:"index:idx_moderator_audit" json:"race_id"`
+	PlayerID    uint64              `gorm:"index:idx_moderator_audit" json:"player_id,omitempty"`
+	ModeratorID uint64              `json:"moderator_id"`
+	Action      string              `gorm:"type:varchar(50)" json:"action"`
+	Reason      string              `gorm:"type:varchar(500)" json:"reason"`
+	Changes     []helper.JsonChange `gorm:"type:json;serializer:json" json:"changes"`
+	CreatedAt   time.Time           `gorm:"column:created_at;type:datetime;default:current_timestamp;not null" json:"created_at"`
+}
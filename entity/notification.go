@@ -0,0 +1,49 @@
+package entity
+
+import (
+	"time"
+)
+
+// Notification is kept for the players of the race it targets: a single player when PlayerID is set,
+// the players of a role when Role is set, otherwise everyone in the race.
+type Notification struct {
+	ID        uint64      `gorm:"primaryKey;autoIncrement" json:"id"`
+	UID       string      `gorm:"column:uid;type:varchar(64);uniqueIndex" json:"uid"`
+	RaceID    uint64      `gorm:"index:idx_notification_target" json:"race_id"`
+	PlayerID  uint64      `gorm:"index:idx_notification_target" json:"player_id,omitempty"`
+	Role      string      `gorm:"type:varchar(20)" json:"role,omitempty"`
+	Type      string      `gorm:"type:varchar(20)" json:"type"`
+	Message   string      `gorm:"type:text" json:"message"`
+	Params    interface{} `gorm:"type:json;serializer:json" json:"params,omitempty"`
+	CreatedAt time.Time   `gorm:"column:created_at;type:datetime;default:current_timestamp;not null" json:"created_at"`
+}
+
+// NotificationReceipt marks the notification as read by the player.
+type NotificationReceipt struct {
+	NotificationID uint64    `gorm:"primaryKey" json:"notification_id"`
+	PlayerID       uint64    `gorm:"primaryKey" json:"player_id"`
+	ReadAt         time.Time `gorm:"type:datetime" json:"read_at"`
+}
+
+func (n *Notification) IsFor(player Player) bool {
+	if n.RaceID != player.RaceID {
+		return false
+	}
+
+	if n.PlayerID != 0 && n.PlayerID != player.ID {
+		return false
+	}
+
+	return n.Role == "" || n.Role == player.Role
+}
+
+func NewPlayerNotification(player Player, notification PlayerNotification) Notification {
+	return Notification{
+		UID:      notification.ID,
+		RaceID:   player.RaceID,
+		PlayerID: player.ID,
+		Type:     notification.Type,
+		Message:  notification.Message,
+		Params:   notification.Params,
+	}
+}
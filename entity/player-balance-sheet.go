@@ -0,0 +1,142 @@
+package entity
+
+import "time"
+
+// NetWorthHistoryLimit is how many net worth snapshots are kept per player.
+const NetWorthHistoryLimit = 200
+
+var BalanceSheetClasses = struct {
+	Cash        string
+	Savings     string
+	Stocks      string
+	RealEstate  string
+	Business    string
+	OtherAssets string
+	Dream       string
+	Mortgage    string
+	Liability   string
+}{
+	Cash:        "cash",
+	Savings:     "savings",
+	Stocks:      "stocks",
+	RealEstate:  "realEstate",
+	Business:    "business",
+	OtherAssets: "otherAssets",
+	Dream:       "dream",
+	Mortgage:    "mortgage",
+	Liability:   "liability",
+}
+
+type BalanceSheetItem struct {
+	Class  string `json:"class"`
+	ID     string `json:"id,omitempty"`
+	Symbol string `json:"symbol,omitempty"`
+	Value  int    `json:"value"`
+}
+
+type BalanceSheet struct {
+	Assets           []BalanceSheetItem `json:"assets"`
+	Liabilities      []BalanceSheetItem `json:"liabilities"`
+	TotalAssets      int                `json:"total_assets"`
+	TotalLiabilities int                `json:"total_liabilities"`
+	NetWorth         int                `json:"net_worth"`
+	CashFlow         int                `json:"cash_flow"`
+	PassiveIncome    int                `json:"passive_income"`
+}
+
+type NetWorthPoint struct {
+	NetWorth    int       `json:"net_worth"`
+	Assets      int       `json:"assets"`
+	Liabilities int       `json:"liabilities"`
+	Cash        int       `json:"cash"`
+	CashFlow    int       `json:"cash_flow"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+// ownedShare scales a whole-asset amount to the player's ownership percent, 0 meaning sole ownership.
+func ownedShare(amount int, percent int) int {
+	if percent <= 0 || percent >= 100 {
+		return amount
+	}
+
+	return amount * percent / 100
+}
+
+// BalanceSheet values every asset class: stocks at the race market, real estate and businesses at cost with
+// their mortgages as liabilities, both scaled to the ownership percent, other assets per unit and dreams at cost.
+func (e *Player) BalanceSheet(market RaceMarket) BalanceSheet {
+	sheet := BalanceSheet{
+		CashFlow:      e.CalculateCashFlow(),
+		PassiveIncome: e.CalculatePassiveIncome(),
+	}
+
+	asset := func(item BalanceSheetItem) {
+		sheet.Assets = append(sheet.Assets, item)
+		sheet.TotalAssets += item.Value
+	}
+	liability := func(item BalanceSheetItem) {
+		if item.Value > 0 {
+			sheet.Liabilities = append(sheet.Liabilities, item)
+			sheet.TotalLiabilities += item.Value
+		}
+	}
+
+	asset(BalanceSheetItem{Class: BalanceSheetClasses.Cash, Value: e.Cash})
+
+	if e.Assets.Savings > 0 {
+		asset(BalanceSheetItem{Class: BalanceSheetClasses.Savings, Value: e.Assets.Savings})
+	}
+
+	for _, stock := range e.Assets.Stocks {
+		asset(BalanceSheetItem{Class: BalanceSheetClasses.Stocks, ID: stock.ID, Symbol: stock.Symbol, Value: market.Value(stock)})
+	}
+
+	for _, realEstate := range e.Assets.RealEstates {
+		asset(BalanceSheetItem{Class: BalanceSheetClasses.RealEstate, ID: realEstate.ID, Symbol: realEstate.Symbol, Value: ownedShare(realEstate.Cost, realEstate.Percent)})
+		liability(BalanceSheetItem{Class: BalanceSheetClasses.Mortgage, ID: realEstate.ID, Symbol: realEstate.Symbol, Value: ownedShare(realEstate.Mortgage, realEstate.Percent)})
+	}
+
+	for _, business := range e.Assets.Business {
+		asset(BalanceSheetItem{Class: BalanceSheetClasses.Business, ID: business.ID, Symbol: business.Symbol, Value: ownedShare(business.Cost, business.Percent)})
+		liability(BalanceSheetItem{Class: BalanceSheetClasses.Mortgage, ID: business.ID, Symbol: business.Symbol, Value: ownedShare(business.Mortgage, business.Percent)})
+	}
+
+	for _, other := range e.Assets.OtherAssets {
+		value := other.CostPerOne * other.Count
+
+		if other.CostPerOne == 0 {
+			value = other.Cost
+		}
+
+		asset(BalanceSheetItem{Class: BalanceSheetClasses.OtherAssets, ID: other.ID, Symbol: other.Symbol, Value: value})
+	}
+
+	for _, dream := range e.Assets.Dreams {
+		asset(BalanceSheetItem{Class: BalanceSheetClasses.Dream, ID: dream.ID, Value: dream.Cost})
+	}
+
+	for _, name := range []string{"homeMortgage", "schoolLoans", "carLoans", "creditCardDebt", "bankLoan"} {
+		liability(BalanceSheetItem{Class: BalanceSheetClasses.Liability, ID: name, Value: e.GetLiability(name)})
+	}
+
+	sheet.NetWorth = sheet.TotalAssets - sheet.TotalLiabilities
+
+	return sheet
+}
+
+func (e *Player) RecordNetWorth(market RaceMarket) {
+	sheet := e.BalanceSheet(market)
+
+	e.NetWorthHistory = append(e.NetWorthHistory, NetWorthPoint{
+		NetWorth:    sheet.NetWorth,
+		Assets:      sheet.TotalAssets,
+		Liabilities: sheet.TotalLiabilities,
+		Cash:        e.Cash,
+		CashFlow:    sheet.CashFlow,
+		CreatedAt:   time.Now(),
+	})
+
+	if len(e.NetWorthHistory) > NetWorthHistoryLimit {
+		e.NetWorthHistory = e.NetWorthHistory[len(e.NetWorthHistory)-NetWorthHistoryLimit:]
+	}
+}
@@ -0,0 +1,151 @@
+package entity
+
+import (
+	"github.com/webjohny/cashflow-go/helper"
+	"time"
+)
+
+var InsuranceTypes = struct {
+	Property string
+	Income   string
+	Business string
+}{
+	Property: "property",
+	Income:   "income",
+	Business: "business",
+}
+
+// InsuranceProduct is a policy the players of a race can buy. Property insurance covers damage market cards,
+// income insurance covers being downsized and business insurance covers assets lost to inflation.
+type InsuranceProduct struct {
+	Type    string `json:"type"`
+	Premium int    `json:"premium"`
+	// Coverage is the percent of a loss the insurer pays.
+	Coverage int `json:"coverage"`
+	// Limit is the most the insurer pays over the life of the policy.
+	Limit int `json:"limit"`
+}
+
+var DefaultInsuranceProducts = []InsuranceProduct{
+	{Type: InsuranceTypes.Property, Premium: 100, Coverage: 80, Limit: 5000},
+	{Type: InsuranceTypes.Income, Premium: 150, Coverage: 50, Limit: 10000},
+	{Type: InsuranceTypes.Business, Premium: 200, Coverage: 50, Limit: 20000},
+}
+
+type InsuranceClaim struct {
+	CardID    string    `json:"cardId"`
+	Loss      int       `json:"loss"`
+	Paid      int       `json:"paid"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+type PlayerInsurance struct {
+	ID           string           `json:"id"`
+	Type         string           `json:"type"`
+	Premium      int              `json:"premium"`
+	Coverage     int              `json:"coverage"`
+	Limit        int              `json:"limit"`
+	PremiumsPaid int              `json:"premiumsPaid"`
+	ClaimsPaid   int              `json:"claimsPaid"`
+	Claims       []InsuranceClaim `json:"claims"`
+	StartedAt    time.Time        `json:"startedAt"`
+}
+
+func NewPlayerInsurance(product InsuranceProduct) PlayerInsurance {
+	return PlayerInsurance{
+		ID:           helper.Uuid("insurance"),
+		Type:         product.Type,
+		Premium:      product.Premium,
+		Coverage:     product.Coverage,
+		Limit:        product.Limit,
+		PremiumsPaid: product.Premium,
+		Claims:       make([]InsuranceClaim, 0),
+		StartedAt:    time.Now(),
+	}
+}
+
+func (i *PlayerInsurance) Remaining() int {
+	if i.ClaimsPaid >= i.Limit {
+		return 0
+	}
+
+	return i.Limit - i.ClaimsPaid
+}
+
+// Cover returns how much of the loss the policy pays, capped by what is left of the limit.
+func (i *PlayerInsurance) Cover(loss int) int {
+	if loss <= 0 {
+		return 0
+	}
+
+	amount := loss * i.Coverage / 100
+
+	if amount > i.Remaining() {
+		amount = i.Remaining()
+	}
+
+	return amount
+}
+
+func FindInsuranceProduct(products []InsuranceProduct, insuranceType string) (InsuranceProduct, bool) {
+	for _, product := range products {
+		if product.Type == insuranceType {
+			return product, true
+		}
+	}
+
+	return InsuranceProduct{}, false
+}
+
+func (e *Player) FindInsurance(insuranceType string) (int, *PlayerInsurance) {
+	for i := range e.Insurances {
+		if e.Insurances[i].Type == insuranceType {
+			return i, &e.Insurances[i]
+		}
+	}
+
+	return -1, &PlayerInsurance{}
+}
+
+func (e *Player) RemoveInsurance(insuranceType string) {
+	if index, _ := e.FindInsurance(insuranceType); index != -1 {
+		e.Insurances = append(e.Insurances[:index], e.Insurances[index+1:]...)
+	}
+}
+
+func (e *Player) CalculateInsurancePremiums() int {
+	premiums := 0
+
+	for _, insurance := range e.Insurances {
+		premiums += insurance.Premium
+	}
+
+	return premiums
+}
+
+// InsuranceCover returns how much of the loss the player's policy of the type would pay.
+func (e *Player) InsuranceCover(insuranceType string, loss int) int {
+	_, insurance := e.FindInsurance(insuranceType)
+
+	return insurance.Cover(loss)
+}
+
+// ClaimInsurance records the claim on the player's policy of the type and returns the payout.
+func (e *Player) ClaimInsurance(insuranceType string, cardID string, loss int) int {
+	index, insurance := e.FindInsurance(insuranceType)
+
+	if index == -1 || loss <= 0 {
+		return 0
+	}
+
+	paid := insurance.Cover(loss)
+	insurance.ClaimsPaid += paid
+	insurance.Claims = append(insurance.Claims, InsuranceClaim{
+		CardID:    cardID,
+		Loss:      loss,
+		Paid:      paid,
+		CreatedAt: time.Now(),
+	})
+
+	return paid
+}
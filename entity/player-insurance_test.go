@@ -0,0 +1,57 @@
+package entity_test
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/webjohny/cashflow-go/entity"
+	"testing"
+)
+
+func TestPlayerInsuranceCover(t *testing.T) {
+	tests := []struct {
+		name       string
+		insurances []entity.PlayerInsurance
+		loss       int
+		expected   int
+	}{
+		{name: "no policy", loss: 1000, expected: 0},
+		{name: "another policy", insurances: []entity.PlayerInsurance{{Type: entity.InsuranceTypes.Income, Coverage: 50, Limit: 10000}}, loss: 1000, expected: 0},
+		{name: "the coverage of the loss", insurances: []entity.PlayerInsurance{{Type: entity.InsuranceTypes.Property, Coverage: 80, Limit: 5000}}, loss: 1000, expected: 800},
+		{name: "capped by the rest of the limit", insurances: []entity.PlayerInsurance{{Type: entity.InsuranceTypes.Property, Coverage: 80, Limit: 5000, ClaimsPaid: 4500}}, loss: 1000, expected: 500},
+		{name: "a used up limit", insurances: []entity.PlayerInsurance{{Type: entity.InsuranceTypes.Property, Coverage: 80, Limit: 5000, ClaimsPaid: 5000}}, loss: 1000, expected: 0},
+		{name: "no loss", insurances: []entity.PlayerInsurance{{Type: entity.InsuranceTypes.Property, Coverage: 80, Limit: 5000}}, loss: 0, expected: 0},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			player := entity.Player{Insurances: test.insurances}
+
+			assert.Equal(t, test.expected, player.InsuranceCover(entity.InsuranceTypes.Property, test.loss))
+		})
+	}
+}
+
+func TestPlayerClaimInsurance(t *testing.T) {
+	player := entity.Player{Insurances: []entity.PlayerInsurance{
+		entity.NewPlayerInsurance(entity.InsuranceProduct{Type: entity.InsuranceTypes.Property, Premium: 100, Coverage: 80, Limit: 5000}),
+	}}
+
+	claims := []struct {
+		loss int
+		paid int
+	}{
+		{loss: 4000, paid: 3200},
+		{loss: 4000, paid: 1800},
+		{loss: 4000, paid: 0},
+	}
+
+	for _, claim := range claims {
+		assert.Equal(t, claim.paid, player.ClaimInsurance(entity.InsuranceTypes.Property, "damage", claim.loss))
+	}
+
+	_, insurance := player.FindInsurance(entity.InsuranceTypes.Property)
+
+	assert.Equal(t, 5000, insurance.ClaimsPaid)
+	assert.Len(t, insurance.Claims, 3)
+	assert.Equal(t, 1800, insurance.Claims[1].Paid)
+	assert.Equal(t, 0, player.ClaimInsurance(entity.InsuranceTypes.Income, "downsized", 1000))
+}
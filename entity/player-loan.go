@@ -0,0 +1,241 @@
+package entity
+
+import (
+	"github.com/webjohny/cashflow-go/helper"
+	"time"
+)
+
+const (
+	DefaultLoanIncrement    = 1000
+	DefaultLoanInterestRate = 10
+)
+
+// LoanPolicy holds the bank loan rules of a race. Zero values fall back to the classic rules:
+// $1,000 increments, 10% per payday, interest-only loans without a cap.
+type LoanPolicy struct {
+	Increment    int `json:"increment,omitempty"`
+	InterestRate int `json:"interestRate,omitempty"`
+	// Term is the number of paydays the principal is spread over, 0 keeps loans interest-only.
+	Term int `json:"term,omitempty"`
+	// MaxCashFlowRatio caps all bank loans at this multiple of the cash flow before loan payments.
+	MaxCashFlowRatio int  `json:"maxCashFlowRatio,omitempty"`
+	PartialRepayment bool `json:"partialRepayment,omitempty"`
+}
+
+func (p LoanPolicy) GetIncrement() int {
+	if p.Increment > 0 {
+		return p.Increment
+	}
+
+	return DefaultLoanIncrement
+}
+
+func (p LoanPolicy) GetInterestRate() int {
+	if p.InterestRate > 0 {
+		return p.InterestRate
+	}
+
+	return DefaultLoanInterestRate
+}
+
+type PlayerLoan struct {
+	ID           string    `json:"id"`
+	Principal    int       `json:"principal"`
+	Balance      int       `json:"balance"`
+	InterestRate int       `json:"interestRate"`
+	Term         int       `json:"term"`
+	PaymentsMade int       `json:"paymentsMade"`
+	TakenAt      time.Time `json:"takenAt"`
+}
+
+type LoanPayment struct {
+	Number    int `json:"number"`
+	Interest  int `json:"interest"`
+	Principal int `json:"principal"`
+	Payment   int `json:"payment"`
+	Balance   int `json:"balance"`
+}
+
+func NewPlayerLoan(amount int, policy LoanPolicy) PlayerLoan {
+	return PlayerLoan{
+		ID:           helper.Uuid("loan"),
+		Principal:    amount,
+		Balance:      amount,
+		InterestRate: policy.GetInterestRate(),
+		Term:         policy.Term,
+		TakenAt:      time.Now(),
+	}
+}
+
+func (l PlayerLoan) Interest() int {
+	return l.Balance * l.InterestRate / 100
+}
+
+func (l PlayerLoan) Installment() int {
+	if l.Term <= 0 {
+		return 0
+	}
+
+	if l.PaymentsMade >= l.Term-1 {
+		return l.Balance
+	}
+
+	installment := (l.Principal + l.Term - 1) / l.Term
+
+	if installment > l.Balance {
+		return l.Balance
+	}
+
+	return installment
+}
+
+func (l PlayerLoan) Payment() int {
+	return l.Interest() + l.Installment()
+}
+
+// Schedule projects the next payments of the loan, for interest-only loans up to limit paydays.
+func (l PlayerLoan) Schedule(limit int) []LoanPayment {
+	var schedule []LoanPayment
+
+	for i := 1; i <= limit && l.Balance > 0; i++ {
+		payment := LoanPayment{
+			Number:    l.PaymentsMade + 1,
+			Interest:  l.Interest(),
+			Principal: l.Installment(),
+		}
+		payment.Payment = payment.Interest + payment.Principal
+
+		l.Serve()
+
+		payment.Balance = l.Balance
+		schedule = append(schedule, payment)
+	}
+
+	return schedule
+}
+
+func (l *PlayerLoan) Serve() {
+	l.Balance -= l.Installment()
+	l.PaymentsMade++
+}
+
+func (e *Player) GetLoansBalance() int {
+	balance := 0
+
+	for _, loan := range e.Loans {
+		balance += loan.Balance
+	}
+
+	return balance
+}
+
+func (e *Player) TakeLoan(loan PlayerLoan) {
+	e.SyncLoans()
+	e.Loans = append(e.Loans, loan)
+	e.deriveLoans()
+}
+
+// RepayLoans pays the amount off the oldest loans first.
+func (e *Player) RepayLoans(amount int) {
+	e.SyncLoans()
+	e.repayLoans(amount)
+	e.deriveLoans()
+}
+
+// ServeLoans takes the principal installments of a payday off the loans.
+func (e *Player) ServeLoans() {
+	e.SyncLoans()
+
+	for i := range e.Loans {
+		e.Loans[i].Serve()
+	}
+
+	e.deriveLoans()
+}
+
+// SyncLoans derives the bank loan liability and expense from the loans. A bank loan set directly on the
+// liabilities (by a moderator or before loans were tracked) is reconciled into the loans first.
+func (e *Player) SyncLoans() {
+	if diff := e.Liabilities.BankLoan - e.GetLoansBalance(); diff > 0 {
+		e.Loans = append(e.Loans, NewPlayerLoan(diff, LoanPolicy{}))
+	} else if diff < 0 {
+		e.repayLoans(-diff)
+	}
+
+	e.deriveLoans()
+}
+
+func (e *Player) repayLoans(amount int) {
+	for i := range e.Loans {
+		if amount <= 0 {
+			break
+		}
+
+		repaid := amount
+
+		if repaid > e.Loans[i].Balance {
+			repaid = e.Loans[i].Balance
+		}
+
+		e.Loans[i].Balance -= repaid
+		amount -= repaid
+	}
+}
+
+func (e *Player) deriveLoans() {
+	var loans []PlayerLoan
+	payment := 0
+
+	for _, loan := range e.Loans {
+		if loan.Balance > 0 {
+			loans = append(loans, loan)
+			payment += loan.Payment()
+		}
+	}
+
+	e.Loans = loans
+	e.Liabilities.BankLoan = e.GetLoansBalance()
+
+	if _, ok := e.Expenses["bankLoan"]; !ok && payment == 0 {
+		return
+	}
+
+	if e.Expenses == nil {
+		e.Expenses = map[string]int{}
+	}
+
+	e.Expenses["bankLoan"] = payment
+}
+
+func (e *Player) GetLiability(liability string) int {
+	switch liability {
+	case "homeMortgage":
+		return e.Liabilities.HomeMortgage
+	case "schoolLoans":
+		return e.Liabilities.SchoolLoans
+	case "carLoans":
+		return e.Liabilities.CarLoans
+	case "creditCardDebt":
+		return e.Liabilities.CreditCardDebt
+	case "bankLoan":
+		return e.Liabilities.BankLoan
+	}
+
+	return 0
+}
+
+func (e *Player) SetLiability(liability string, amount int) {
+	switch liability {
+	case "homeMortgage":
+		e.Liabilities.HomeMortgage = amount
+	case "schoolLoans":
+		e.Liabilities.SchoolLoans = amount
+	case "carLoans":
+		e.Liabilities.CarLoans = amount
+	case "creditCardDebt":
+		e.Liabilities.CreditCardDebt = amount
+	case "bankLoan":
+		e.Liabilities.BankLoan = amount
+		e.SyncLoans()
+	}
+}
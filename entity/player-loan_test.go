@@ -0,0 +1,57 @@
+package entity_test
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/webjohny/cashflow-go/entity"
+	"testing"
+)
+
+func TestPlayerLoanSchedule(t *testing.T) {
+	tests := []struct {
+		name     string
+		loan     entity.PlayerLoan
+		limit    int
+		expected []entity.LoanPayment
+	}{
+		{
+			name:  "interest-only loan up to the limit",
+			loan:  entity.PlayerLoan{Principal: 5000, Balance: 5000, InterestRate: 10},
+			limit: 3,
+			expected: []entity.LoanPayment{
+				{Number: 1, Interest: 500, Principal: 0, Payment: 500, Balance: 5000},
+				{Number: 2, Interest: 500, Principal: 0, Payment: 500, Balance: 5000},
+				{Number: 3, Interest: 500, Principal: 0, Payment: 500, Balance: 5000},
+			},
+		},
+		{
+			name:  "loan with a term ends with the balance left",
+			loan:  entity.PlayerLoan{Principal: 1000, Balance: 1000, InterestRate: 10, Term: 3},
+			limit: 12,
+			expected: []entity.LoanPayment{
+				{Number: 1, Interest: 100, Principal: 334, Payment: 434, Balance: 666},
+				{Number: 2, Interest: 66, Principal: 334, Payment: 400, Balance: 332},
+				{Number: 3, Interest: 33, Principal: 332, Payment: 365, Balance: 0},
+			},
+		},
+		{
+			name:  "loan with payments made",
+			loan:  entity.PlayerLoan{Principal: 1000, Balance: 666, InterestRate: 10, Term: 3, PaymentsMade: 1},
+			limit: 1,
+			expected: []entity.LoanPayment{
+				{Number: 2, Interest: 66, Principal: 334, Payment: 400, Balance: 332},
+			},
+		},
+		{
+			name:     "repaid loan",
+			loan:     entity.PlayerLoan{Principal: 1000, Balance: 0, InterestRate: 10, Term: 3, PaymentsMade: 3},
+			limit:    12,
+			expected: nil,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, test.loan.Schedule(test.limit))
+		})
+	}
+}
@@ -14,12 +14,14 @@ var PlayerRoles = struct {
 	WaitList  string
 	Owner     string
 	Moderator string
+	Spectator string
 }{
 	Player:    "player",
 	Guest:     "guest",
 	WaitList:  "wait_list",
 	Owner:     "owner",
 	Moderator: "moderator",
+	Spectator: "spectator",
 }
 
 var NotificationTypes = struct {
@@ -84,6 +86,9 @@ type Player struct {
 	Expenses        map[string]int       `gorm:"type:json;serializer:json" json:"expenses"`
 	Assets          PlayerAssets         `gorm:"type:json;serializer:json" json:"assets"`
 	Liabilities     PlayerLiabilities    `gorm:"type:json;serializer:json" json:"liabilities"`
+	Loans           []PlayerLoan         `gorm:"type:json;serializer:json" json:"loans"`
+	Insurances      []PlayerInsurance    `gorm:"type:json;serializer:json" json:"insurances"`
+	NetWorthHistory []NetWorthPoint      `gorm:"type:json;serializer:json" json:"net_worth_history"`
 	Cash            int                  `json:"cash" gorm:"allowzero"`
 	CashFlow        int                  `json:"cash_flow" gorm:"allowzero"`
 	ProfessionID    uint8                `json:"profession_id"`
@@ -101,11 +106,15 @@ type Player struct {
 	HasBankrupt     uint8                `json:"has_bankrupt"`
 	AboutToBankrupt string               `json:"about_to_bankrupt" gorm:"type:varchar(255)"`
 	IsActive        bool                 `gorm:"default:true" json:"is_active"`
+	Team            *PlayerTeam          `gorm:"type:json;serializer:json" json:"team,omitempty"`
 	CreatedAt       time.Time            `gorm:"column:created_at;type:datetime;default:current_timestamp;not null" json:"created_at"`
 
 	PassiveIncome int `json:"passive_income" gorm:"-"`
 	TotalExpenses int `json:"total_expenses" gorm:"-"`
 	TotalIncome   int `json:"total_income" gorm:"-"`
+
+	// newNotifications counts the notifications set since the player was read, saving the player persists them.
+	newNotifications int
 }
 
 func (r *Player) GetStringID() string {
@@ -113,6 +122,7 @@ func (r *Player) GetStringID() string {
 }
 
 func (r *Player) SetNotification(message string, typeMessage string) {
+	r.newNotifications++
 	r.Notifications = append(r.Notifications, PlayerNotification{
 		ID:      helper.Uuid("n"),
 		Message: message,
@@ -120,6 +130,24 @@ func (r *Player) SetNotification(message string, typeMessage string) {
 	})
 }
 
+func (r *Player) SetNotificationWithParams(message string, typeMessage string, params map[string]interface{}) {
+	r.newNotifications++
+	r.Notifications = append(r.Notifications, PlayerNotification{
+		ID:      helper.Uuid("n"),
+		Message: message,
+		Type:    typeMessage,
+		Params:  params,
+	})
+}
+
+func (r *Player) HasNewNotifications() bool {
+	return r.newNotifications > 0
+}
+
+func (r *Player) ClearNewNotifications() {
+	r.newNotifications = 0
+}
+
 func (r *Player) RemoveNotification(ID string) {
 	for key, notification := range r.Notifications {
 		if notification.ID == ID {
@@ -191,6 +219,7 @@ func (e *Player) Reset(profession Profession) {
 	e.Assets.OtherAssets = make([]CardOtherAssets, 0)
 	e.Assets.Stocks = make([]CardStocks, 0)
 	e.Assets.Dreams = make([]CardDream, 0)
+	e.Insurances = make([]PlayerInsurance, 0)
 
 	if profession.ID != 0 {
 		e.Salary = profession.Income.Salary
@@ -214,12 +243,23 @@ func (e *Player) Reset(profession Profession) {
 }
 
 func (e *Player) CreateResponse() RaceResponse {
-	return RaceResponse{
+	response := RaceResponse{
 		ID:        e.ID,
 		UserId:    e.UserID,
 		Username:  e.Username,
 		Responded: false,
 	}
+
+	if e.Team != nil {
+		response.Members = e.Team.GetMemberIds()
+	}
+
+	return response
+}
+
+// IsTeam reports whether the player is the shared sheet of a team.
+func (e *Player) IsTeam() bool {
+	return e.Team != nil && len(e.Team.Members) > 0
 }
 
 func (e *Player) DecrementDualDiceCount() {
@@ -535,6 +575,35 @@ func (e *Player) RemoveBusiness(id string) CardBusiness {
 	return CardBusiness{}
 }
 
+// RemoveAssetByID takes the real estate, business or other asset out of the player's assets and returns its heading.
+func (e *Player) RemoveAssetByID(assetType string, id string) (string, bool) {
+	switch assetType {
+	case TxTypes.RealEstate:
+		if realEstate := e.FindRealEstateByID(id); realEstate.ID != "" {
+			heading := realEstate.Heading
+			e.RemoveRealEstate(id)
+
+			return heading, true
+		}
+	case TxTypes.Business:
+		if index, business := e.FindBusinessByID(id); index != -1 {
+			heading := business.Heading
+			e.RemoveBusiness(id)
+
+			return heading, true
+		}
+	case TxTypes.Other:
+		if index, other := e.FindOtherAssetsByID(id); index != -1 {
+			heading := other.Heading
+			e.RemoveOtherAssetsByID(id)
+
+			return heading, true
+		}
+	}
+
+	return "", false
+}
+
 func (e *Player) SplitStocks(card string) {
 	_, stock := e.FindStocksBySymbol(card)
 	stock.Count *= 2
@@ -550,7 +619,13 @@ func (e *Player) CanContinue() bool {
 }
 
 func (e *Player) ConditionsForBigRace() bool {
-	return e.IsIncomeStable() && e.RequiredDeals() && e.CashPerMonths()
+	for _, progress := range e.BigRaceProgress() {
+		if !progress.Done {
+			return false
+		}
+	}
+
+	return true
 }
 
 func (e *Player) ConditionsForCompletedBigRace() bool {
@@ -575,26 +650,60 @@ func (e *Player) IsIncomeStable() bool {
 	return e.CalculatePassiveIncome() > e.CalculateTotalExpenses()
 }
 
-func (e *Player) RequiredDeals() bool {
-	deals := e.Info.Conditions.RequiredDeals
-
-	for _, deal := range deals {
-		if !deal {
-			return false
+// BigRaceProgress measures the player against every rule to enter the fast track.
+func (e *Player) BigRaceProgress() []BigRaceRuleProgress {
+	rules := e.Info.Conditions.GetRules()
+	progress := make([]BigRaceRuleProgress, 0)
+
+	for _, rule := range rules {
+		progress = append(progress, e.bigRaceRuleProgress(rule))
+	}
+
+	return progress
+}
+
+func (e *Player) bigRaceRuleProgress(rule BigRaceRule) BigRaceRuleProgress {
+	progress := BigRaceRuleProgress{BigRaceRule: rule}
+	count := rule.Count
+
+	if count == 0 {
+		count = 1
+	}
+
+	switch rule.Type {
+	case BigRaceRuleTypes.PassiveIncome:
+		progress.Current = e.CalculatePassiveIncome()
+		progress.Required = e.CalculateTotalExpenses() + 1
+	case BigRaceRuleTypes.CashFlow:
+		progress.Current = e.CalculateCashFlow()
+		progress.Required = rule.Count
+	case BigRaceRuleTypes.CashMonths:
+		progress.Current = e.Cash
+		progress.Required = e.CalculateTotalExpenses() * rule.Count
+	case BigRaceRuleTypes.Business:
+		progress.Current = len(e.Assets.Business)
+		progress.Required = count
+	case BigRaceRuleTypes.RealEstate:
+		progress.Current = len(e.Assets.RealEstates)
+		progress.Required = count
+	case BigRaceRuleTypes.OtherAssets:
+		progress.Current = len(e.Assets.OtherAssets)
+		progress.Required = count
+	case BigRaceRuleTypes.Stocks:
+		for _, stock := range e.Assets.Stocks {
+			progress.Current += stock.Count
 		}
-	}
-
-	return true
-}
-
-func (e *Player) CashPerMonths() bool {
-	months := e.Info.Conditions.CashPerMonths
+		progress.Required = count
+	case BigRaceRuleTypes.NoLiability:
+		progress.Current = e.GetLiability(rule.GetLiability())
+		progress.Done = progress.Current == 0
 
-	if months > 0 {
-		return e.Cash >= e.CalculateTotalExpenses()*months
+		return progress
 	}
 
-	return true
+	progress.Done = progress.Current >= progress.Required
+
+	return progress
 }
 
 func (e *Player) IsBankrupt() bool {
@@ -0,0 +1,54 @@
+package entity_test
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/webjohny/cashflow-go/entity"
+	"testing"
+)
+
+func TestPlayerBigRaceProgress(t *testing.T) {
+	types := entity.BigRaceRuleTypes
+
+	tests := []struct {
+		name     string
+		rule     entity.BigRaceRule
+		assets   entity.PlayerAssets
+		current  int
+		required int
+		done     bool
+	}{
+		{name: "business without count asks for one", rule: entity.BigRaceRule{Type: types.Business}, current: 0, required: 1, done: false},
+		{name: "business without count is met by one", rule: entity.BigRaceRule{Type: types.Business}, assets: entity.PlayerAssets{Business: []entity.CardBusiness{{ID: "b1"}}}, current: 1, required: 1, done: true},
+		{name: "real estate without count asks for one", rule: entity.BigRaceRule{Type: types.RealEstate}, current: 0, required: 1, done: false},
+		{name: "other assets without count asks for one", rule: entity.BigRaceRule{Type: types.OtherAssets}, current: 0, required: 1, done: false},
+		{name: "stocks without count asks for one share", rule: entity.BigRaceRule{Type: types.Stocks}, assets: entity.PlayerAssets{Stocks: []entity.CardStocks{{Symbol: "ON2U", Count: 3}}}, current: 3, required: 1, done: true},
+		{name: "cash flow without count asks for none", rule: entity.BigRaceRule{Type: types.CashFlow}, current: 1000, required: 0, done: true},
+		{name: "cash months without count asks for none", rule: entity.BigRaceRule{Type: types.CashMonths}, current: 500, required: 0, done: true},
+		{name: "cash months with count", rule: entity.BigRaceRule{Type: types.CashMonths, Count: 1}, current: 500, required: 1000, done: false},
+		{name: "passive income ignores count", rule: entity.BigRaceRule{Type: types.PassiveIncome}, current: 0, required: 1001, done: false},
+		{name: "no liability defaults to the bank loan", rule: entity.BigRaceRule{Type: types.NoLiability}, current: 0, required: 0, done: true},
+		{name: "no liability of another kind", rule: entity.BigRaceRule{Type: types.NoLiability, Liability: "carLoans"}, current: 5000, required: 0, done: false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			player := entity.Player{
+				Cash:        500,
+				Salary:      2000,
+				Expenses:    map[string]int{"taxes": 1000},
+				Assets:      test.assets,
+				Liabilities: entity.PlayerLiabilities{CarLoans: 5000},
+			}
+			player.Info.Conditions.Rules = []entity.BigRaceRule{test.rule}
+
+			progress := player.BigRaceProgress()
+
+			assert.Len(t, progress, 1)
+			assert.Equal(t, test.rule, progress[0].BigRaceRule)
+			assert.Equal(t, test.current, progress[0].Current)
+			assert.Equal(t, test.required, progress[0].Required)
+			assert.Equal(t, test.done, progress[0].Done)
+			assert.Equal(t, test.done, player.ConditionsForBigRace())
+		})
+	}
+}
@@ -0,0 +1,88 @@
+package entity
+
+import (
+	"time"
+)
+
+var RaceAuctionStatuses = struct {
+	Active string
+	Sold   string
+	Closed string
+}{
+	Active: "active",
+	Sold:   "sold",
+	Closed: "closed",
+}
+
+type RaceAuctionBid struct {
+	PlayerID  uint64    `json:"player_id"`
+	UserId    uint64    `json:"user_id"`
+	Username  string    `json:"username"`
+	Amount    int       `json:"amount"`
+	Count     int       `json:"count,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type RaceAuction struct {
+	Card      Card             `json:"card"`
+	Seller    RacePlayer       `json:"seller"`
+	Bids      []RaceAuctionBid `json:"bids"`
+	Winner    *RaceAuctionBid  `json:"winner,omitempty"`
+	Status    string           `json:"status"`
+	StartedAt time.Time        `json:"started_at"`
+	EndsAt    time.Time        `json:"ends_at"`
+}
+
+func (a *RaceAuction) IsActive() bool {
+	return a.Status == RaceAuctionStatuses.Active
+}
+
+func (a *RaceAuction) IsExpired() bool {
+	return time.Now().After(a.EndsAt)
+}
+
+func (a *RaceAuction) HighestBid() RaceAuctionBid {
+	var highest RaceAuctionBid
+
+	for _, bid := range a.Bids {
+		if bid.Amount > highest.Amount {
+			highest = bid
+		}
+	}
+
+	return highest
+}
+
+// SortedBids returns bids from the highest to the lowest premium, earlier bids win ties.
+func (a *RaceAuction) SortedBids() []RaceAuctionBid {
+	bids := make([]RaceAuctionBid, len(a.Bids))
+	copy(bids, a.Bids)
+
+	for i := 1; i < len(bids); i++ {
+		for j := i; j > 0 && bids[j].Amount > bids[j-1].Amount; j-- {
+			bids[j], bids[j-1] = bids[j-1], bids[j]
+		}
+	}
+
+	return bids
+}
+
+func (a *RaceAuction) AddBid(bid RaceAuctionBid) {
+	bid.CreatedAt = time.Now()
+	a.Bids = append(a.Bids, bid)
+}
+
+func (r *Race) HasActiveAuction() bool {
+	return r.Auction != nil && r.Auction.IsActive()
+}
+
+func (r *Race) StartAuction(seller RacePlayer, duration time.Duration) {
+	r.Auction = &RaceAuction{
+		Card:      r.CurrentCard,
+		Seller:    seller,
+		Bids:      make([]RaceAuctionBid, 0),
+		Status:    RaceAuctionStatuses.Active,
+		StartedAt: time.Now(),
+		EndsAt:    time.Now().Add(duration),
+	}
+}
@@ -0,0 +1,159 @@
+package entity
+
+import (
+	"sort"
+	"time"
+)
+
+// MarketHistoryLimit is how many price ticks are kept per symbol.
+const MarketHistoryLimit = 200
+
+var MarketEvents = struct {
+	Quote        string
+	Buy          string
+	Sell         string
+	Split        string
+	ReverseSplit string
+}{
+	Quote:        "quote",
+	Buy:          "buy",
+	Sell:         "sell",
+	Split:        "split",
+	ReverseSplit: "reverseSplit",
+}
+
+type MarketTick struct {
+	Price     int       `json:"price"`
+	Event     string    `json:"event"`
+	Count     int       `json:"count,omitempty"`
+	Ratio     int       `json:"ratio,omitempty"`
+	CardID    string    `json:"card_id,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type MarketSymbol struct {
+	Symbol        string       `json:"symbol"`
+	Price         int          `json:"price"`
+	Low           int          `json:"low"`
+	High          int          `json:"high"`
+	Volume        int          `json:"volume"`
+	Splits        int          `json:"splits,omitempty"`
+	ReverseSplits int          `json:"reverse_splits,omitempty"`
+	History       []MarketTick `json:"history"`
+}
+
+type RaceMarket struct {
+	Symbols map[string]MarketSymbol `json:"symbols,omitempty"`
+}
+
+// Observe moves the market by a drawn card: stock cards quote the symbol, manipulation cards split it.
+func (m *RaceMarket) Observe(card Card) {
+	if card.Symbol == "" {
+		return
+	}
+
+	if card.AssetType == StockTypes.Manipulation {
+		if card.Increase > 1 {
+			m.Split(card.Symbol, card.Increase, card.ID)
+		} else if card.Decrease > 1 {
+			m.ReverseSplit(card.Symbol, card.Decrease, card.ID)
+		}
+	} else if card.Type == "stock" && card.Price > 0 {
+		m.record(card.Symbol, MarketTick{Price: card.Price, Event: MarketEvents.Quote, CardID: card.ID})
+	}
+}
+
+func (m *RaceMarket) Trade(symbol string, price int, count int, event string, cardID string) {
+	if symbol == "" || price <= 0 || count <= 0 {
+		return
+	}
+
+	m.record(symbol, MarketTick{Price: price, Event: event, Count: count, CardID: cardID})
+}
+
+// Split divides the price of the symbol by the ratio, the players' holdings are multiplied separately.
+func (m *RaceMarket) Split(symbol string, ratio int, cardID string) {
+	stock, ok := m.Symbols[symbol]
+
+	if !ok || ratio <= 1 {
+		return
+	}
+
+	stock.Low, stock.High = stock.Low/ratio, stock.High/ratio
+	m.Symbols[symbol] = stock
+	m.record(symbol, MarketTick{Price: stock.Price / ratio, Event: MarketEvents.Split, Ratio: ratio, CardID: cardID})
+}
+
+func (m *RaceMarket) ReverseSplit(symbol string, ratio int, cardID string) {
+	stock, ok := m.Symbols[symbol]
+
+	if !ok || ratio <= 1 {
+		return
+	}
+
+	stock.Low, stock.High = stock.Low*ratio, stock.High*ratio
+	m.Symbols[symbol] = stock
+	m.record(symbol, MarketTick{Price: stock.Price * ratio, Event: MarketEvents.ReverseSplit, Ratio: ratio, CardID: cardID})
+}
+
+// PriceOf returns the last traded price of the symbol, the fallback while it has not been quoted in the race.
+func (m *RaceMarket) PriceOf(symbol string, fallback int) int {
+	if stock, ok := m.Symbols[symbol]; ok && stock.Price > 0 {
+		return stock.Price
+	}
+
+	return fallback
+}
+
+func (m *RaceMarket) List() []MarketSymbol {
+	list := make([]MarketSymbol, 0, len(m.Symbols))
+
+	for _, stock := range m.Symbols {
+		list = append(list, stock)
+	}
+
+	sort.Slice(list, func(i, j int) bool {
+		return list[i].Symbol < list[j].Symbol
+	})
+
+	return list
+}
+
+func (m *RaceMarket) record(symbol string, tick MarketTick) {
+	if m.Symbols == nil {
+		m.Symbols = make(map[string]MarketSymbol)
+	}
+
+	stock := m.Symbols[symbol]
+	stock.Symbol = symbol
+	tick.CreatedAt = time.Now()
+
+	switch tick.Event {
+	case MarketEvents.Split:
+		stock.Splits++
+	case MarketEvents.ReverseSplit:
+		stock.ReverseSplits++
+	}
+
+	if stock.Low == 0 || tick.Price < stock.Low {
+		stock.Low = tick.Price
+	}
+	if tick.Price > stock.High {
+		stock.High = tick.Price
+	}
+
+	stock.Price = tick.Price
+	stock.Volume += tick.Count
+	stock.History = append(stock.History, tick)
+
+	if len(stock.History) > MarketHistoryLimit {
+		stock.History = stock.History[len(stock.History)-MarketHistoryLimit:]
+	}
+
+	m.Symbols[symbol] = stock
+}
+
+// Value returns what the holding is worth at the last traded price of its symbol.
+func (m *RaceMarket) Value(stock CardStocks) int {
+	return m.PriceOf(stock.Symbol, stock.GetLastPrice()) * stock.Count
+}
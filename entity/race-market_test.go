@@ -0,0 +1,78 @@
+package entity_test
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/webjohny/cashflow-go/entity"
+	"testing"
+)
+
+func TestRaceMarketObserve(t *testing.T) {
+	manipulation := entity.StockTypes.Manipulation
+
+	tests := []struct {
+		name          string
+		cards         []entity.Card
+		price         int
+		low           int
+		high          int
+		splits        int
+		reverseSplits int
+		events        []string
+	}{
+		{
+			name:   "quotes",
+			cards:  []entity.Card{{Type: "stock", Symbol: "ON2U", Price: 10}, {Type: "stock", Symbol: "ON2U", Price: 30}, {Type: "stock", Symbol: "ON2U", Price: 20}},
+			price:  20,
+			low:    10,
+			high:   30,
+			events: []string{entity.MarketEvents.Quote, entity.MarketEvents.Quote, entity.MarketEvents.Quote},
+		},
+		{
+			name:   "split",
+			cards:  []entity.Card{{Type: "stock", Symbol: "ON2U", Price: 40}, {Type: "stock", Symbol: "ON2U", AssetType: manipulation, Increase: 2}},
+			price:  20,
+			low:    20,
+			high:   20,
+			splits: 1,
+			events: []string{entity.MarketEvents.Quote, entity.MarketEvents.Split},
+		},
+		{
+			name:          "reverse split",
+			cards:         []entity.Card{{Type: "stock", Symbol: "ON2U", Price: 5}, {Type: "stock", Symbol: "ON2U", AssetType: manipulation, Decrease: 2}},
+			price:         10,
+			low:           10,
+			high:          10,
+			reverseSplits: 1,
+			events:        []string{entity.MarketEvents.Quote, entity.MarketEvents.ReverseSplit},
+		},
+		{
+			name:   "split of a symbol never quoted",
+			cards:  []entity.Card{{Type: "stock", Symbol: "ON2U", AssetType: manipulation, Increase: 2}},
+			events: []string{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			market := entity.RaceMarket{}
+
+			for _, card := range test.cards {
+				market.Observe(card)
+			}
+
+			stock := market.Symbols["ON2U"]
+			events := make([]string, 0)
+
+			for _, tick := range stock.History {
+				events = append(events, tick.Event)
+			}
+
+			assert.Equal(t, test.price, stock.Price)
+			assert.Equal(t, test.low, stock.Low)
+			assert.Equal(t, test.high, stock.High)
+			assert.Equal(t, test.splits, stock.Splits)
+			assert.Equal(t, test.reverseSplits, stock.ReverseSplits)
+			assert.Equal(t, test.events, events)
+		})
+	}
+}
@@ -0,0 +1,27 @@
+package entity
+
+import "time"
+
+func (r *Race) IsPaused() bool {
+	return r.Status == RaceStatus.PAUSED
+}
+
+// Pause freezes the race, the state is kept as it is until the race is resumed.
+func (r *Race) Pause(userId uint64) {
+	now := time.Now()
+
+	r.Status = RaceStatus.PAUSED
+	r.PausedAt = &now
+	r.PausedBy = userId
+}
+
+// Resume restarts the race and moves the running deadlines on by the time the race was paused.
+func (r *Race) Resume() {
+	if r.PausedAt != nil && r.Auction != nil && r.Auction.IsActive() {
+		r.Auction.EndsAt = r.Auction.EndsAt.Add(time.Since(*r.PausedAt))
+	}
+
+	r.Status = RaceStatus.STARTED
+	r.PausedAt = nil
+	r.PausedBy = 0
+}
@@ -0,0 +1,79 @@
+package entity
+
+import "time"
+
+// RaceTemplate is a named set of race options a user can apply when creating a lobby, the presets have no owner.
+type RaceTemplate struct {
+	ID          uint64      `gorm:"primaryKey;autoIncrement" json:"id,omitempty"`
+	Code        string      `gorm:"type:varchar(64);uniqueIndex" json:"code"`
+	UserID      uint64      `gorm:"index" json:"user_id,omitempty"`
+	Name        string      `gorm:"type:varchar(100)" json:"name"`
+	Description string      `gorm:"type:varchar(500)" json:"description,omitempty"`
+	Options     RaceOptions `gorm:"type:json;serializer:json" json:"options"`
+	IsShared    bool        `json:"is_shared"`
+	IsPreset    bool        `gorm:"-" json:"is_preset"`
+	CreatedAt   time.Time   `gorm:"column:created_at;type:datetime;default:current_timestamp;not null" json:"created_at"`
+	UpdatedAt   time.Time   `gorm:"column:updated_at;type:datetime;default:current_timestamp;not null" json:"updated_at"`
+}
+
+var RaceTemplatePresets = []RaceTemplate{
+	{
+		Code:        "classic",
+		Name:        "Classic",
+		Description: "The board game rules without a manager",
+		Options:     RaceOptions{},
+		IsShared:    true,
+		IsPreset:    true,
+	},
+	{
+		Code:        "classroom",
+		Name:        "Classroom with manager",
+		Description: "A manager hands out money and cards, players queue up in the wait list",
+		Options: RaceOptions{
+			EnableManager:      true,
+			EnableCardCategory: true,
+			EnableWaitList:     true,
+			BigRaceConditions: BigRaceConditions{
+				Rules: []BigRaceRule{
+					{Type: BigRaceRuleTypes.PassiveIncome},
+					{Type: BigRaceRuleTypes.Business, Count: 1},
+					{Type: BigRaceRuleTypes.RealEstate, Count: 2},
+					{Type: BigRaceRuleTypes.NoLiability, Liability: "bankLoan"},
+					{Type: BigRaceRuleTypes.CashMonths, Count: 3},
+				},
+			},
+		},
+		IsShared: true,
+		IsPreset: true,
+	},
+	{
+		Code:        "speed",
+		Name:        "Speed game",
+		Description: "Short auctions on every deal to keep the turns moving",
+		Options: RaceOptions{
+			EnableAuctions:  true,
+			AuctionDuration: 15,
+		},
+		IsShared: true,
+		IsPreset: true,
+	},
+}
+
+// GetRaceTemplatePreset returns the built-in template with the code, the code is empty if there is none.
+func GetRaceTemplatePreset(code string) RaceTemplate {
+	for _, preset := range RaceTemplatePresets {
+		if preset.Code == code {
+			return preset
+		}
+	}
+
+	return RaceTemplate{}
+}
+
+func (t *RaceTemplate) IsOwnedBy(userId uint64) bool {
+	return !t.IsPreset && t.UserID == userId
+}
+
+func (t *RaceTemplate) IsAvailableFor(userId uint64) bool {
+	return t.IsPreset || t.IsShared || t.UserID == userId
+}
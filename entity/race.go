@@ -12,11 +12,13 @@ var RaceStatus = struct {
 	LOBBY     string
 	CANCELLED string
 	FINISHED  string
+	PAUSED    string
 }{
 	STARTED:   "started",
 	LOBBY:     "lobby",
 	CANCELLED: "cancelled",
 	FINISHED:  "finished",
+	PAUSED:    "paused",
 }
 
 type RaceNotification struct {
@@ -26,15 +28,17 @@ type RaceNotification struct {
 }
 
 type RaceLog struct {
-	Amount          int    `json:"amount"`
-	CurrentCash     int    `json:"current_cash"`
-	UpdatedCash     int    `json:"updated_cash"`
-	CurrentCashFlow int    `json:"current_cashflow"`
-	UpdatedCashFlow int    `json:"updated_cashflow"`
-	PlayerId        int    `json:"player_id"`
-	Username        string `json:"username"`
-	Color           string `json:"color"`
-	Message         string `json:"message"`
+	Amount          int                    `json:"amount"`
+	CurrentCash     int                    `json:"current_cash"`
+	UpdatedCash     int                    `json:"updated_cash"`
+	CurrentCashFlow int                    `json:"current_cashflow"`
+	UpdatedCashFlow int                    `json:"updated_cashflow"`
+	PlayerId        int                    `json:"player_id"`
+	Username        string                 `json:"username"`
+	Color           string                 `json:"color"`
+	Message         string                 `json:"message"`
+	Code            string                 `json:"code,omitempty"`
+	Params          map[string]interface{} `json:"params,omitempty"`
 }
 
 type RaceResponse struct {
@@ -42,6 +46,18 @@ type RaceResponse struct {
 	UserId    uint64 `json:"user_id,omitempty"`
 	Username  string `json:"username,omitempty"`
 	Responded bool   `json:"responded"`
+	// Members and Turn are set for a team, the members roll the dice one after another.
+	Members []uint64 `json:"members,omitempty"`
+	Turn    int      `json:"turn,omitempty"`
+}
+
+// GetRoller returns the user who rolls the dice on the next turn of the response.
+func (r RaceResponse) GetRoller() uint64 {
+	if len(r.Members) == 0 {
+		return r.UserId
+	}
+
+	return r.Members[r.Turn%len(r.Members)]
 }
 
 type RacePlayer struct {
@@ -60,6 +76,7 @@ type BigRaceConditions struct {
 	CashFlow      bool            `json:"cashFlow,omitempty"`
 	RequiredDeals map[string]bool `json:"requiredDeals,omitempty"`
 	CashPerMonths int             `json:"cashPerMonths,omitempty"`
+	Rules         []BigRaceRule   `json:"rules,omitempty"`
 }
 
 type RaceOptions struct {
@@ -69,12 +86,30 @@ type RaceOptions struct {
 	EnableCardCategory          bool              `json:"enableCardCategory,omitempty"`
 	HideCards                   bool              `json:"hideCards,omitempty"`
 	HandMode                    bool              `json:"handMode,omitempty"`
-	BannerLink                  string            `json:"bannerLink,omitempty"`
-	BannerImage                 string            `json:"bannerImage,omitempty"`
-	Language                    string            `json:"language,omitempty"`
-	MeetLink                    string            `json:"meetLink,omitempty"`
-	EnableWaitList              bool              `json:"enableWaitList,omitempty"`
-	CardCollection              string            `json:"cardCollection,omitempty"`
+	// HandModeConfirmation holds the dice entered in hand mode until a moderator confirms them.
+	HandModeConfirmation bool               `json:"handModeConfirmation,omitempty"`
+	BannerLink           string             `json:"bannerLink,omitempty"`
+	BannerImage          string             `json:"bannerImage,omitempty"`
+	Language             string             `json:"language,omitempty"`
+	MeetLink             string             `json:"meetLink,omitempty"`
+	EnableWaitList       bool               `json:"enableWaitList,omitempty"`
+	CardCollection       string             `json:"cardCollection,omitempty"`
+	EnableAuctions       bool               `json:"enableAuctions,omitempty"`
+	AuctionDuration      int                `json:"auctionDuration,omitempty"`
+	LoanPolicy           LoanPolicy         `json:"loanPolicy,omitempty"`
+	InsuranceProducts    []InsuranceProduct `json:"insuranceProducts,omitempty"`
+	EnableTeams          bool               `json:"enableTeams,omitempty"`
+	// TeamDecision is how the deals of a team member are approved: by the captain or by the majority.
+	TeamDecision string `json:"teamDecision,omitempty"`
+}
+
+// GetInsuranceProducts returns the insurance products of the race, the default ones unless they were overridden.
+func (c *RaceOptions) GetInsuranceProducts() []InsuranceProduct {
+	if len(c.InsuranceProducts) > 0 {
+		return c.InsuranceProducts
+	}
+
+	return DefaultInsuranceProducts
 }
 
 func (c *RaceOptions) Merge(override RaceOptions) {
@@ -95,12 +130,42 @@ func (c *RaceOptions) Merge(override RaceOptions) {
 	if override.HandMode != c.HandMode {
 		c.HandMode = override.HandMode
 	}
+	if override.HandModeConfirmation != c.HandModeConfirmation {
+		c.HandModeConfirmation = override.HandModeConfirmation
+	}
 	if override.EnableWaitList != c.EnableWaitList {
 		c.EnableWaitList = override.EnableWaitList
 	}
 	if override.CardCollection != "" {
 		c.CardCollection = override.CardCollection
 	}
+	if override.EnableAuctions != c.EnableAuctions {
+		c.EnableAuctions = override.EnableAuctions
+	}
+	if override.AuctionDuration > 0 {
+		c.AuctionDuration = override.AuctionDuration
+	}
+	if override.LoanPolicy != (LoanPolicy{}) {
+		c.LoanPolicy = override.LoanPolicy
+	}
+	if len(override.InsuranceProducts) > 0 {
+		c.InsuranceProducts = override.InsuranceProducts
+	}
+	if override.EnableTeams != c.EnableTeams {
+		c.EnableTeams = override.EnableTeams
+	}
+	if override.TeamDecision != "" {
+		c.TeamDecision = override.TeamDecision
+	}
+}
+
+// GetTeamDecision returns how the deals of a team are approved, the captain decides unless a vote is set.
+func (c *RaceOptions) GetTeamDecision() string {
+	if c.TeamDecision == TeamDecisionModes.Vote {
+		return TeamDecisionModes.Vote
+	}
+
+	return TeamDecisionModes.Captain
 }
 
 type RaceCardMap struct {
@@ -141,6 +206,75 @@ func (rcm *RaceCardMap) Next(action string) {
 		rcm.Active = make(map[string]int)
 	}
 
+	rcm.Active[action] = rcm.Map[action][rcm.nextPosition(action)]
+}
+
+// Upcoming returns the next count cards of the deck in the order they will be drawn.
+func (rcm *RaceCardMap) Upcoming(action string, count int) []int {
+	deck := rcm.Map[action]
+	cards := make([]int, 0)
+
+	if count > len(deck) {
+		count = len(deck)
+	}
+
+	position := rcm.nextPosition(action)
+
+	for i := 0; i < count; i++ {
+		cards = append(cards, deck[(position+i)%len(deck)])
+	}
+
+	return cards
+}
+
+func (rcm *RaceCardMap) Shuffle(action string) {
+	deck := rcm.Map[action]
+
+	r := rand.New(rand.NewSource(time.Now().UnixNano()))
+	r.Shuffle(len(deck), func(i, j int) {
+		deck[i], deck[j] = deck[j], deck[i]
+	})
+}
+
+// Remove takes the cards out of play, the deck is left untouched when no card would remain. When the active
+// card is removed the last remaining card before it becomes active, so the deck goes on where it stopped.
+func (rcm *RaceCardMap) Remove(action string, cards []int) bool {
+	deck := make([]int, 0)
+	active, hasActive := rcm.Active[action]
+	previous, passedActive := -1, false
+
+	for _, card := range rcm.Map[action] {
+		if card == active {
+			passedActive = true
+		}
+
+		if !helper.Contains[int](cards, card) {
+			deck = append(deck, card)
+
+			if !passedActive {
+				previous = card
+			}
+		}
+	}
+
+	if len(deck) == 0 {
+		return false
+	}
+
+	rcm.Map[action] = deck
+
+	if hasActive && helper.Contains[int](cards, active) {
+		if previous == -1 {
+			previous = deck[len(deck)-1]
+		}
+
+		rcm.Active[action] = previous
+	}
+
+	return true
+}
+
+func (rcm *RaceCardMap) nextPosition(action string) int {
 	current := rcm.Active[action]
 
 	var index int
@@ -150,20 +284,27 @@ func (rcm *RaceCardMap) Next(action string) {
 		}
 	}
 
-	var nextItem = index
-
 	if index > len(rcm.Map[action])-1 {
-		nextItem = 0
+		return 0
 	}
 
-	rcm.Active[action] = rcm.Map[action][nextItem]
+	return index
+}
+
+// RacePendingRoll is a roll of physical dice waiting for the confirmation of a moderator.
+type RacePendingRoll struct {
+	PlayerID   uint64    `json:"player_id"`
+	Username   string    `json:"username"`
+	Dice       []int     `json:"dice"`
+	IsFinished bool      `json:"is_finished"`
+	CreatedAt  time.Time `json:"created_at"`
 }
 
 type Race struct {
 	ID                uint64               `gorm:"primary_key:auto_increment" json:"id"`
 	Responses         []RaceResponse       `gorm:"type:json;serializer:json" json:"responses"`
 	IsMultiFlow       bool                 `gorm:"is_multi_flow" json:"is_multi_flow"`
-	Status            string               `gorm:"status;type:enum('lobby','started','cancelled','finished')" json:"status"`
+	Status            string               `gorm:"status;type:enum('lobby','started','cancelled','finished','paused')" json:"status"`
 	Hash              string               `gorm:"hash" json:"hash"`
 	CurrentPlayer     RacePlayer           `gorm:"type:json;serializer:json" json:"current_player,omitempty"`
 	CurrentCard       Card                 `gorm:"type:json;serializer:json" json:"current_card,omitempty"`
@@ -173,6 +314,11 @@ type Race struct {
 	Dice              []int                `gorm:"type:json;serializer:json" json:"dice"`
 	Options           RaceOptions          `gorm:"type:json;serializer:json" json:"options"`
 	CardMap           RaceCardMap          `gorm:"type:json;serializer:json" json:"card_map"`
+	Auction           *RaceAuction         `gorm:"type:json;serializer:json" json:"auction,omitempty"`
+	Market            RaceMarket           `gorm:"type:json;serializer:json" json:"market"`
+	PausedAt          *time.Time           `gorm:"type:datetime" json:"paused_at,omitempty"`
+	PausedBy          uint64               `json:"paused_by,omitempty"`
+	PendingRoll       *RacePendingRoll     `gorm:"type:json;serializer:json" json:"pending_roll,omitempty"`
 	CreatedAt         time.Time            `gorm:"column:created_at;type:datetime;default:current_timestamp;not null" json:"created_at"`
 }
 
@@ -199,6 +345,16 @@ func (r *Race) ResetResponses() {
 	}
 }
 
+func (r *Race) AddResponse(response RaceResponse) {
+	for i := 0; i < len(r.Responses); i++ {
+		if r.Responses[i].ID == response.ID {
+			return
+		}
+	}
+
+	r.Responses = append(r.Responses, response)
+}
+
 func (r *Race) RemoveResponsePlayer(playerId uint64) {
 	if len(r.Responses) > 0 {
 		for i := 0; i < len(r.Responses); i++ {
@@ -273,9 +429,7 @@ func (r *Race) GetNextPlayer() RaceResponse {
 func (r *Race) NextPlayer() {
 	next := r.GetNextPlayer()
 
-	r.CurrentPlayer.ID = next.ID
-	r.CurrentPlayer.UserId = next.UserId
-	r.CurrentPlayer.Username = next.Username
+	r.setCurrentPlayer(next)
 }
 
 func (r *Race) PickCurrentPlayer(playerId int) {
@@ -289,9 +443,25 @@ func (r *Race) PickCurrentPlayer(playerId int) {
 		}
 	}
 
+	r.setCurrentPlayer(next)
+}
+
+// setCurrentPlayer gives the turn to the player, for a team the turn goes to the member whose time it is to
+// roll and the next member is queued.
+func (r *Race) setCurrentPlayer(next RaceResponse) {
 	r.CurrentPlayer.ID = next.ID
-	r.CurrentPlayer.UserId = next.UserId
+	r.CurrentPlayer.UserId = next.GetRoller()
 	r.CurrentPlayer.Username = next.Username
+
+	if len(next.Members) == 0 {
+		return
+	}
+
+	for i := 0; i < len(r.Responses); i++ {
+		if r.Responses[i].ID == next.ID {
+			r.Responses[i].Turn = (next.Turn + 1) % len(next.Members)
+		}
+	}
 }
 
 func (r *Race) CalculateTotalSteps(diceValues []int, diceCount int) int {
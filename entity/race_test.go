@@ -0,0 +1,64 @@
+package entity_test
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/webjohny/cashflow-go/entity"
+	"testing"
+)
+
+func TestRaceCardMapUpcoming(t *testing.T) {
+	tests := []struct {
+		name     string
+		active   int
+		count    int
+		expected []int
+	}{
+		{name: "next cards after the active one", active: 3, count: 2, expected: []int{5, 7}},
+		{name: "wraps around the end of the deck", active: 7, count: 3, expected: []int{9, 3, 5}},
+		{name: "starts over after the last card", active: 9, count: 2, expected: []int{3, 5}},
+		{name: "never repeats the deck", active: 5, count: 10, expected: []int{7, 9, 3, 5}},
+		{name: "nothing for no count", active: 5, count: 0, expected: []int{}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cardMap := entity.RaceCardMap{
+				Active: map[string]int{"deal": test.active},
+				Map:    map[string][]int{"deal": {3, 5, 7, 9}},
+			}
+
+			assert.Equal(t, test.expected, cardMap.Upcoming("deal", test.count))
+		})
+	}
+}
+
+func TestRaceCardMapRemove(t *testing.T) {
+	tests := []struct {
+		name     string
+		active   int
+		cards    []int
+		removed  bool
+		deck     []int
+		upcoming []int
+	}{
+		{name: "other cards", active: 5, cards: []int{9}, removed: true, deck: []int{3, 5, 7}, upcoming: []int{7, 3}},
+		{name: "the active card", active: 5, cards: []int{5}, removed: true, deck: []int{3, 7, 9}, upcoming: []int{7, 9}},
+		{name: "the active card and the one after it", active: 5, cards: []int{5, 7}, removed: true, deck: []int{3, 9}, upcoming: []int{9, 3}},
+		{name: "the active first card", active: 3, cards: []int{3}, removed: true, deck: []int{5, 7, 9}, upcoming: []int{5, 7}},
+		{name: "the active last card", active: 9, cards: []int{9}, removed: true, deck: []int{3, 5, 7}, upcoming: []int{3, 5}},
+		{name: "every card", active: 5, cards: []int{3, 5, 7, 9}, removed: false, deck: []int{3, 5, 7, 9}, upcoming: []int{7, 9}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cardMap := entity.RaceCardMap{
+				Active: map[string]int{"deal": test.active},
+				Map:    map[string][]int{"deal": {3, 5, 7, 9}},
+			}
+
+			assert.Equal(t, test.removed, cardMap.Remove("deal", test.cards))
+			assert.Equal(t, test.deck, cardMap.Map["deal"])
+			assert.Equal(t, test.upcoming, cardMap.Upcoming("deal", 2))
+		})
+	}
+}
@@ -0,0 +1,183 @@
+package entity
+
+import (
+	"sort"
+	"time"
+)
+
+var SharedAssetTypes = struct {
+	RealEstate  string
+	Business    string
+	OtherAssets string
+}{
+	RealEstate:  "realEstate",
+	Business:    "business",
+	OtherAssets: "otherAssets",
+}
+
+var SharedAssetStatuses = struct {
+	Active string
+	Sold   string
+	Closed string
+}{
+	Active: "active",
+	Sold:   "sold",
+	Closed: "closed",
+}
+
+var SharedAssetBuyoutStatuses = struct {
+	Pending   string
+	Accepted  string
+	Declined  string
+	Cancelled string
+}{
+	Pending:   "pending",
+	Accepted:  "accepted",
+	Declined:  "declined",
+	Cancelled: "cancelled",
+}
+
+type SharedAssetShare struct {
+	PlayerID     uint64 `json:"player_id"`
+	Username     string `json:"username"`
+	Percent      int    `json:"percent"`
+	Contribution int    `json:"contribution,omitempty"`
+}
+
+type SharedAssetBuyout struct {
+	ID        string    `json:"id"`
+	BuyerID   uint64    `json:"buyer_id"`
+	SellerID  uint64    `json:"seller_id"`
+	Percent   int       `json:"percent"`
+	Price     int       `json:"price"`
+	Status    string    `json:"status"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// SharedAsset is the ledger record of an asset bought in partnership. Every partner keeps a copy of the asset
+// on the sheet, the ledger holds the shares which their percent and cash flow are derived from.
+type SharedAsset struct {
+	ID        uint64              `gorm:"primaryKey;autoIncrement" json:"id"`
+	RaceID    uint64              `gorm:"index:idx_shared_asset" json:"race_id"`
+	AssetID   string              `gorm:"index:idx_shared_asset;type:varchar(255)" json:"asset_id"`
+	AssetType string              `gorm:"type:varchar(20)" json:"asset_type"`
+	Heading   string              `gorm:"type:varchar(255)" json:"heading"`
+	Cost      int                 `json:"cost"`
+	Mortgage  int                 `json:"mortgage"`
+	CashFlow  int                 `json:"cash_flow"`
+	OwnerID   uint64              `json:"owner_id"`
+	Shares    []SharedAssetShare  `gorm:"type:json;serializer:json" json:"shares"`
+	Buyouts   []SharedAssetBuyout `gorm:"type:json;serializer:json" json:"buyouts"`
+	Status    string              `gorm:"type:varchar(20)" json:"status"`
+	CreatedAt time.Time           `gorm:"column:created_at;type:datetime;default:current_timestamp;not null" json:"created_at"`
+	UpdatedAt time.Time           `gorm:"column:updated_at;type:datetime;default:current_timestamp;not null" json:"updated_at"`
+}
+
+func (a *SharedAsset) IsActive() bool {
+	return a.Status == SharedAssetStatuses.Active
+}
+
+func (a *SharedAsset) FindShare(playerId uint64) (int, *SharedAssetShare) {
+	for i := range a.Shares {
+		if a.Shares[i].PlayerID == playerId {
+			return i, &a.Shares[i]
+		}
+	}
+
+	return -1, &SharedAssetShare{}
+}
+
+func (a *SharedAsset) FindBuyout(ID string) *SharedAssetBuyout {
+	for i := range a.Buyouts {
+		if a.Buyouts[i].ID == ID {
+			return &a.Buyouts[i]
+		}
+	}
+
+	return &SharedAssetBuyout{}
+}
+
+// SetShares keeps the given percents or derives them from the contributions when none are given,
+// the rounding remainder goes to the owner.
+func (a *SharedAsset) SetShares(shares []SharedAssetShare) {
+	total := 0
+	given := false
+
+	for _, share := range shares {
+		total += share.Contribution
+		given = given || share.Percent > 0
+	}
+
+	percent := 0
+
+	for i := range shares {
+		if !given && total > 0 {
+			shares[i].Percent = shares[i].Contribution * 100 / total
+		} else if !given && shares[i].PlayerID == a.OwnerID {
+			shares[i].Percent = 100
+		}
+
+		percent += shares[i].Percent
+	}
+
+	a.Shares = shares
+
+	if _, owner := a.FindShare(a.OwnerID); owner.PlayerID != 0 {
+		owner.Percent += 100 - percent
+	}
+}
+
+// Split divides the amount by the shares, the rounding remainder goes to the owner.
+func (a *SharedAsset) Split(amount int) map[uint64]int {
+	parts := make(map[uint64]int, len(a.Shares))
+	rest := amount
+
+	for _, share := range a.Shares {
+		parts[share.PlayerID] = amount * share.Percent / 100
+		rest -= parts[share.PlayerID]
+	}
+
+	parts[a.OwnerID] += rest
+
+	return parts
+}
+
+// Transfer moves percent of the share from one partner to another. A partner left without a share leaves
+// the ledger, the ownership passes to the largest partner when the owner leaves.
+func (a *SharedAsset) Transfer(fromId uint64, toId uint64, percent int, username string) {
+	_, from := a.FindShare(fromId)
+
+	if from.PlayerID == 0 || percent <= 0 {
+		return
+	}
+
+	if percent > from.Percent {
+		percent = from.Percent
+	}
+
+	from.Percent -= percent
+
+	if _, to := a.FindShare(toId); to.PlayerID != 0 {
+		to.Percent += percent
+	} else {
+		a.Shares = append(a.Shares, SharedAssetShare{PlayerID: toId, Username: username, Percent: percent})
+	}
+
+	var shares []SharedAssetShare
+
+	for _, share := range a.Shares {
+		if share.Percent > 0 {
+			shares = append(shares, share)
+		}
+	}
+
+	sort.SliceStable(shares, func(i, j int) bool {
+		return shares[i].Percent > shares[j].Percent
+	})
+
+	a.Shares = shares
+
+	if _, owner := a.FindShare(a.OwnerID); owner.PlayerID == 0 && len(a.Shares) > 0 {
+		a.OwnerID = a.Shares[0].PlayerID
+	}
+}
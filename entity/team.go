@@ -0,0 +1,127 @@
+package entity
+
+import (
+	"encoding/json"
+	"time"
+)
+
+var TeamDecisionModes = struct {
+	Captain string
+	Vote    string
+}{
+	Captain: "captain",
+	Vote:    "vote",
+}
+
+var TeamDecisionActions = struct {
+	Purchase string
+	Selling  string
+}{
+	Purchase: "purchase",
+	Selling:  "selling",
+}
+
+var TeamDecisionStatuses = struct {
+	Pending  string
+	Approved string
+	Rejected string
+	Expired  string
+}{
+	Pending:  "pending",
+	Approved: "approved",
+	Rejected: "rejected",
+	Expired:  "expired",
+}
+
+// LobbyTeam groups lobby players that will share one financial sheet in the race.
+type LobbyTeam struct {
+	Name      string   `json:"name" form:"name" binding:"required,max=50"`
+	CaptainID uint64   `json:"captain_id" form:"captain_id"`
+	Members   []uint64 `json:"members" form:"members" binding:"required,min=1"`
+}
+
+type PlayerTeamMember struct {
+	UserID   uint64 `json:"user_id"`
+	Username string `json:"username"`
+}
+
+// PlayerTeam is set on the player of a team, the player itself belongs to the captain.
+type PlayerTeam struct {
+	CaptainID uint64             `json:"captain_id"`
+	Members   []PlayerTeamMember `json:"members"`
+}
+
+// TeamMember resolves every member of a team to the player the team plays with.
+type TeamMember struct {
+	RaceID   uint64 `gorm:"primaryKey;autoIncrement:false" json:"race_id"`
+	UserID   uint64 `gorm:"primaryKey;autoIncrement:false" json:"user_id"`
+	PlayerID uint64 `gorm:"index" json:"player_id"`
+}
+
+// TeamDecision is a deal a team member proposed, it is carried out once the captain or the majority approves it.
+type TeamDecision struct {
+	ID         uint64          `gorm:"primaryKey;autoIncrement" json:"id"`
+	RaceID     uint64          `gorm:"index:idx_team_decision" json:"race_id"`
+	PlayerID   uint64          `gorm:"index:idx_team_decision" json:"player_id"`
+	ProposedBy uint64          `json:"proposed_by"`
+	CardID     string          `gorm:"type:varchar(100)" json:"card_id"`
+	Action     string          `gorm:"type:varchar(20)" json:"action"`
+	ActionType string          `gorm:"type:varchar(50)" json:"action_type"`
+	IsBigRace  bool            `json:"is_big_race"`
+	Payload    json.RawMessage `gorm:"type:json" json:"payload"`
+	Votes      map[uint64]bool `gorm:"type:json;serializer:json" json:"votes"`
+	Status     string          `gorm:"type:varchar(20);default:pending" json:"status"`
+	ResolvedAt *time.Time      `gorm:"type:datetime" json:"resolved_at,omitempty"`
+	CreatedAt  time.Time       `gorm:"column:created_at;type:datetime;default:current_timestamp;not null" json:"created_at"`
+}
+
+func (t *PlayerTeam) IsCaptain(userId uint64) bool {
+	return t.CaptainID == userId
+}
+
+func (t *PlayerTeam) IsMember(userId uint64) bool {
+	for _, member := range t.Members {
+		if member.UserID == userId {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (t *PlayerTeam) GetMemberIds() []uint64 {
+	ids := make([]uint64, 0)
+
+	for _, member := range t.Members {
+		ids = append(ids, member.UserID)
+	}
+
+	return ids
+}
+
+func (d *TeamDecision) IsPending() bool {
+	return d.Status == TeamDecisionStatuses.Pending
+}
+
+func (d *TeamDecision) Resolve(status string) {
+	now := time.Now()
+
+	d.Status = status
+	d.ResolvedAt = &now
+}
+
+// CountVotes returns the approvals and the rejections of the decision.
+func (d *TeamDecision) CountVotes() (int, int) {
+	approvals := 0
+	rejections := 0
+
+	for _, approve := range d.Votes {
+		if approve {
+			approvals++
+		} else {
+			rejections++
+		}
+	}
+
+	return approvals, rejections
+}
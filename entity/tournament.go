@@ -0,0 +1,310 @@
+package entity
+
+import (
+	"sort"
+	"time"
+)
+
+var TournamentFormats = struct {
+	Bracket string
+	Swiss   string
+}{
+	Bracket: "bracket",
+	Swiss:   "swiss",
+}
+
+var TournamentAdvancements = struct {
+	FinishOrder string
+	NetWorth    string
+}{
+	FinishOrder: "finishOrder",
+	NetWorth:    "netWorth",
+}
+
+var TournamentStatuses = struct {
+	New       string
+	Started   string
+	Finished  string
+	Cancelled string
+}{
+	New:       "new",
+	Started:   "started",
+	Finished:  "finished",
+	Cancelled: "cancelled",
+}
+
+type TournamentParticipant struct {
+	UserID     uint64 `json:"user_id"`
+	Username   string `json:"username"`
+	Points     int    `json:"points"`
+	Wins       int    `json:"wins"`
+	NetWorth   int    `json:"net_worth"`
+	Rounds     int    `json:"rounds"`
+	LastPlace  int    `json:"last_place"`
+	Eliminated bool   `json:"eliminated"`
+}
+
+// TournamentResult is the place of a participant at a table once its race is finished.
+type TournamentResult struct {
+	UserID    uint64 `json:"user_id"`
+	Username  string `json:"username"`
+	Place     int    `json:"place"`
+	NetWorth  int    `json:"net_worth"`
+	Completed bool   `json:"completed"`
+}
+
+// Tournament is a series of rounds, every round seats the participants at tables that play one race each.
+// A bracket eliminates everybody below Advancing at a table until one table is left, a swiss series plays
+// Rounds rounds pairing participants with similar points.
+type Tournament struct {
+	ID           uint64                  `gorm:"primaryKey;autoIncrement" json:"id"`
+	OwnerID      uint64                  `gorm:"index" json:"owner_id"`
+	Name         string                  `gorm:"type:varchar(100)" json:"name"`
+	Format       string                  `gorm:"type:varchar(20)" json:"format"`
+	AdvanceBy    string                  `gorm:"type:varchar(20)" json:"advance_by"`
+	TableSize    int                     `json:"table_size"`
+	Advancing    int                     `json:"advancing"`
+	Rounds       int                     `json:"rounds"`
+	CurrentRound int                     `json:"current_round"`
+	Options      RaceOptions             `gorm:"type:json;serializer:json" json:"options"`
+	Participants []TournamentParticipant `gorm:"type:json;serializer:json" json:"participants"`
+	Status       string                  `gorm:"type:varchar(20);default:new" json:"status"`
+	CreatedAt    time.Time               `gorm:"column:created_at;type:datetime;default:current_timestamp;not null" json:"created_at"`
+	UpdatedAt    time.Time               `gorm:"column:updated_at;type:datetime;default:current_timestamp;not null" json:"updated_at"`
+}
+
+// TournamentTable is the lobby the participants of a round are seated in, the results are taken from its race.
+type TournamentTable struct {
+	ID           uint64             `gorm:"primaryKey;autoIncrement" json:"id"`
+	TournamentID uint64             `gorm:"index:idx_tournament_table" json:"tournament_id"`
+	Round        int                `gorm:"index:idx_tournament_table" json:"round"`
+	Number       int                `json:"number"`
+	LobbyID      uint64             `json:"lobby_id"`
+	RaceID       uint64             `json:"race_id"`
+	Seats        []uint64           `gorm:"type:json;serializer:json" json:"seats"`
+	Results      []TournamentResult `gorm:"type:json;serializer:json" json:"results"`
+	IsFinished   bool               `json:"is_finished"`
+	CreatedAt    time.Time          `gorm:"column:created_at;type:datetime;default:current_timestamp;not null" json:"created_at"`
+}
+
+func (t *Tournament) IsOwnedBy(userId uint64) bool {
+	return t.OwnerID == userId
+}
+
+func (t *Tournament) IsNew() bool {
+	return t.Status == TournamentStatuses.New
+}
+
+func (t *Tournament) IsStarted() bool {
+	return t.Status == TournamentStatuses.Started
+}
+
+func (t *Tournament) IsValid() bool {
+	if t.Format != TournamentFormats.Bracket && t.Format != TournamentFormats.Swiss {
+		return false
+	}
+
+	if t.AdvanceBy != TournamentAdvancements.FinishOrder && t.AdvanceBy != TournamentAdvancements.NetWorth {
+		return false
+	}
+
+	if t.TableSize < 2 {
+		return false
+	}
+
+	if t.Format == TournamentFormats.Bracket {
+		return t.Advancing > 0 && t.Advancing < t.TableSize
+	}
+
+	return t.Rounds > 0
+}
+
+func (t *Tournament) GetParticipant(userId uint64) *TournamentParticipant {
+	for i := range t.Participants {
+		if t.Participants[i].UserID == userId {
+			return &t.Participants[i]
+		}
+	}
+
+	return nil
+}
+
+func (t *Tournament) AddParticipant(userId uint64, username string) {
+	if t.GetParticipant(userId) == nil {
+		t.Participants = append(t.Participants, TournamentParticipant{UserID: userId, Username: username})
+	}
+}
+
+func (t *Tournament) RemoveParticipant(userId uint64) {
+	for i := range t.Participants {
+		if t.Participants[i].UserID == userId {
+			t.Participants = append(t.Participants[:i], t.Participants[i+1:]...)
+			return
+		}
+	}
+}
+
+// GetStandings orders the participants: the bracket by the rounds they played and their place in the last
+// one, then both formats by points, wins and net worth.
+func (t *Tournament) GetStandings() []TournamentParticipant {
+	standings := make([]TournamentParticipant, len(t.Participants))
+	copy(standings, t.Participants)
+
+	sort.SliceStable(standings, func(i, j int) bool {
+		a, b := standings[i], standings[j]
+
+		if t.Format == TournamentFormats.Bracket && a.Rounds != b.Rounds {
+			return a.Rounds > b.Rounds
+		}
+
+		if t.Format == TournamentFormats.Bracket && a.LastPlace != b.LastPlace {
+			return a.LastPlace < b.LastPlace
+		}
+
+		if a.Points != b.Points {
+			return a.Points > b.Points
+		}
+
+		if a.Wins != b.Wins {
+			return a.Wins > b.Wins
+		}
+
+		return a.NetWorth > b.NetWorth
+	})
+
+	return standings
+}
+
+// GetContenders returns the participants who play the next round in the order of the standings.
+func (t *Tournament) GetContenders() []TournamentParticipant {
+	contenders := make([]TournamentParticipant, 0)
+
+	for _, participant := range t.GetStandings() {
+		if !participant.Eliminated {
+			contenders = append(contenders, participant)
+		}
+	}
+
+	return contenders
+}
+
+// SeatContenders splits the contenders into tables of balanced size. The bracket deals them out snake-wise so
+// every table gets strong and weak players and keeps every table above the advancing places, the swiss series
+// seats neighbours in the standings together.
+func (t *Tournament) SeatContenders(contenders []TournamentParticipant) [][]TournamentParticipant {
+	count := len(contenders)
+	tables := (count + t.TableSize - 1) / t.TableSize
+
+	if t.Format == TournamentFormats.Bracket && tables > count/(t.Advancing+1) {
+		tables = count / (t.Advancing + 1)
+	}
+
+	if tables < 1 {
+		tables = 1
+	}
+
+	seated := make([][]TournamentParticipant, tables)
+
+	if t.Format == TournamentFormats.Bracket {
+		for i, contender := range contenders {
+			index := i % tables
+
+			if (i/tables)%2 == 1 {
+				index = tables - 1 - index
+			}
+
+			seated[index] = append(seated[index], contender)
+		}
+
+		return seated
+	}
+
+	position := 0
+
+	for i := 0; i < tables; i++ {
+		size := count / tables
+
+		if i < count%tables {
+			size++
+		}
+
+		seated[i] = append(seated[i], contenders[position:position+size]...)
+		position += size
+	}
+
+	return seated
+}
+
+// ApplyResults adds the results of a table to the standings, a place scores the players it beat and in a
+// bracket the places below Advancing are eliminated.
+func (t *Tournament) ApplyResults(results []TournamentResult) {
+	for _, result := range results {
+		participant := t.GetParticipant(result.UserID)
+
+		if participant == nil {
+			continue
+		}
+
+		participant.Rounds++
+		participant.LastPlace = result.Place
+		participant.Points += len(results) - result.Place
+		participant.NetWorth += result.NetWorth
+
+		if result.Place == 1 {
+			participant.Wins++
+		}
+
+		if t.Format == TournamentFormats.Bracket && result.Place > t.Advancing {
+			participant.Eliminated = true
+		}
+	}
+}
+
+// RankTournamentResults places the players of a finished race. By finish order the players who completed
+// the fast track come first, then the players on the fast track, then the players who did not go bankrupt,
+// ties are broken by net worth. By net worth only the net worth counts.
+func RankTournamentResults(players []Player, market RaceMarket, advanceBy string) []TournamentResult {
+	results := make([]TournamentResult, 0)
+	stages := make(map[uint64]int)
+
+	for i := range players {
+		player := &players[i]
+		stage := 0
+
+		if player.ConditionsForCompletedBigRace() {
+			stage = 3
+		} else if player.OnBigRace {
+			stage = 2
+		} else if player.HasBankrupt == 0 {
+			stage = 1
+		}
+
+		if advanceBy == TournamentAdvancements.NetWorth {
+			stage = 0
+		}
+
+		stages[player.UserID] = stage
+		results = append(results, TournamentResult{
+			UserID:    player.UserID,
+			Username:  player.Username,
+			NetWorth:  player.BalanceSheet(market).NetWorth,
+			Completed: player.ConditionsForCompletedBigRace(),
+		})
+	}
+
+	sort.SliceStable(results, func(i, j int) bool {
+		a, b := results[i], results[j]
+
+		if stages[a.UserID] != stages[b.UserID] {
+			return stages[a.UserID] > stages[b.UserID]
+		}
+
+		return a.NetWorth > b.NetWorth
+	})
+
+	for i := range results {
+		results[i].Place = i + 1
+	}
+
+	return results
+}
@@ -0,0 +1,91 @@
+package entity_test
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/webjohny/cashflow-go/entity"
+	"testing"
+)
+
+func TestTournamentSeatContenders(t *testing.T) {
+	formats := entity.TournamentFormats
+
+	tests := []struct {
+		name      string
+		format    string
+		tableSize int
+		advancing int
+		count     int
+		expected  [][]uint64
+	}{
+		{name: "bracket deals snake-wise", format: formats.Bracket, tableSize: 4, advancing: 2, count: 8, expected: [][]uint64{{1, 4, 5, 8}, {2, 3, 6, 7}}},
+		{name: "bracket keeps every table above the advancing places", format: formats.Bracket, tableSize: 2, advancing: 1, count: 5, expected: [][]uint64{{1, 4, 5}, {2, 3}}},
+		{name: "bracket seats a small field at one table", format: formats.Bracket, tableSize: 4, advancing: 2, count: 2, expected: [][]uint64{{1, 2}}},
+		{name: "swiss seats neighbours together", format: formats.Swiss, tableSize: 4, advancing: 0, count: 6, expected: [][]uint64{{1, 2, 3}, {4, 5, 6}}},
+		{name: "swiss gives the first tables the extra seats", format: formats.Swiss, tableSize: 3, advancing: 0, count: 7, expected: [][]uint64{{1, 2, 3}, {4, 5}, {6, 7}}},
+		{name: "swiss seats a full table", format: formats.Swiss, tableSize: 4, advancing: 0, count: 4, expected: [][]uint64{{1, 2, 3, 4}}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			tournament := entity.Tournament{Format: test.format, TableSize: test.tableSize, Advancing: test.advancing}
+			contenders := make([]entity.TournamentParticipant, 0)
+
+			for i := 1; i <= test.count; i++ {
+				contenders = append(contenders, entity.TournamentParticipant{UserID: uint64(i)})
+			}
+
+			seated := make([][]uint64, 0)
+
+			for _, table := range tournament.SeatContenders(contenders) {
+				userIds := make([]uint64, 0)
+
+				for _, contender := range table {
+					userIds = append(userIds, contender.UserID)
+				}
+
+				seated = append(seated, userIds)
+			}
+
+			assert.Equal(t, test.expected, seated)
+		})
+	}
+}
+
+func TestRankTournamentResults(t *testing.T) {
+	completed := entity.Player{ID: 3, UserID: 3, Cash: 500, CashFlow: 60000}
+	completed.Info.GoalPassiveIncome = 50000
+	completed.Assets.Dreams = []entity.CardDream{{ID: "d1", AssetType: "personal", PlayerId: 3}}
+
+	players := []entity.Player{
+		{ID: 1, UserID: 1, Cash: 9000, HasBankrupt: 1},
+		{ID: 2, UserID: 2, Cash: 1000},
+		completed,
+		{ID: 4, UserID: 4, Cash: 3000},
+		{ID: 5, UserID: 5, Cash: 200, OnBigRace: true},
+	}
+
+	tests := []struct {
+		name      string
+		advanceBy string
+		expected  []uint64
+		netWorth  []int
+	}{
+		{name: "finish order ranks by stage then net worth", advanceBy: entity.TournamentAdvancements.FinishOrder, expected: []uint64{3, 5, 4, 2, 1}, netWorth: []int{500, 200, 3000, 1000, 9000}},
+		{name: "net worth ranks by net worth only", advanceBy: entity.TournamentAdvancements.NetWorth, expected: []uint64{1, 4, 2, 3, 5}, netWorth: []int{9000, 3000, 1000, 500, 200}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			results := entity.RankTournamentResults(players, entity.RaceMarket{}, test.advanceBy)
+
+			assert.Len(t, results, len(test.expected))
+
+			for i, result := range results {
+				assert.Equal(t, test.expected[i], result.UserID)
+				assert.Equal(t, i+1, result.Place)
+				assert.Equal(t, test.netWorth[i], result.NetWorth)
+				assert.Equal(t, result.UserID == 3, result.Completed)
+			}
+		})
+	}
+}
@@ -0,0 +1,67 @@
+package entity
+
+import (
+	"strconv"
+	"time"
+)
+
+var TradeOfferStatuses = struct {
+	Pending   string
+	Accepted  string
+	Declined  string
+	Countered string
+	Cancelled string
+}{
+	Pending:   "pending",
+	Accepted:  "accepted",
+	Declined:  "declined",
+	Countered: "countered",
+	Cancelled: "cancelled",
+}
+
+type TradeStock struct {
+	Symbol string `json:"symbol"`
+	Count  int    `json:"count"`
+}
+
+type TradeBusiness struct {
+	ID    string `json:"id"`
+	Count int    `json:"count,omitempty"`
+}
+
+type TradeBundle struct {
+	Cash        int             `json:"cash,omitempty"`
+	Stocks      []TradeStock    `json:"stocks,omitempty"`
+	RealEstates []string        `json:"realEstates,omitempty"`
+	Business    []TradeBusiness `json:"business,omitempty"`
+}
+
+func (b *TradeBundle) IsEmpty() bool {
+	return b.Cash == 0 && len(b.Stocks) == 0 && len(b.RealEstates) == 0 && len(b.Business) == 0
+}
+
+type TradeOffer struct {
+	ID         uint64      `gorm:"primaryKey;autoIncrement" json:"id"`
+	RaceID     uint64      `gorm:"index:idx_trade_race" json:"race_id"`
+	SenderID   uint64      `gorm:"index:idx_trade_race" json:"sender_id"`
+	ReceiverID uint64      `gorm:"index:idx_trade_race" json:"receiver_id"`
+	ParentID   uint64      `json:"parent_id,omitempty"`
+	Offer      TradeBundle `gorm:"type:json;serializer:json" json:"offer"`
+	Request    TradeBundle `gorm:"type:json;serializer:json" json:"request"`
+	Message    string      `gorm:"type:text" json:"message"`
+	Status     string      `gorm:"type:varchar(20)" json:"status"`
+	CreatedAt  time.Time   `gorm:"column:created_at;type:datetime;default:current_timestamp;not null" json:"created_at"`
+	UpdatedAt  time.Time   `gorm:"column:updated_at;type:datetime;default:current_timestamp;not null" json:"updated_at"`
+}
+
+func (t *TradeOffer) IsPending() bool {
+	return t.Status == TradeOfferStatuses.Pending
+}
+
+func (t *TradeOffer) IsParticipant(playerId uint64) bool {
+	return t.SenderID == playerId || t.ReceiverID == playerId
+}
+
+func (t *TradeOffer) GetCardID() string {
+	return "trade-" + strconv.FormatUint(t.ID, 10)
+}
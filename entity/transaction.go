@@ -36,6 +36,10 @@ var TransactionCardType = struct {
 	ReceiveAssets     string
 	PayLoan           string
 	TakeLoan          string
+	Trade             string
+	Auction           string
+	Insurance         string
+	Moderator         string
 }{
 	Skip:              "skip",
 	Stock:             "stock",
@@ -70,6 +74,10 @@ var TransactionCardType = struct {
 	StartMoney:        "startMoney",
 	PayLoan:           "payLoan",
 	TakeLoan:          "takeLoan",
+	Trade:             "trade",
+	Auction:           "auction",
+	Insurance:         "insurance",
+	Moderator:         "moderator",
 }
 
 var TransactionType = struct {
@@ -105,13 +113,15 @@ type TransactionData struct {
 }
 
 type Transaction struct {
-	ID              uint             `gorm:"primaryKey;autoIncrement" json:"id"`
-	PlayerID        *uint64          `gorm:"uniqueIndex:trx;index:idx_player" json:"player_id,omitempty"`
-	RaceID          *uint64          `gorm:"uniqueIndex:trx;index:idx_player" json:"race_id,omitempty"`
-	CardID          string           `gorm:"uniqueIndex:trx;type:varchar(150)" json:"card_id"`
-	CardType        string           `gorm:"uniqueIndex:trx;type:varchar(20)" json:"card_type"`
-	TransactionType string           `gorm:"type:varchar(20)" json:"transaction_type"` // Handle enum in application logic
-	Details         string           `gorm:"type:varchar(255)" json:"description"`
-	Data            *TransactionData `gorm:"type:json;serializer:json" json:"data,omitempty"`
-	CreatedAt       time.Time        `gorm:"column:created_at;type:datetime;default:current_timestamp;not null" json:"created_at"`
+	ID              uint                   `gorm:"primaryKey;autoIncrement" json:"id"`
+	PlayerID        *uint64                `gorm:"uniqueIndex:trx;index:idx_player" json:"player_id,omitempty"`
+	RaceID          *uint64                `gorm:"uniqueIndex:trx;index:idx_player" json:"race_id,omitempty"`
+	CardID          string                 `gorm:"uniqueIndex:trx;type:varchar(150)" json:"card_id"`
+	CardType        string                 `gorm:"uniqueIndex:trx;type:varchar(20)" json:"card_type"`
+	TransactionType string                 `gorm:"type:varchar(20)" json:"transaction_type"` // Handle enum in application logic
+	Details         string                 `gorm:"type:varchar(255)" json:"description"`
+	Code            string                 `gorm:"type:varchar(100)" json:"code,omitempty"`
+	Params          map[string]interface{} `gorm:"type:json;serializer:json" json:"params,omitempty"`
+	Data            *TransactionData       `gorm:"type:json;serializer:json" json:"data,omitempty"`
+	CreatedAt       time.Time              `gorm:"column:created_at;type:datetime;default:current_timestamp;not null" json:"created_at"`
 }
@@ -81,9 +81,17 @@ var MarketTypes = struct {
 }
 
 var UserRequestTypes = struct {
-	Baby   string
-	Salary string
+	Baby          string
+	Salary        string
+	Loan          string
+	LoanRepayment string
+	Charity       string
+	SellAsset     string
 }{
-	Baby:   "baby",
-	Salary: "salary",
+	Baby:          "baby",
+	Salary:        "salary",
+	Loan:          "takeLoan",
+	LoanRepayment: "payLoan",
+	Charity:       "charity",
+	SellAsset:     "sellAsset",
 }
@@ -4,18 +4,74 @@ import (
 	"time"
 )
 
+var UserRequestStatuses = struct {
+	Pending   int
+	Approved  int
+	Rejected  int
+	Expired   int
+	Cancelled int
+}{
+	Pending:   0,
+	Approved:  1,
+	Rejected:  2,
+	Expired:   3,
+	Cancelled: 4,
+}
+
 type UserRequest struct {
-	ID            uint64                 `gorm:"primary_key:auto_increment" json:"id"`
-	RaceID        uint64                 `gorm:"type:int(11)" json:"race_id"`
-	UserID        uint64                 `gorm:"type:int(11)" json:"user_id"`
-	Type          string                 `gorm:"type:varchar(20)" json:"type"`
-	CurrentCard   string                 `gorm:"type:varchar(150)" json:"current_card"`
-	Amount        int                    `gorm:"type:int(11)" json:"amount"`
-	Message       string                 `gorm:"type:text" json:"message"`
-	RejectMessage string                 `gorm:"type:text" json:"reject_message"`
-	Status        int                    `gorm:"type:int(1)" json:"status"`
-	Data          map[string]interface{} `gorm:"type:json;serializer:json" json:"data"`
-	CreatedAt     time.Time              `gorm:"column:created_at;type:datetime;default:CURRENT_TIMESTAMP();not null" json:"created_at"`
+	ID             uint64                 `gorm:"primary_key:auto_increment" json:"id"`
+	RaceID         uint64                 `gorm:"type:int(11)" json:"race_id"`
+	UserID         uint64                 `gorm:"type:int(11)" json:"user_id"`
+	Type           string                 `gorm:"type:varchar(20)" json:"type"`
+	CurrentCard    string                 `gorm:"type:varchar(150)" json:"current_card"`
+	Amount         int                    `gorm:"type:int(11)" json:"amount"`
+	ApprovedAmount int                    `gorm:"type:int(11)" json:"approved_amount"`
+	Message        string                 `gorm:"type:text" json:"message"`
+	RejectMessage  string                 `gorm:"type:text" json:"reject_message"`
+	Status         int                    `gorm:"type:int(1)" json:"status"`
+	ResolvedBy     uint64                 `json:"resolved_by,omitempty"`
+	ResolvedAt     *time.Time             `gorm:"type:datetime" json:"resolved_at,omitempty"`
+	Data           map[string]interface{} `gorm:"type:json;serializer:json" json:"data"`
+	CreatedAt      time.Time              `gorm:"column:created_at;type:datetime;default:CURRENT_TIMESTAMP();not null" json:"created_at"`
 
 	User User `gorm:"foreignKey:UserID" json:"user"`
 }
+
+func (r *UserRequest) IsPending() bool {
+	return r.Status == UserRequestStatuses.Pending
+}
+
+// Transition moves a pending request into one of the final statuses, a resolved request never changes again.
+func (r *UserRequest) Transition(status int, userId uint64) bool {
+	if !r.IsPending() || status == UserRequestStatuses.Pending || status > UserRequestStatuses.Cancelled {
+		return false
+	}
+
+	now := time.Now()
+
+	r.Status = status
+	r.ResolvedBy = userId
+	r.ResolvedAt = &now
+
+	return true
+}
+
+func (r *UserRequest) GetDataString(key string) string {
+	if value, ok := r.Data[key].(string); ok {
+		return value
+	}
+
+	return ""
+}
+
+// GetDataInt reads a number of the data, JSON numbers come back from the column as float64.
+func (r *UserRequest) GetDataInt(key string) int {
+	switch value := r.Data[key].(type) {
+	case int:
+		return value
+	case float64:
+		return int(value)
+	}
+
+	return 0
+}
@@ -0,0 +1,47 @@
+package entity_test
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/webjohny/cashflow-go/entity"
+	"testing"
+)
+
+func TestUserRequestTransition(t *testing.T) {
+	statuses := entity.UserRequestStatuses
+
+	tests := []struct {
+		name     string
+		from     int
+		to       int
+		expected bool
+	}{
+		{name: "pending to approved", from: statuses.Pending, to: statuses.Approved, expected: true},
+		{name: "pending to rejected", from: statuses.Pending, to: statuses.Rejected, expected: true},
+		{name: "pending to expired", from: statuses.Pending, to: statuses.Expired, expected: true},
+		{name: "pending to cancelled", from: statuses.Pending, to: statuses.Cancelled, expected: true},
+		{name: "pending to pending", from: statuses.Pending, to: statuses.Pending, expected: false},
+		{name: "pending to unknown", from: statuses.Pending, to: statuses.Cancelled + 1, expected: false},
+		{name: "approved to rejected", from: statuses.Approved, to: statuses.Rejected, expected: false},
+		{name: "rejected to approved", from: statuses.Rejected, to: statuses.Approved, expected: false},
+		{name: "cancelled to pending", from: statuses.Cancelled, to: statuses.Pending, expected: false},
+		{name: "expired to cancelled", from: statuses.Expired, to: statuses.Cancelled, expected: false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			request := entity.UserRequest{Status: test.from}
+
+			assert.Equal(t, test.expected, request.Transition(test.to, 7))
+
+			if test.expected {
+				assert.Equal(t, test.to, request.Status)
+				assert.Equal(t, uint64(7), request.ResolvedBy)
+				assert.NotNil(t, request.ResolvedAt)
+			} else {
+				assert.Equal(t, test.from, request.Status)
+				assert.Zero(t, request.ResolvedBy)
+				assert.Nil(t, request.ResolvedAt)
+			}
+		})
+	}
+}
@@ -0,0 +1,18 @@
+package helper
+
+import "encoding/json"
+
+func Clone[T any](value T) T {
+	var clone T
+
+	data, err := json.Marshal(value)
+	if err != nil {
+		panic(err)
+	}
+
+	if err = json.Unmarshal(data, &clone); err != nil {
+		panic(err)
+	}
+
+	return clone
+}
@@ -2,9 +2,12 @@ package helper
 
 import (
 	"github.com/gin-gonic/gin"
+	"github.com/webjohny/cashflow-go/i18n"
 	"strconv"
 )
 
+const IdempotencyKeyHeader = "Idempotency-Key"
+
 func GetUserId(ctx *gin.Context) uint64 {
 	userIdParam, _ := strconv.Atoi(ctx.GetString("userId"))
 	return uint64(userIdParam)
@@ -29,3 +32,19 @@ func GetBigRace(ctx *gin.Context) bool {
 	}
 	return bigRace
 }
+
+func GetLanguage(ctx *gin.Context) string {
+	if language := ctx.Query("lang"); language != "" {
+		return i18n.Normalize(language)
+	}
+
+	if language := ctx.GetString("language"); language != "" {
+		return i18n.Normalize(language)
+	}
+
+	return i18n.ParseAcceptLanguage(ctx.GetHeader("Accept-Language"))
+}
+
+func GetIdempotencyKey(ctx *gin.Context) string {
+	return ctx.GetHeader(IdempotencyKeyHeader)
+}
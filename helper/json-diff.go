@@ -0,0 +1,100 @@
+package helper
+
+import (
+	"encoding/json"
+	"reflect"
+	"sort"
+	"strconv"
+)
+
+type JsonChange struct {
+	Path   string      `json:"path"`
+	Before interface{} `json:"before"`
+	After  interface{} `json:"after"`
+}
+
+// JsonDiff compares the JSON forms of two values and returns the changed leaves with dot separated paths.
+func JsonDiff(before interface{}, after interface{}) []JsonChange {
+	changes := make([]JsonChange, 0)
+
+	jsonDiff("", toJsonValue(before), toJsonValue(after), &changes)
+
+	return changes
+}
+
+func toJsonValue(value interface{}) interface{} {
+	var result interface{}
+
+	data, err := json.Marshal(value)
+	if err != nil {
+		return nil
+	}
+
+	_ = json.Unmarshal(data, &result)
+
+	return result
+}
+
+func jsonDiff(path string, before interface{}, after interface{}, changes *[]JsonChange) {
+	beforeMap, isBeforeMap := before.(map[string]interface{})
+	afterMap, isAfterMap := after.(map[string]interface{})
+
+	if isBeforeMap && isAfterMap {
+		keys := make([]string, 0, len(beforeMap)+len(afterMap))
+
+		for key := range beforeMap {
+			keys = append(keys, key)
+		}
+		for key := range afterMap {
+			if _, ok := beforeMap[key]; !ok {
+				keys = append(keys, key)
+			}
+		}
+
+		sort.Strings(keys)
+
+		for _, key := range keys {
+			jsonDiff(jsonPath(path, key), beforeMap[key], afterMap[key], changes)
+		}
+
+		return
+	}
+
+	beforeList, isBeforeList := before.([]interface{})
+	afterList, isAfterList := after.([]interface{})
+
+	if isBeforeList && isAfterList {
+		length := len(beforeList)
+
+		if len(afterList) > length {
+			length = len(afterList)
+		}
+
+		for i := 0; i < length; i++ {
+			var beforeItem, afterItem interface{}
+
+			if i < len(beforeList) {
+				beforeItem = beforeList[i]
+			}
+			if i < len(afterList) {
+				afterItem = afterList[i]
+			}
+
+			jsonDiff(jsonPath(path, strconv.Itoa(i)), beforeItem, afterItem, changes)
+		}
+
+		return
+	}
+
+	if !reflect.DeepEqual(before, after) {
+		*changes = append(*changes, JsonChange{Path: path, Before: before, After: after})
+	}
+}
+
+func jsonPath(path string, key string) string {
+	if path == "" {
+		return key
+	}
+
+	return path + "." + key
+}
@@ -0,0 +1,77 @@
+package helper_test
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/webjohny/cashflow-go/helper"
+	"testing"
+)
+
+type diffPlayer struct {
+	Cash   int      `json:"cash"`
+	Name   string   `json:"name"`
+	Assets []string `json:"assets"`
+}
+
+func TestJsonDiff(t *testing.T) {
+	tests := []struct {
+		name     string
+		before   interface{}
+		after    interface{}
+		expected []helper.JsonChange
+	}{
+		{
+			name:     "equal values",
+			before:   diffPlayer{Cash: 100, Name: "Alice"},
+			after:    diffPlayer{Cash: 100, Name: "Alice"},
+			expected: []helper.JsonChange{},
+		},
+		{
+			name:   "changed leaves are sorted by path",
+			before: diffPlayer{Cash: 100, Name: "Alice"},
+			after:  diffPlayer{Cash: 250, Name: "Bob"},
+			expected: []helper.JsonChange{
+				{Path: "cash", Before: float64(100), After: float64(250)},
+				{Path: "name", Before: "Alice", After: "Bob"},
+			},
+		},
+		{
+			name:   "list items are compared by index",
+			before: diffPlayer{Assets: []string{"house", "car"}},
+			after:  diffPlayer{Assets: []string{"house", "boat", "plane"}},
+			expected: []helper.JsonChange{
+				{Path: "assets.1", Before: "car", After: "boat"},
+				{Path: "assets.2", Before: nil, After: "plane"},
+			},
+		},
+		{
+			name:   "added key",
+			before: map[string]interface{}{"cash": 1},
+			after:  map[string]interface{}{"cash": 1, "info": map[string]interface{}{"dream": "yacht"}},
+			expected: []helper.JsonChange{
+				{Path: "info", Before: nil, After: map[string]interface{}{"dream": "yacht"}},
+			},
+		},
+		{
+			name:   "nested keys are joined with dots",
+			before: map[string]interface{}{"options": map[string]interface{}{"handMode": false}},
+			after:  map[string]interface{}{"options": map[string]interface{}{"handMode": true}},
+			expected: []helper.JsonChange{
+				{Path: "options.handMode", Before: false, After: true},
+			},
+		},
+		{
+			name:   "changed type replaces the whole value",
+			before: map[string]interface{}{"data": []int{1}},
+			after:  map[string]interface{}{"data": "none"},
+			expected: []helper.JsonChange{
+				{Path: "data", Before: []interface{}{float64(1)}, After: "none"},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, helper.JsonDiff(test.before, test.after))
+		})
+	}
+}
@@ -0,0 +1,172 @@
+package i18n
+
+import (
+	"github.com/webjohny/cashflow-go/apperror"
+	"github.com/webjohny/cashflow-go/storage"
+)
+
+var en = map[string]string{
+	apperror.CodeUndefinedUser:                                "User not found",
+	apperror.CodeUndefinedUserRequest:                         "Request not found",
+	apperror.CodeUserRequestHasBeenAlreadyApproved:            "Request has already been approved",
+	apperror.CodeUndefinedPlayer:                              "Player not found",
+	apperror.CodeUndefinedReceiverPlayer:                      "Receiver not found",
+	apperror.CodeUndefinedGame:                                "Game not found",
+	apperror.CodeGameIsFull:                                   "The game is full",
+	apperror.CodeGameIsStarted:                                "The game has already started",
+	apperror.CodeGameIsFinished:                               "The game is finished",
+	apperror.CodeGameIsNotStarted:                             "The game has not started",
+	apperror.CodeGameIsPaused:                                 "The game is paused",
+	apperror.CodeGameIsNotPaused:                              "The game is not paused",
+	apperror.CodeUndefinedLobby:                               "Lobby not found",
+	apperror.CodeCannotCreatedRace:                            "Cannot create the game",
+	apperror.CodeCannotTakeBigDeals:                           "You cannot take big deals",
+	apperror.CodeForbidden:                                    "Forbidden",
+	apperror.CodeProcessFailed:                                "Processing failed",
+	apperror.CodeIsNotValidCountValue:                         "Invalid count",
+	apperror.CodeIsNotValidRealEstate:                         "Invalid real estate",
+	apperror.CodeIsNotValidBusiness:                           "Invalid business",
+	apperror.CodeIsNotValidOtherAssets:                        "Invalid asset",
+	apperror.CodeInvalidTypeOfCard:                            "Invalid type of card",
+	apperror.CodeWrongAmount:                                  "Wrong amount",
+	apperror.CodeTooManyAssets:                                "Too many assets",
+	apperror.CodeNotFoundAssets:                               "Assets not found",
+	apperror.CodeNotEnoughMoney:                               "Not enough money",
+	apperror.CodeNotFoundStocks:                               "Stocks not found",
+	apperror.CodeNotEnoughStocks:                              "Not enough stocks",
+	apperror.CodeUndefinedProfession:                          "Profession not found",
+	apperror.CodeInvalidCard:                                  "Invalid card",
+	apperror.CodeDreamPlaceHasAlreadyTaken:                    "This dream has already been taken",
+	apperror.CodeNotEnoughAsset:                               "Not enough assets",
+	apperror.CodePermissionDenied:                             "Permission denied",
+	apperror.CodeTransactionDeclined:                          "Transaction declined",
+	apperror.CodeWrongAmountForTakingLoan:                     "Wrong amount for a loan",
+	apperror.CodeWrongAmountForPayingLoan:                     "Wrong amount for a loan payment",
+	apperror.CodeCommonPassiveIncomeGreaterThanCashFlowOfCard: "Shared passive income is greater than the cash flow of the card",
+	apperror.CodeYouHaveNoProperties:                          "You have no properties",
+	apperror.CodeNotSuitableBuilding:                          "The building does not suit this offer",
+	apperror.CodeLimitedPartnership:                           "Limited partnership limit exceeded",
+	apperror.CodeIncorrectCount:                               "Incorrect count",
+	apperror.CodeCardsNotFound:                                "Cards not found",
+	apperror.CodeProfessionsNotFound:                          "Professions not found",
+	apperror.CodeNotFoundTheRealEstate:                        "Real estate not found",
+	apperror.CodeNotFoundTheBusiness:                          "Business not found",
+	apperror.CodeNotFoundTheAsset:                             "Asset not found",
+	apperror.CodeTransactionAlreadyExists:                     "Transaction already exists",
+	apperror.CodeItsNotYourMoveNow:                            "It is not your move now",
+	apperror.CodeInsufficientPlayers:                          "Not enough players",
+	apperror.CodeMovingBigRaceDeclined:                        "You cannot move to the fast track yet",
+	apperror.CodeYouAreBankrupt:                               "You are bankrupt",
+	apperror.CodeForbiddenByOwner:                             "Only the owner can do this",
+	apperror.CodeIfHadBeenInsurance:                           "Insurance would have covered this",
+	apperror.CodeUserIsNotOnWaitList:                          "User is not on the wait list",
+	apperror.CodeEmptyMessage:                                 "Message is empty",
+	apperror.CodeMessageIsTooLong:                             "Message is too long",
+	apperror.CodeUndefinedMessage:                             "Message not found",
+	apperror.CodeIncorrectReaction:                            "Incorrect reaction",
+	apperror.CodeUndefinedTradeOffer:                          "Trade offer not found",
+	apperror.CodeTradeOfferIsNotPending:                       "Trade offer is no longer pending",
+	apperror.CodeEmptyTradeOffer:                              "Trade offer is empty",
+	apperror.CodeCannotTradeWithYourself:                      "You cannot trade with yourself",
+	apperror.CodeAssetAlreadyOwned:                            "The asset is already owned",
+	apperror.CodeUndefinedAuction:                             "Auction not found",
+	apperror.CodeAuctionIsFinished:                            "The auction is finished",
+	apperror.CodeBidIsTooLow:                                  "The bid is too low",
+	apperror.CodeBadRequest:                                   "Bad request",
+	apperror.CodeValidationFailed:                             "Validation failed",
+	apperror.CodeIdempotencyKeyInUse:                          "The request with this idempotency key is still in progress",
+	apperror.CodeIdempotencyKeyReused:                         "The idempotency key was already used for another request",
+	apperror.CodeTooManyRequests:                              "Too many requests, try again later",
+	apperror.CodeLoanLimitExceeded:                            "Loan limit exceeded, you can borrow up to ${limit}",
+	apperror.CodePartialRepaymentNotAllowed:                   "Only full repayment is allowed, the debt is ${amount}",
+	apperror.CodeUndefinedStockSymbol:                         "The stock has not been traded in this game",
+	apperror.CodeUndefinedSharedAsset:                         "The shared asset was not found",
+	apperror.CodeUndefinedBuyout:                              "The buyout was not found",
+	apperror.CodeBuyoutIsNotPending:                           "The buyout is no longer pending",
+	apperror.CodeNotAPartner:                                  "You are not a partner of this asset",
+	apperror.CodeUndefinedInsurance:                           "You have no such insurance",
+	apperror.CodeInsuranceAlreadyActive:                       "You already have this insurance",
+	apperror.CodeManagerModeDisabled:                          "The race is not in manager mode",
+	apperror.CodeUndefinedDeck:                                "Undefined deck",
+	apperror.CodeDeckCannotBeEmpty:                            "At least one card must stay in the deck",
+	apperror.CodeUserRequestIsNotPending:                      "The request is already resolved",
+	apperror.CodeUndefinedUserRequestType:                     "Undefined request type",
+	apperror.CodeInvalidUserRequestStatus:                     "A request can only be approved or rejected",
+	apperror.CodeUndefinedNotification:                        "Notification not found",
+	apperror.CodeUndefinedRaceTemplate:                        "Race template not found",
+	apperror.CodeUnsupportedLanguage:                          "Language {language} is not supported",
+	apperror.CodeUndefinedCardCollection:                      "Card collection {collection} is not available in {language}",
+	apperror.CodeUndefinedBigRaceRule:                         "Unknown fast track rule {type}",
+	apperror.CodeHandModeDisabled:                             "Hand mode is disabled",
+	apperror.CodeInvalidDiceValue:                             "Dice values must be between 1 and 6",
+	apperror.CodeTooManyDice:                                  "Only {allowed} dice may be rolled this turn",
+	apperror.CodeRollAwaitsConfirmation:                       "The previous roll is waiting for the moderator",
+	apperror.CodeUndefinedPendingRoll:                         "There is no roll to confirm",
+	apperror.CodeTeamModeDisabled:                             "Team play is disabled",
+	apperror.CodeInvalidTeam:                                  "The team {team} is invalid: check its name, captain and members",
+	apperror.CodeUndefinedTeamDecision:                        "Team decision not found",
+	apperror.CodeTeamDecisionIsNotPending:                     "The team decision has already been resolved",
+	apperror.CodeUndefinedTournament:                          "Tournament not found",
+	apperror.CodeInvalidTournament:                            "Check the format, the advancement, the table size of the tournament and how many players advance or how many rounds are played",
+	apperror.CodeTournamentIsStarted:                          "The tournament has already started",
+	apperror.CodeTournamentIsFinished:                         "The tournament is over",
+	apperror.CodeTournamentRoundInProgress:                    "Round {round} is still being played",
+	apperror.CodeUndefinedTournamentTable:                     "Table of the current round not found or already finished",
+
+	storage.MessageRealEstateHasBeenSold:    "Real estate has been sold",
+	storage.MessageStocksHaveBeenSold:       "Stocks have been sold",
+	storage.MessageGoldHasBeenSold:          "Gold has been sold",
+	storage.MessageYouBoughtRealEstate:      "You successfully bought real estate",
+	storage.MessageYouBoughtStocks:          "You successfully bought stocks of this company",
+	storage.MessageFailRiskDeal:             "The risky business passed you by",
+	storage.MessageFailLottery:              "You lost the lottery",
+	storage.MessageSuccessRiskDeal:          "You acquired a risky business",
+	storage.MessageSuccessLottery:           "You won the lottery",
+	storage.MessageYouBoughtDream:           "You successfully bought a dream",
+	storage.MessageYouBoughtBusiness:        "You successfully bought a business",
+	storage.MessageMoneyRequestAccepted:     "Money request was accepted",
+	storage.MessageMoneyRequestInProcessing: "Money request is in processing",
+	storage.MessageYouHadBaby:               "You had a baby",
+	storage.MessageYouHaveTooManyBabies:     "You have too many babies",
+	storage.YouHaveNoBabies:                 "You have no babies",
+	storage.YouHaveHealthyInsurance:         "You have health insurance",
+	storage.MessagePlayerBoughtBusiness:     "{username} bought a business {business}",
+	storage.MessagePlayerWentBankrupt:       "{username} went bankrupt",
+	storage.MessageYouReceivedTradeOffer:    "You received a trade offer",
+	storage.MessageTradeOfferAccepted:       "Your trade offer was accepted",
+	storage.MessageTradeOfferDeclined:       "Your trade offer was declined",
+	storage.MessageTradeOfferCountered:      "Your trade offer was countered",
+	storage.MessageInsuranceClaimPaid:       "Your {type} insurance paid out ${amount}",
+	storage.MessageInsuranceLapsed:          "Your {type} insurance lapsed, the premium of ${amount} could not be paid",
+	storage.MessageUserRequestReceived:      "{username} asks for ${amount}: {type}",
+	storage.MessageUserRequestApproved:      "Your {type} request was approved for ${amount}",
+	storage.MessageUserRequestRejected:      "Your {type} request was rejected: {reason}",
+	storage.MessageUserRequestExpired:       "The {type} request of {username} for ${amount} expired when the turn changed",
+	storage.MessageUserRequestCancelled:     "{username} cancelled the {type} request for ${amount}",
+	storage.MessageRollAwaitsConfirmation:   "{username} rolled {dice}, confirm the roll",
+	storage.MessageRollRejected:             "Your roll of {dice} was rejected, enter the dice again",
+	storage.MessageTeamDecisionProposed:     "{username} proposes a deal on \"{card}\"",
+	storage.MessageTeamDecisionApproved:     "The team approved the deal on \"{card}\"",
+	storage.MessageTeamDecisionRejected:     "The team rejected the deal on \"{card}\"",
+
+	storage.TransactionSentMoneyToBank:        "You paid ${amount} to the bank",
+	storage.TransactionSentMoney:              "Sent ${amount} to {username} (#{playerId})",
+	storage.TransactionReceivedMoney:          "{username} (#{playerId}) sent you ${amount}",
+	storage.TransactionSentStocks:             "Sent stocks ({count} pcs.) to {username}",
+	storage.TransactionReceivedStocks:         "{username} (#{playerId}) sent you stocks ({count} pcs.)",
+	storage.TransactionSentBusiness:           "Sent business shares ({count} pcs.) to {username}",
+	storage.TransactionReceivedBusiness:       "{username} (#{playerId}) sent you business shares ({count} pcs.)",
+	storage.TransactionTookLoan:               "Took a loan of ${amount}",
+	storage.TransactionPaidLoan:               "Paid ${amount} off the loan",
+	storage.TransactionTrade:                  "Trade #{offerId} with {username}",
+	storage.TransactionAuctionPremiumPaid:     "Paid an auction premium of ${amount} for {card}",
+	storage.TransactionAuctionPremium:         "Received an auction premium of ${amount} for {card}",
+	storage.TransactionAuctionPremiumReturned: "Returned the auction premium of ${amount} for {card}",
+	storage.TransactionAuctionPremiumRefunded: "The auction premium of ${amount} for {card} was refunded",
+	storage.TransactionSaleProceedsShare:      "Received ${amount} for your {percent}% share of {card}",
+	storage.TransactionBoughtOutShare:         "Paid ${amount} for a {percent}% share of {card}",
+	storage.TransactionSoldShare:              "Received ${amount} for a {percent}% share of {card}",
+	storage.TransactionInsurancePremium:       "Paid ${amount} of insurance premiums",
+	storage.TransactionInsuranceClaim:         "Received ${amount} from {type} insurance for {card}",
+	storage.TransactionModeratorAdjusted:      "The moderator adjusted your balance by ${amount}",
+}
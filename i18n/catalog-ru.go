@@ -0,0 +1,172 @@
+package i18n
+
+import (
+	"github.com/webjohny/cashflow-go/apperror"
+	"github.com/webjohny/cashflow-go/storage"
+)
+
+var ru = map[string]string{
+	apperror.CodeUndefinedUser:                                "Пользователь не найден",
+	apperror.CodeUndefinedUserRequest:                         "Запрос не найден",
+	apperror.CodeUserRequestHasBeenAlreadyApproved:            "Запрос уже одобрен",
+	apperror.CodeUndefinedPlayer:                              "Игрок не найден",
+	apperror.CodeUndefinedReceiverPlayer:                      "Получатель не найден",
+	apperror.CodeUndefinedGame:                                "Игра не найдена",
+	apperror.CodeGameIsFull:                                   "В игре нет свободных мест",
+	apperror.CodeGameIsStarted:                                "Игра уже началась",
+	apperror.CodeGameIsFinished:                               "Игра завершена",
+	apperror.CodeGameIsNotStarted:                             "Игра ещё не началась",
+	apperror.CodeGameIsPaused:                                 "Игра приостановлена",
+	apperror.CodeGameIsNotPaused:                              "Игра не приостановлена",
+	apperror.CodeUndefinedLobby:                               "Лобби не найдено",
+	apperror.CodeCannotCreatedRace:                            "Не удалось создать игру",
+	apperror.CodeCannotTakeBigDeals:                           "Вам недоступны крупные сделки",
+	apperror.CodeForbidden:                                    "Доступ запрещён",
+	apperror.CodeProcessFailed:                                "Не удалось выполнить операцию",
+	apperror.CodeIsNotValidCountValue:                         "Неверное количество",
+	apperror.CodeIsNotValidRealEstate:                         "Неверная недвижимость",
+	apperror.CodeIsNotValidBusiness:                           "Неверный бизнес",
+	apperror.CodeIsNotValidOtherAssets:                        "Неверный актив",
+	apperror.CodeInvalidTypeOfCard:                            "Неверный тип карточки",
+	apperror.CodeWrongAmount:                                  "Неверная сумма",
+	apperror.CodeTooManyAssets:                                "Слишком много активов",
+	apperror.CodeNotFoundAssets:                               "Активы не найдены",
+	apperror.CodeNotEnoughMoney:                               "Недостаточно денег",
+	apperror.CodeNotFoundStocks:                               "Акции не найдены",
+	apperror.CodeNotEnoughStocks:                              "Недостаточно акций",
+	apperror.CodeUndefinedProfession:                          "Профессия не найдена",
+	apperror.CodeInvalidCard:                                  "Неверная карточка",
+	apperror.CodeDreamPlaceHasAlreadyTaken:                    "Эта мечта уже занята",
+	apperror.CodeNotEnoughAsset:                               "Недостаточно активов",
+	apperror.CodePermissionDenied:                             "Недостаточно прав",
+	apperror.CodeTransactionDeclined:                          "Транзакция отклонена",
+	apperror.CodeWrongAmountForTakingLoan:                     "Неверная сумма кредита",
+	apperror.CodeWrongAmountForPayingLoan:                     "Неверная сумма погашения кредита",
+	apperror.CodeCommonPassiveIncomeGreaterThanCashFlowOfCard: "Общий пассивный доход больше денежного потока карточки",
+	apperror.CodeYouHaveNoProperties:                          "У вас нет недвижимости",
+	apperror.CodeNotSuitableBuilding:                          "Здание не подходит под предложение",
+	apperror.CodeLimitedPartnership:                           "Превышен лимит ограниченного партнёрства",
+	apperror.CodeIncorrectCount:                               "Неверное количество",
+	apperror.CodeCardsNotFound:                                "Карточки не найдены",
+	apperror.CodeProfessionsNotFound:                          "Профессии не найдены",
+	apperror.CodeNotFoundTheRealEstate:                        "Недвижимость не найдена",
+	apperror.CodeNotFoundTheBusiness:                          "Бизнес не найден",
+	apperror.CodeNotFoundTheAsset:                             "Актив не найден",
+	apperror.CodeTransactionAlreadyExists:                     "Транзакция уже существует",
+	apperror.CodeItsNotYourMoveNow:                            "Сейчас не ваш ход",
+	apperror.CodeInsufficientPlayers:                          "Недостаточно игроков",
+	apperror.CodeMovingBigRaceDeclined:                        "Вы пока не можете перейти на большой круг",
+	apperror.CodeYouAreBankrupt:                               "Вы банкрот",
+	apperror.CodeForbiddenByOwner:                             "Это может сделать только владелец",
+	apperror.CodeIfHadBeenInsurance:                           "Страховка покрыла бы это",
+	apperror.CodeUserIsNotOnWaitList:                          "Пользователя нет в листе ожидания",
+	apperror.CodeEmptyMessage:                                 "Сообщение пустое",
+	apperror.CodeMessageIsTooLong:                             "Сообщение слишком длинное",
+	apperror.CodeUndefinedMessage:                             "Сообщение не найдено",
+	apperror.CodeIncorrectReaction:                            "Неверная реакция",
+	apperror.CodeUndefinedTradeOffer:                          "Предложение обмена не найдено",
+	apperror.CodeTradeOfferIsNotPending:                       "Предложение обмена уже неактуально",
+	apperror.CodeEmptyTradeOffer:                              "Предложение обмена пустое",
+	apperror.CodeCannotTradeWithYourself:                      "Нельзя обмениваться с самим собой",
+	apperror.CodeAssetAlreadyOwned:                            "Актив уже принадлежит игроку",
+	apperror.CodeUndefinedAuction:                             "Аукцион не найден",
+	apperror.CodeAuctionIsFinished:                            "Аукцион завершён",
+	apperror.CodeBidIsTooLow:                                  "Ставка слишком низкая",
+	apperror.CodeBadRequest:                                   "Некорректный запрос",
+	apperror.CodeValidationFailed:                             "Ошибка валидации",
+	apperror.CodeIdempotencyKeyInUse:                          "Запрос с этим ключом идемпотентности ещё выполняется",
+	apperror.CodeIdempotencyKeyReused:                         "Ключ идемпотентности уже использован для другого запроса",
+	apperror.CodeTooManyRequests:                              "Слишком много запросов, попробуйте позже",
+	apperror.CodeLoanLimitExceeded:                            "Превышен лимит кредита, можно взять не более ${limit}",
+	apperror.CodePartialRepaymentNotAllowed:                   "Разрешено только полное погашение, долг составляет ${amount}",
+	apperror.CodeUndefinedStockSymbol:                         "Эта акция ещё не торговалась в игре",
+	apperror.CodeUndefinedSharedAsset:                         "Совместный актив не найден",
+	apperror.CodeUndefinedBuyout:                              "Выкуп доли не найден",
+	apperror.CodeBuyoutIsNotPending:                           "Выкуп доли уже обработан",
+	apperror.CodeNotAPartner:                                  "Вы не являетесь партнёром по этому активу",
+	apperror.CodeUndefinedInsurance:                           "У вас нет такой страховки",
+	apperror.CodeInsuranceAlreadyActive:                       "У вас уже есть эта страховка",
+	apperror.CodeManagerModeDisabled:                          "Игра не в режиме ведущего",
+	apperror.CodeUndefinedDeck:                                "Колода не найдена",
+	apperror.CodeDeckCannotBeEmpty:                            "В колоде должна остаться хотя бы одна карточка",
+	apperror.CodeUserRequestIsNotPending:                      "Запрос уже обработан",
+	apperror.CodeUndefinedUserRequestType:                     "Неизвестный тип запроса",
+	apperror.CodeInvalidUserRequestStatus:                     "Запрос можно только одобрить или отклонить",
+	apperror.CodeUndefinedNotification:                        "Уведомление не найдено",
+	apperror.CodeUndefinedRaceTemplate:                        "Шаблон игры не найден",
+	apperror.CodeUnsupportedLanguage:                          "Язык {language} не поддерживается",
+	apperror.CodeUndefinedCardCollection:                      "Коллекция карточек {collection} недоступна на языке {language}",
+	apperror.CodeUndefinedBigRaceRule:                         "Неизвестное условие большого круга {type}",
+	apperror.CodeHandModeDisabled:                             "Ручной режим выключен",
+	apperror.CodeInvalidDiceValue:                             "Значения кубиков должны быть от 1 до 6",
+	apperror.CodeTooManyDice:                                  "В этот ход можно бросить кубиков: {allowed}",
+	apperror.CodeRollAwaitsConfirmation:                       "Предыдущий бросок ждёт подтверждения ведущего",
+	apperror.CodeUndefinedPendingRoll:                         "Нет броска для подтверждения",
+	apperror.CodeTeamModeDisabled:                             "Командная игра отключена",
+	apperror.CodeInvalidTeam:                                  "Команда {team} некорректна: проверьте название, капитана и участников",
+	apperror.CodeUndefinedTeamDecision:                        "Решение команды не найдено",
+	apperror.CodeTeamDecisionIsNotPending:                     "Решение команды уже принято",
+	apperror.CodeUndefinedTournament:                          "Турнир не найден",
+	apperror.CodeInvalidTournament:                            "Проверьте формат, правило прохода, размер стола турнира и сколько игроков проходит дальше или сколько туров играется",
+	apperror.CodeTournamentIsStarted:                          "Турнир уже начался",
+	apperror.CodeTournamentIsFinished:                         "Турнир завершён",
+	apperror.CodeTournamentRoundInProgress:                    "Тур {round} ещё не сыгран",
+	apperror.CodeUndefinedTournamentTable:                     "Стол текущего раунда не найден или уже завершён",
+
+	storage.MessageRealEstateHasBeenSold:    "Недвижимость продана",
+	storage.MessageStocksHaveBeenSold:       "Акции проданы",
+	storage.MessageGoldHasBeenSold:          "Золото продано",
+	storage.MessageYouBoughtRealEstate:      "Вы успешно купили недвижимость",
+	storage.MessageYouBoughtStocks:          "Вы успешно купили акции этой компании",
+	storage.MessageFailRiskDeal:             "Рискованный бизнес прошёл мимо вас",
+	storage.MessageFailLottery:              "Вы проиграли в лотерею",
+	storage.MessageSuccessRiskDeal:          "Вы приобрели рискованный бизнес",
+	storage.MessageSuccessLottery:           "Вы выиграли в лотерею",
+	storage.MessageYouBoughtDream:           "Вы успешно купили мечту",
+	storage.MessageYouBoughtBusiness:        "Вы успешно купили бизнес",
+	storage.MessageMoneyRequestAccepted:     "Запрос денег одобрен",
+	storage.MessageMoneyRequestInProcessing: "Запрос денег в обработке",
+	storage.MessageYouHadBaby:               "У вас родился ребёнок",
+	storage.MessageYouHaveTooManyBabies:     "У вас слишком много детей",
+	storage.YouHaveNoBabies:                 "У вас нет детей",
+	storage.YouHaveHealthyInsurance:         "У вас есть медицинская страховка",
+	storage.MessagePlayerBoughtBusiness:     "{username} купил(а) бизнес {business}",
+	storage.MessagePlayerWentBankrupt:       "{username} обанкротился(ась)",
+	storage.MessageYouReceivedTradeOffer:    "Вы получили предложение обмена",
+	storage.MessageTradeOfferAccepted:       "Ваше предложение обмена принято",
+	storage.MessageTradeOfferDeclined:       "Ваше предложение обмена отклонено",
+	storage.MessageTradeOfferCountered:      "На ваше предложение обмена пришло встречное",
+	storage.MessageInsuranceClaimPaid:       "Страховка ({type}) выплатила ${amount}",
+	storage.MessageInsuranceLapsed:          "Страховка ({type}) прекращена, взнос ${amount} не был оплачен",
+	storage.MessageUserRequestReceived:      "{username} просит ${amount}: {type}",
+	storage.MessageUserRequestApproved:      "Ваш запрос ({type}) одобрен на ${amount}",
+	storage.MessageUserRequestRejected:      "Ваш запрос ({type}) отклонён: {reason}",
+	storage.MessageUserRequestExpired:       "Запрос ({type}) игрока {username} на ${amount} истёк при смене хода",
+	storage.MessageUserRequestCancelled:     "{username} отменил(а) запрос ({type}) на ${amount}",
+	storage.MessageRollAwaitsConfirmation:   "{username} выбросил(а) {dice}, подтвердите бросок",
+	storage.MessageRollRejected:             "Ваш бросок {dice} отклонён, введите кубики заново",
+	storage.MessageTeamDecisionProposed:     "{username} предлагает сделку по карточке \"{card}\"",
+	storage.MessageTeamDecisionApproved:     "Команда одобрила сделку по карточке \"{card}\"",
+	storage.MessageTeamDecisionRejected:     "Команда отклонила сделку по карточке \"{card}\"",
+
+	storage.TransactionSentMoneyToBank:        "Вы перевели ${amount} банку",
+	storage.TransactionSentMoney:              "Перевёл ${amount} игроку {username} (#{playerId})",
+	storage.TransactionReceivedMoney:          "{username} (#{playerId}) перевёл Вам ${amount}",
+	storage.TransactionSentStocks:             "Перевёл акции ({count} шт.) игроку {username}",
+	storage.TransactionReceivedStocks:         "{username} (#{playerId}) перевёл Вам ({count} шт.) акций",
+	storage.TransactionSentBusiness:           "Перевёл доли бизнеса ({count} шт.) игроку {username}",
+	storage.TransactionReceivedBusiness:       "{username} (#{playerId}) перевёл Вам ({count} шт.) долей бизнеса",
+	storage.TransactionTookLoan:               "Взял(а) в кредит ${amount}",
+	storage.TransactionPaidLoan:               "Оплата по кредиту ${amount}",
+	storage.TransactionTrade:                  "Обмен #{offerId} с игроком {username}",
+	storage.TransactionAuctionPremiumPaid:     "Оплатил(а) премию аукциона ${amount} за {card}",
+	storage.TransactionAuctionPremium:         "Получил(а) премию аукциона ${amount} за {card}",
+	storage.TransactionAuctionPremiumReturned: "Вернул(а) премию аукциона ${amount} за {card}",
+	storage.TransactionAuctionPremiumRefunded: "Премия аукциона ${amount} за {card} возвращена",
+	storage.TransactionSaleProceedsShare:      "Получил(а) ${amount} за свою долю {percent}% в {card}",
+	storage.TransactionBoughtOutShare:         "Заплатил(а) ${amount} за долю {percent}% в {card}",
+	storage.TransactionSoldShare:              "Получил(а) ${amount} за долю {percent}% в {card}",
+	storage.TransactionInsurancePremium:       "Оплатил(а) страховые взносы ${amount}",
+	storage.TransactionInsuranceClaim:         "Получил(а) ${amount} по страховке ({type}) за {card}",
+	storage.TransactionModeratorAdjusted:      "Модератор изменил ваш баланс на ${amount}",
+}
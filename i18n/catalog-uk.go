@@ -0,0 +1,172 @@
+package i18n
+
+import (
+	"github.com/webjohny/cashflow-go/apperror"
+	"github.com/webjohny/cashflow-go/storage"
+)
+
+var uk = map[string]string{
+	apperror.CodeUndefinedUser:                                "Користувача не знайдено",
+	apperror.CodeUndefinedUserRequest:                         "Запит не знайдено",
+	apperror.CodeUserRequestHasBeenAlreadyApproved:            "Запит уже схвалено",
+	apperror.CodeUndefinedPlayer:                              "Гравця не знайдено",
+	apperror.CodeUndefinedReceiverPlayer:                      "Отримувача не знайдено",
+	apperror.CodeUndefinedGame:                                "Гру не знайдено",
+	apperror.CodeGameIsFull:                                   "У грі немає вільних місць",
+	apperror.CodeGameIsStarted:                                "Гра вже почалася",
+	apperror.CodeGameIsFinished:                               "Гру завершено",
+	apperror.CodeGameIsNotStarted:                             "Гра ще не почалася",
+	apperror.CodeGameIsPaused:                                 "Гру призупинено",
+	apperror.CodeGameIsNotPaused:                              "Гра не призупинена",
+	apperror.CodeUndefinedLobby:                               "Лобі не знайдено",
+	apperror.CodeCannotCreatedRace:                            "Не вдалося створити гру",
+	apperror.CodeCannotTakeBigDeals:                           "Вам недоступні великі угоди",
+	apperror.CodeForbidden:                                    "Доступ заборонено",
+	apperror.CodeProcessFailed:                                "Не вдалося виконати операцію",
+	apperror.CodeIsNotValidCountValue:                         "Невірна кількість",
+	apperror.CodeIsNotValidRealEstate:                         "Невірна нерухомість",
+	apperror.CodeIsNotValidBusiness:                           "Невірний бізнес",
+	apperror.CodeIsNotValidOtherAssets:                        "Невірний актив",
+	apperror.CodeInvalidTypeOfCard:                            "Невірний тип картки",
+	apperror.CodeWrongAmount:                                  "Невірна сума",
+	apperror.CodeTooManyAssets:                                "Забагато активів",
+	apperror.CodeNotFoundAssets:                               "Активи не знайдено",
+	apperror.CodeNotEnoughMoney:                               "Недостатньо грошей",
+	apperror.CodeNotFoundStocks:                               "Акції не знайдено",
+	apperror.CodeNotEnoughStocks:                              "Недостатньо акцій",
+	apperror.CodeUndefinedProfession:                          "Професію не знайдено",
+	apperror.CodeInvalidCard:                                  "Невірна картка",
+	apperror.CodeDreamPlaceHasAlreadyTaken:                    "Ця мрія вже зайнята",
+	apperror.CodeNotEnoughAsset:                               "Недостатньо активів",
+	apperror.CodePermissionDenied:                             "Недостатньо прав",
+	apperror.CodeTransactionDeclined:                          "Транзакцію відхилено",
+	apperror.CodeWrongAmountForTakingLoan:                     "Невірна сума кредиту",
+	apperror.CodeWrongAmountForPayingLoan:                     "Невірна сума погашення кредиту",
+	apperror.CodeCommonPassiveIncomeGreaterThanCashFlowOfCard: "Спільний пасивний дохід більший за грошовий потік картки",
+	apperror.CodeYouHaveNoProperties:                          "У вас немає нерухомості",
+	apperror.CodeNotSuitableBuilding:                          "Будівля не підходить під пропозицію",
+	apperror.CodeLimitedPartnership:                           "Перевищено ліміт обмеженого партнерства",
+	apperror.CodeIncorrectCount:                               "Невірна кількість",
+	apperror.CodeCardsNotFound:                                "Картки не знайдено",
+	apperror.CodeProfessionsNotFound:                          "Професії не знайдено",
+	apperror.CodeNotFoundTheRealEstate:                        "Нерухомість не знайдено",
+	apperror.CodeNotFoundTheBusiness:                          "Бізнес не знайдено",
+	apperror.CodeNotFoundTheAsset:                             "Актив не знайдено",
+	apperror.CodeTransactionAlreadyExists:                     "Транзакція вже існує",
+	apperror.CodeItsNotYourMoveNow:                            "Зараз не ваш хід",
+	apperror.CodeInsufficientPlayers:                          "Недостатньо гравців",
+	apperror.CodeMovingBigRaceDeclined:                        "Ви поки не можете перейти на велике коло",
+	apperror.CodeYouAreBankrupt:                               "Ви банкрут",
+	apperror.CodeForbiddenByOwner:                             "Це може зробити лише власник",
+	apperror.CodeIfHadBeenInsurance:                           "Страховка покрила б це",
+	apperror.CodeUserIsNotOnWaitList:                          "Користувача немає в листі очікування",
+	apperror.CodeEmptyMessage:                                 "Повідомлення порожнє",
+	apperror.CodeMessageIsTooLong:                             "Повідомлення задовге",
+	apperror.CodeUndefinedMessage:                             "Повідомлення не знайдено",
+	apperror.CodeIncorrectReaction:                            "Невірна реакція",
+	apperror.CodeUndefinedTradeOffer:                          "Пропозицію обміну не знайдено",
+	apperror.CodeTradeOfferIsNotPending:                       "Пропозиція обміну вже неактуальна",
+	apperror.CodeEmptyTradeOffer:                              "Пропозиція обміну порожня",
+	apperror.CodeCannotTradeWithYourself:                      "Не можна обмінюватися із самим собою",
+	apperror.CodeAssetAlreadyOwned:                            "Актив уже належить гравцю",
+	apperror.CodeUndefinedAuction:                             "Аукціон не знайдено",
+	apperror.CodeAuctionIsFinished:                            "Аукціон завершено",
+	apperror.CodeBidIsTooLow:                                  "Ставка занизька",
+	apperror.CodeBadRequest:                                   "Некоректний запит",
+	apperror.CodeValidationFailed:                             "Помилка валідації",
+	apperror.CodeIdempotencyKeyInUse:                          "Запит із цим ключем ідемпотентності ще виконується",
+	apperror.CodeIdempotencyKeyReused:                         "Ключ ідемпотентності вже використано для іншого запиту",
+	apperror.CodeTooManyRequests:                              "Забагато запитів, спробуйте пізніше",
+	apperror.CodeLoanLimitExceeded:                            "Перевищено ліміт кредиту, можна взяти не більше ${limit}",
+	apperror.CodePartialRepaymentNotAllowed:                   "Дозволено лише повне погашення, борг становить ${amount}",
+	apperror.CodeUndefinedStockSymbol:                         "Ця акція ще не торгувалася у грі",
+	apperror.CodeUndefinedSharedAsset:                         "Спільний актив не знайдено",
+	apperror.CodeUndefinedBuyout:                              "Викуп частки не знайдено",
+	apperror.CodeBuyoutIsNotPending:                           "Викуп частки вже оброблено",
+	apperror.CodeNotAPartner:                                  "Ви не є партнером за цим активом",
+	apperror.CodeUndefinedInsurance:                           "У вас немає такої страховки",
+	apperror.CodeInsuranceAlreadyActive:                       "У вас вже є ця страховка",
+	apperror.CodeManagerModeDisabled:                          "Гра не в режимі ведучого",
+	apperror.CodeUndefinedDeck:                                "Колоду не знайдено",
+	apperror.CodeDeckCannotBeEmpty:                            "У колоді має залишитися хоча б одна картка",
+	apperror.CodeUserRequestIsNotPending:                      "Запит уже оброблено",
+	apperror.CodeUndefinedUserRequestType:                     "Невідомий тип запиту",
+	apperror.CodeInvalidUserRequestStatus:                     "Запит можна лише схвалити або відхилити",
+	apperror.CodeUndefinedNotification:                        "Сповіщення не знайдено",
+	apperror.CodeUndefinedRaceTemplate:                        "Шаблон гри не знайдено",
+	apperror.CodeUnsupportedLanguage:                          "Мова {language} не підтримується",
+	apperror.CodeUndefinedCardCollection:                      "Колекція карток {collection} недоступна мовою {language}",
+	apperror.CodeUndefinedBigRaceRule:                         "Невідома умова великого кола {type}",
+	apperror.CodeHandModeDisabled:                             "Ручний режим вимкнено",
+	apperror.CodeInvalidDiceValue:                             "Значення кубиків мають бути від 1 до 6",
+	apperror.CodeTooManyDice:                                  "Цього ходу можна кинути кубиків: {allowed}",
+	apperror.CodeRollAwaitsConfirmation:                       "Попередній кидок чекає підтвердження ведучого",
+	apperror.CodeUndefinedPendingRoll:                         "Немає кидка для підтвердження",
+	apperror.CodeTeamModeDisabled:                             "Командну гру вимкнено",
+	apperror.CodeInvalidTeam:                                  "Команда {team} некоректна: перевірте назву, капітана та учасників",
+	apperror.CodeUndefinedTeamDecision:                        "Рішення команди не знайдено",
+	apperror.CodeTeamDecisionIsNotPending:                     "Рішення команди вже ухвалено",
+	apperror.CodeUndefinedTournament:                          "Турнір не знайдено",
+	apperror.CodeInvalidTournament:                            "Перевірте формат, правило проходу, розмір столу турніру та скільки гравців проходить далі або скільки турів грається",
+	apperror.CodeTournamentIsStarted:                          "Турнір уже розпочався",
+	apperror.CodeTournamentIsFinished:                         "Турнір завершено",
+	apperror.CodeTournamentRoundInProgress:                    "Тур {round} ще не зіграно",
+	apperror.CodeUndefinedTournamentTable:                     "Стіл поточного раунду не знайдено або вже завершено",
+
+	storage.MessageRealEstateHasBeenSold:    "Нерухомість продано",
+	storage.MessageStocksHaveBeenSold:       "Акції продано",
+	storage.MessageGoldHasBeenSold:          "Золото продано",
+	storage.MessageYouBoughtRealEstate:      "Ви успішно купили нерухомість",
+	storage.MessageYouBoughtStocks:          "Ви успішно купили акції цієї компанії",
+	storage.MessageFailRiskDeal:             "Ризикований бізнес пройшов повз вас",
+	storage.MessageFailLottery:              "Ви програли в лотерею",
+	storage.MessageSuccessRiskDeal:          "Ви придбали ризикований бізнес",
+	storage.MessageSuccessLottery:           "Ви виграли в лотерею",
+	storage.MessageYouBoughtDream:           "Ви успішно купили мрію",
+	storage.MessageYouBoughtBusiness:        "Ви успішно купили бізнес",
+	storage.MessageMoneyRequestAccepted:     "Запит грошей схвалено",
+	storage.MessageMoneyRequestInProcessing: "Запит грошей в обробці",
+	storage.MessageYouHadBaby:               "У вас народилася дитина",
+	storage.MessageYouHaveTooManyBabies:     "У вас забагато дітей",
+	storage.YouHaveNoBabies:                 "У вас немає дітей",
+	storage.YouHaveHealthyInsurance:         "У вас є медична страховка",
+	storage.MessagePlayerBoughtBusiness:     "{username} купив(ла) бізнес {business}",
+	storage.MessagePlayerWentBankrupt:       "{username} збанкрутував(ла)",
+	storage.MessageYouReceivedTradeOffer:    "Ви отримали пропозицію обміну",
+	storage.MessageTradeOfferAccepted:       "Вашу пропозицію обміну прийнято",
+	storage.MessageTradeOfferDeclined:       "Вашу пропозицію обміну відхилено",
+	storage.MessageTradeOfferCountered:      "На вашу пропозицію обміну надійшла зустрічна",
+	storage.MessageInsuranceClaimPaid:       "Страховка ({type}) виплатила ${amount}",
+	storage.MessageInsuranceLapsed:          "Страховку ({type}) припинено, внесок ${amount} не було сплачено",
+	storage.MessageUserRequestReceived:      "{username} просить ${amount}: {type}",
+	storage.MessageUserRequestApproved:      "Ваш запит ({type}) схвалено на ${amount}",
+	storage.MessageUserRequestRejected:      "Ваш запит ({type}) відхилено: {reason}",
+	storage.MessageUserRequestExpired:       "Запит ({type}) гравця {username} на ${amount} сплив при зміні ходу",
+	storage.MessageUserRequestCancelled:     "{username} скасував(ла) запит ({type}) на ${amount}",
+	storage.MessageRollAwaitsConfirmation:   "{username} викинув(ла) {dice}, підтвердіть кидок",
+	storage.MessageRollRejected:             "Ваш кидок {dice} відхилено, введіть кубики знову",
+	storage.MessageTeamDecisionProposed:     "{username} пропонує угоду за карткою \"{card}\"",
+	storage.MessageTeamDecisionApproved:     "Команда схвалила угоду за карткою \"{card}\"",
+	storage.MessageTeamDecisionRejected:     "Команда відхилила угоду за карткою \"{card}\"",
+
+	storage.TransactionSentMoneyToBank:        "Ви переказали ${amount} банку",
+	storage.TransactionSentMoney:              "Переказав ${amount} гравцю {username} (#{playerId})",
+	storage.TransactionReceivedMoney:          "{username} (#{playerId}) переказав Вам ${amount}",
+	storage.TransactionSentStocks:             "Переказав акції ({count} шт.) гравцю {username}",
+	storage.TransactionReceivedStocks:         "{username} (#{playerId}) переказав Вам ({count} шт.) акцій",
+	storage.TransactionSentBusiness:           "Переказав частки бізнесу ({count} шт.) гравцю {username}",
+	storage.TransactionReceivedBusiness:       "{username} (#{playerId}) переказав Вам ({count} шт.) часток бізнесу",
+	storage.TransactionTookLoan:               "Взяв(ла) кредит ${amount}",
+	storage.TransactionPaidLoan:               "Оплата кредиту ${amount}",
+	storage.TransactionTrade:                  "Обмін #{offerId} з гравцем {username}",
+	storage.TransactionAuctionPremiumPaid:     "Сплатив(ла) премію аукціону ${amount} за {card}",
+	storage.TransactionAuctionPremium:         "Отримав(ла) премію аукціону ${amount} за {card}",
+	storage.TransactionAuctionPremiumReturned: "Повернув(ла) премію аукціону ${amount} за {card}",
+	storage.TransactionAuctionPremiumRefunded: "Премію аукціону ${amount} за {card} повернуто",
+	storage.TransactionSaleProceedsShare:      "Отримав(ла) ${amount} за свою частку {percent}% у {card}",
+	storage.TransactionBoughtOutShare:         "Заплатив(ла) ${amount} за частку {percent}% у {card}",
+	storage.TransactionSoldShare:              "Отримав(ла) ${amount} за частку {percent}% у {card}",
+	storage.TransactionInsurancePremium:       "Сплатив(ла) страхові внески ${amount}",
+	storage.TransactionInsuranceClaim:         "Отримав(ла) ${amount} за страховкою ({type}) за {card}",
+	storage.TransactionModeratorAdjusted:      "Модератор змінив ваш баланс на ${amount}",
+}
@@ -0,0 +1,98 @@
+package i18n
+
+import (
+	"fmt"
+	"strings"
+)
+
+const DefaultLanguage = "en"
+
+type Params map[string]interface{}
+
+var catalogs = map[string]map[string]string{
+	"en": en,
+	"ru": ru,
+	"uk": uk,
+}
+
+func Languages() []string {
+	return []string{"en", "ru", "uk"}
+}
+
+func IsSupported(language string) bool {
+	_, ok := catalogs[language]
+
+	return ok
+}
+
+// Normalize reduces a locale such as "uk-UA" to a supported language, falling back to the default one.
+func Normalize(language string) string {
+	language = baseLanguage(language)
+
+	if !IsSupported(language) {
+		return DefaultLanguage
+	}
+
+	return language
+}
+
+// ParseAcceptLanguage picks the first supported language of an Accept-Language header.
+func ParseAcceptLanguage(header string) string {
+	for _, part := range strings.Split(header, ",") {
+		language := baseLanguage(strings.Split(part, ";")[0])
+
+		if IsSupported(language) {
+			return language
+		}
+	}
+
+	return DefaultLanguage
+}
+
+func baseLanguage(tag string) string {
+	tag = strings.ToLower(strings.TrimSpace(tag))
+
+	if index := strings.IndexAny(tag, "-_"); index > 0 {
+		tag = tag[:index]
+	}
+
+	if tag == "ua" {
+		return "uk"
+	}
+
+	return tag
+}
+
+func Catalog(language string) map[string]string {
+	catalog := make(map[string]string, len(en))
+
+	for code, message := range en {
+		catalog[code] = message
+	}
+
+	for code, message := range catalogs[Normalize(language)] {
+		catalog[code] = message
+	}
+
+	return catalog
+}
+
+// Translate renders a message by its code, replacing {name} placeholders with params.
+// Unknown codes are returned as is, so plain strings pass through untouched.
+func Translate(language string, code string, params Params) string {
+	message, ok := catalogs[Normalize(language)][code]
+
+	if !ok {
+		message, ok = en[code]
+	}
+
+	if !ok {
+		message = code
+	}
+
+	for key, value := range params {
+		message = strings.ReplaceAll(message, "{"+key+"}", fmt.Sprint(value))
+	}
+
+	return message
+}
@@ -0,0 +1,29 @@
+package i18n_test
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/webjohny/cashflow-go/apperror"
+	"github.com/webjohny/cashflow-go/i18n"
+	"github.com/webjohny/cashflow-go/storage"
+	"testing"
+)
+
+func TestTranslate(t *testing.T) {
+	params := i18n.Params{"amount": 1500, "username": "Alice", "playerId": 7}
+
+	assert.Equal(t, "Sent $1500 to Alice (#7)", i18n.Translate("en", storage.TransactionSentMoney, params))
+	assert.Equal(t, "Перевёл $1500 игроку Alice (#7)", i18n.Translate("ru", storage.TransactionSentMoney, params))
+	assert.Equal(t, "Переказав $1500 гравцю Alice (#7)", i18n.Translate("uk-UA", storage.TransactionSentMoney, params))
+}
+
+func TestTranslateFallback(t *testing.T) {
+	assert.Equal(t, "Not enough money", i18n.Translate("de", apperror.CodeNotEnoughMoney, nil))
+	assert.Equal(t, "plain text", i18n.Translate("ru", "plain text", nil))
+}
+
+func TestParseAcceptLanguage(t *testing.T) {
+	assert.Equal(t, "uk", i18n.ParseAcceptLanguage("uk-UA,uk;q=0.9,en;q=0.8"))
+	assert.Equal(t, "ru", i18n.ParseAcceptLanguage("de-DE, ru;q=0.7"))
+	assert.Equal(t, "en", i18n.ParseAcceptLanguage("fr-FR"))
+	assert.Equal(t, "en", i18n.ParseAcceptLanguage(""))
+}
@@ -18,39 +18,70 @@ var (
 	db *gorm.DB = config.SetupDatabaseConnection()
 
 	// Repositories
-	raceRepository        repository.RaceRepository        = repository.NewRaceRepository(db)
-	lobbyRepository       repository.LobbyRepository       = repository.NewLobbyRepository(db)
-	userRepository        repository.UserRepository        = repository.NewUserRepository(db)
-	userRequestRepository repository.UserRequestRepository = repository.NewUserRequestRepository(db)
-	playerRepository      repository.PlayerRepository      = repository.NewPlayerRepository(db)
-	professionRepository  repository.ProfessionRepository  = repository.NewProfessionRepository(os.Getenv("PROFESSIONS_PATH"))
-	trxRepository         repository.TransactionRepository = repository.NewTransactionRepository(db)
+	raceRepository           repository.RaceRepository           = repository.NewRaceRepository(db)
+	lobbyRepository          repository.LobbyRepository          = repository.NewLobbyRepository(db)
+	userRepository           repository.UserRepository           = repository.NewUserRepository(db)
+	userRequestRepository    repository.UserRequestRepository    = repository.NewUserRequestRepository(db)
+	playerRepository         repository.PlayerRepository         = repository.NewPlayerRepository(db)
+	professionRepository     repository.ProfessionRepository     = repository.NewProfessionRepository(os.Getenv("PROFESSIONS_PATH"))
+	trxRepository            repository.TransactionRepository    = repository.NewTransactionRepository(db)
+	chatRepository           repository.ChatRepository           = repository.NewChatRepository(db)
+	tradeRepository          repository.TradeRepository          = repository.NewTradeRepository(db)
+	idempotencyRepository    repository.IdempotencyRepository    = repository.NewIdempotencyRepository(db)
+	sharedAssetRepository    repository.SharedAssetRepository    = repository.NewSharedAssetRepository(db)
+	moderatorAuditRepository repository.ModeratorAuditRepository = repository.NewModeratorAuditRepository(db)
+	notificationRepository   repository.NotificationRepository   = repository.NewNotificationRepository(db)
+	raceTemplateRepository   repository.RaceTemplateRepository   = repository.NewRaceTemplateRepository(db)
+	teamDecisionRepository   repository.TeamDecisionRepository   = repository.NewTeamDecisionRepository(db)
+	tournamentRepository     repository.TournamentRepository     = repository.NewTournamentRepository(db)
 
 	// Services
-	jwtService         service.JWTService         = service.NewJWTService()
-	userService        service.UserService        = service.NewUserService(userRepository)
-	transactionService service.TransactionService = service.NewTransactionService(trxRepository)
-	professionService  service.ProfessionService  = service.NewProfessionService(professionRepository)
-	playerService      service.PlayerService      = service.NewPlayerService(playerRepository, professionService, transactionService)
-	userRequestService service.UserRequestService = service.NewUserRequestService(userRequestRepository)
-	authService        service.AuthService        = service.NewAuthService(userRepository)
-	gameService        service.GameService        = service.NewGameService(raceService, playerService, lobbyService, professionService)
-	raceService        service.RaceService        = service.NewRaceService(raceRepository, playerService, transactionService)
-	lobbyService       service.LobbyService       = service.NewLobbyService(lobbyRepository)
-	cardService        service.CardService        = service.NewCardService(gameService, raceService, playerService)
-	financeService     service.FinanceService     = service.NewFinanceService(userRequestRepository, cardService, raceService, playerService)
+	jwtService            service.JWTService            = service.NewJWTService()
+	userService           service.UserService           = service.NewUserService(userRepository)
+	transactionService    service.TransactionService    = service.NewTransactionService(trxRepository)
+	professionService     service.ProfessionService     = service.NewProfessionService(professionRepository)
+	chatService           service.ChatService           = service.NewChatService(chatRepository, playerRepository)
+	notificationService   service.NotificationService   = service.NewNotificationService(notificationRepository, playerRepository)
+	playerService         service.PlayerService         = service.NewPlayerService(playerRepository, sharedAssetRepository, professionService, transactionService, chatService, notificationService)
+	authService           service.AuthService           = service.NewAuthService(userRepository)
+	gameService           service.GameService           = service.NewGameService(raceService, playerService, lobbyService, professionService)
+	raceService           service.RaceService           = service.NewRaceService(raceRepository, playerService, transactionService, userRequestRepository)
+	userRequestService    service.UserRequestService    = service.NewUserRequestService(userRequestRepository, raceService, playerService)
+	lobbyService          service.LobbyService          = service.NewLobbyService(lobbyRepository)
+	cardService           service.CardService           = service.NewCardService(gameService, raceService, playerService)
+	financeService        service.FinanceService        = service.NewFinanceService(userRequestService, cardService, raceService, playerService)
+	tradeService          service.TradeService          = service.NewTradeService(tradeRepository, raceService, playerService)
+	auctionService        service.AuctionService        = service.NewAuctionService(raceService, playerService)
+	marketService         service.MarketService         = service.NewMarketService(raceService)
+	sharedAssetService    service.SharedAssetService    = service.NewSharedAssetService(sharedAssetRepository, raceService, playerService)
+	idempotencyService    service.IdempotencyService    = service.NewIdempotencyService(idempotencyRepository)
+	moderatorAuditService service.ModeratorAuditService = service.NewModeratorAuditService(moderatorAuditRepository)
+	raceTemplateService   service.RaceTemplateService   = service.NewRaceTemplateService(raceTemplateRepository, lobbyService, cardService)
+	teamService           service.TeamService           = service.NewTeamService(teamDecisionRepository, raceService, playerService, cardService)
+	tournamentService     service.TournamentService     = service.NewTournamentService(tournamentRepository, lobbyService, raceService, playerService, raceTemplateService)
 
 	// Controllers
-	backdoorController   controller.BackdoorController   = controller.NewBackdoorController(cardService, raceService, playerService, gameService)
-	gameController       controller.GameController       = controller.NewGameController(gameService, professionService)
-	moderatorController  controller.ModeratorController  = controller.NewModeratorController(playerService, raceService, lobbyService, userRequestService)
-	playerController     controller.PlayerController     = controller.NewPlayerController(playerService, raceService, lobbyService)
-	playerTestController controller.PlayerTestController = controller.NewPlayerTestController(playerService)
-	lobbyController      controller.LobbyController      = controller.NewLobbyController(lobbyService)
-	financeController    controller.FinanceController    = controller.NewFinanceController(financeService)
-	cardController       controller.CardController       = controller.NewCardController(cardService)
-	authController       controller.AuthController       = controller.NewAuthController(authService, jwtService)
-	userController       controller.UserController       = controller.NewUserController(userService, jwtService)
+	backdoorController     controller.BackdoorController     = controller.NewBackdoorController(cardService, raceService, playerService, gameService)
+	gameController         controller.GameController         = controller.NewGameController(gameService, professionService)
+	moderatorController    controller.ModeratorController    = controller.NewModeratorController(playerService, raceService, lobbyService, userRequestService, moderatorAuditService, cardService, gameService)
+	playerController       controller.PlayerController       = controller.NewPlayerController(playerService, raceService, lobbyService, notificationService)
+	playerTestController   controller.PlayerTestController   = controller.NewPlayerTestController(playerService)
+	lobbyController        controller.LobbyController        = controller.NewLobbyController(lobbyService, raceTemplateService)
+	financeController      controller.FinanceController      = controller.NewFinanceController(financeService)
+	cardController         controller.CardController         = controller.NewCardController(cardService, teamService)
+	chatController         controller.ChatController         = controller.NewChatController(chatService)
+	tradeController        controller.TradeController        = controller.NewTradeController(tradeService)
+	auctionController      controller.AuctionController      = controller.NewAuctionController(auctionService)
+	marketController       controller.MarketController       = controller.NewMarketController(marketService)
+	sharedAssetController  controller.SharedAssetController  = controller.NewSharedAssetController(sharedAssetService)
+	userRequestController  controller.UserRequestController  = controller.NewUserRequestController(userRequestService)
+	notificationController controller.NotificationController = controller.NewNotificationController(notificationService, gameService)
+	raceTemplateController controller.RaceTemplateController = controller.NewRaceTemplateController(raceTemplateService)
+	teamController         controller.TeamController         = controller.NewTeamController(teamService)
+	tournamentController   controller.TournamentController   = controller.NewTournamentController(tournamentService)
+	i18nController         controller.I18nController         = controller.NewI18nController()
+	authController         controller.AuthController         = controller.NewAuthController(authService, jwtService)
+	userController         controller.UserController         = controller.NewUserController(userService, jwtService)
 )
 
 func init() {
@@ -74,7 +105,7 @@ func main() {
 		request.FinalResponse(ctx, nil, nil)
 	})
 
-	cardRoutes := r.Group("api/card", middleware.AuthorizeJWT(jwtService), middleware.GetGameId())
+	cardRoutes := r.Group("api/card", middleware.AuthorizeJWT(jwtService), middleware.GetGameId(), middleware.Idempotency(idempotencyService), middleware.Deprecated())
 	{
 		cardRoutes.GET("/test/:action", cardController.TestCard)
 
@@ -88,25 +119,27 @@ func main() {
 		cardRoutes.POST("/ok/:family/:type", cardController.Accept)
 	}
 
-	authRoutes := r.Group("api/auth")
+	r.GET("/api/i18n/:language", middleware.Deprecated(), i18nController.Catalog)
+
+	authRoutes := r.Group("api/auth", middleware.Deprecated())
 	{
 		authRoutes.POST("/login", authController.Login)
 		authRoutes.POST("/register", authController.Register)
 	}
 
-	backdoorRoutes := r.Group("api/backdoor", middleware.GetGameId())
+	backdoorRoutes := r.Group("api/backdoor", middleware.GetGameId(), middleware.Deprecated())
 	{
 		backdoorRoutes.POST("/:raceId/change-card", backdoorController.ChangeCard)
 	}
 
-	userRoutes := r.Group("api/user", middleware.AuthorizeJWT(jwtService))
+	userRoutes := r.Group("api/user", middleware.AuthorizeJWT(jwtService), middleware.Deprecated())
 	{
 		userRoutes.GET("/profile", userController.Profile)
 		userRoutes.PUT("/profile", userController.Update)
 		// userRoutes.POST("/picture", userController.SaveFile)
 	}
 
-	moderatorRoutes := r.Group("api/moderator", middleware.AuthorizeJWT(jwtService), middleware.GetGameId())
+	moderatorRoutes := r.Group("api/moderator", middleware.AuthorizeJWT(jwtService), middleware.GetGameId(), middleware.Deprecated())
 	{
 		moderatorRoutes.GET("/:raceId/race", moderatorController.GetRace)
 		moderatorRoutes.PUT("/:raceId/status", moderatorController.UpdateStatusRace)
@@ -119,29 +152,32 @@ func main() {
 		// userRoutes.POST("/picture", userController.SaveFile)
 	}
 
-	lobbyRoutes := r.Group("api/lobby", middleware.AuthorizeJWT(jwtService))
+	lobbyRoutes := r.Group("api/lobby", middleware.AuthorizeJWT(jwtService), middleware.Deprecated())
 	{
 		lobbyRoutes.GET("/:lobbyId", lobbyController.GetLobby)
 		lobbyRoutes.POST("/create", lobbyController.Create)
 		lobbyRoutes.GET("/join/:lobbyId", lobbyController.Join)
+		lobbyRoutes.GET("/spectate/:lobbyId", lobbyController.Spectate)
 		lobbyRoutes.GET("/leave/:lobbyId", lobbyController.Leave)
 		lobbyRoutes.GET("/cancel/:lobbyId", lobbyController.Cancel)
 		lobbyRoutes.PUT("/options/:lobbyId", lobbyController.SetOptions)
 		// userRoutes.POST("/picture", userController.SaveFile)
 	}
 
-	gameRoutes := r.Group("api/game", middleware.AuthorizeJWT(jwtService), middleware.GetGameId())
+	gameRoutes := r.Group("api/game", middleware.AuthorizeJWT(jwtService), middleware.GetGameId(), middleware.Deprecated())
 	{
 		gameRoutes.GET("/:raceId", gameController.GetGame)
+		gameRoutes.GET("/spectate/:raceId", gameController.Spectate)
 		gameRoutes.GET("/cancel/:raceId", gameController.Cancel)
 		gameRoutes.GET("/reset/:raceId", gameController.Reset)
 		gameRoutes.POST("/start/:lobbyId", gameController.Start)
+		gameRoutes.POST("/promote/:raceId", gameController.PromoteWaitList)
 		gameRoutes.POST("/roll-dice", gameController.RollDice)
 		gameRoutes.GET("/change-turn", gameController.ChangeTurn)
 		gameRoutes.GET("/get/tiles", gameController.GetTiles)
 	}
 
-	financeRoutes := r.Group("api/finance", middleware.AuthorizeJWT(jwtService), middleware.GetGameId())
+	financeRoutes := r.Group("api/finance", middleware.AuthorizeJWT(jwtService), middleware.GetGameId(), middleware.Idempotency(idempotencyService), middleware.Deprecated())
 	{
 		financeRoutes.POST("/send/money", financeController.SendMoney)
 		financeRoutes.POST("/send/assets", financeController.SendAssets)
@@ -149,7 +185,7 @@ func main() {
 		financeRoutes.POST("/ask/money", financeController.AskMoney)
 	}
 
-	playerRoutes := r.Group("api/player", middleware.AuthorizeJWT(jwtService), middleware.GetGameId())
+	playerRoutes := r.Group("api/player", middleware.AuthorizeJWT(jwtService), middleware.GetGameId(), middleware.Deprecated())
 	{
 		playerRoutes.GET("/info", playerController.GetRacePlayer)
 		playerRoutes.POST("/on-big-race/:raceId", playerController.MoveOnBigRace)
@@ -160,7 +196,32 @@ func main() {
 		playerRoutes.POST("/read-notification/:notificationId/:raceId", playerController.IsReadNotification)
 	}
 
-	playerTestRoutes := r.Group("test/player")
+	chatRoutes := r.Group("api/chat", middleware.AuthorizeJWT(jwtService), middleware.GetGameId(), middleware.Deprecated())
+	{
+		chatRoutes.GET("/:raceId", chatController.GetMessages)
+		chatRoutes.POST("/:raceId", chatController.Send)
+		chatRoutes.POST("/:raceId/react/:messageId", chatController.React)
+		chatRoutes.DELETE("/:raceId/:messageId", chatController.Delete)
+	}
+
+	tradeRoutes := r.Group("api/trade", middleware.AuthorizeJWT(jwtService), middleware.GetGameId(), middleware.Deprecated())
+	{
+		tradeRoutes.GET("/:raceId", tradeController.GetOffers)
+		tradeRoutes.POST("/:raceId", tradeController.Propose)
+		tradeRoutes.POST("/:raceId/counter/:offerId", tradeController.Counter)
+		tradeRoutes.POST("/:raceId/accept/:offerId", tradeController.Accept)
+		tradeRoutes.POST("/:raceId/decline/:offerId", tradeController.Decline)
+		tradeRoutes.POST("/:raceId/cancel/:offerId", tradeController.Cancel)
+	}
+
+	auctionRoutes := r.Group("api/auction", middleware.AuthorizeJWT(jwtService), middleware.GetGameId(), middleware.Deprecated())
+	{
+		auctionRoutes.GET("/:raceId", auctionController.GetAuction)
+		auctionRoutes.POST("/:raceId/bid", auctionController.Bid)
+		auctionRoutes.POST("/:raceId/close", auctionController.Close)
+	}
+
+	playerTestRoutes := r.Group("test/player", middleware.Deprecated())
 	{
 		playerTestRoutes.GET("/", playerTestController.Index)
 		playerTestRoutes.GET("/extra-money", playerTestController.AddMoney)
@@ -188,5 +249,12 @@ func main() {
 		playerTestRoutes.GET("/buy-risk-stocks", playerTestController.BuyRiskStocks)
 	}
 
+	registerV2Routes(r)
+
+	// The document is generated from the registered routes, so it has to be built after all of them.
+	openAPIController := controller.NewOpenAPIController(r.Routes())
+	r.GET("/openapi.json", openAPIController.Spec)
+	r.GET("/swagger", openAPIController.UI)
+
 	r.Run(":" + os.Getenv("PORT"))
 }
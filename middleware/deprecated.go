@@ -0,0 +1,13 @@
+package middleware
+
+import "github.com/gin-gonic/gin"
+
+// Deprecated marks the legacy routes, which stay as shims until clients move to /api/v2.
+func Deprecated() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		ctx.Header("Deprecation", "true")
+		ctx.Header("Link", "</api/v2>; rel=\"successor-version\"")
+
+		ctx.Next()
+	}
+}
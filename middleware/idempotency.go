@@ -0,0 +1,95 @@
+package middleware
+
+import (
+	"bytes"
+	"github.com/gin-gonic/gin"
+	"github.com/webjohny/cashflow-go/apperror"
+	"github.com/webjohny/cashflow-go/helper"
+	"github.com/webjohny/cashflow-go/objects"
+	"github.com/webjohny/cashflow-go/request"
+	"io"
+	"net/http"
+	"time"
+)
+
+type IdempotencyStore interface {
+	Lock(key string) bool
+	Unlock(key string)
+	Get(key string) (objects.IdempotentResponse, bool)
+	Save(key string, response objects.IdempotentResponse)
+}
+
+type responseRecorder struct {
+	gin.ResponseWriter
+	body bytes.Buffer
+}
+
+func (w *responseRecorder) Write(data []byte) (int, error) {
+	w.body.Write(data)
+
+	return w.ResponseWriter.Write(data)
+}
+
+// Idempotency replays the stored response of a POST retried with the same Idempotency-Key. Keys are scoped
+// by user, a key reused with another request body is rejected, and a key still being processed is a conflict.
+func Idempotency(store IdempotencyStore) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		idempotencyKey := helper.GetIdempotencyKey(ctx)
+
+		if ctx.Request.Method != http.MethodPost || idempotencyKey == "" {
+			ctx.Next()
+			return
+		}
+
+		if len(idempotencyKey) > 255 {
+			request.FinalResponse(ctx, apperror.ErrValidationFailed.WithDetails(apperror.Details{
+				"header": helper.IdempotencyKeyHeader,
+			}), nil)
+			return
+		}
+
+		body, _ := io.ReadAll(ctx.Request.Body)
+		ctx.Request.Body = io.NopCloser(bytes.NewReader(body))
+
+		key := ctx.GetString("userId") + ":" + idempotencyKey
+		fingerprint := helper.CreateHash(ctx.Request.Method + " " + ctx.Request.URL.RequestURI() + " " + string(body))
+
+		if !store.Lock(key) {
+			request.FinalResponse(ctx, apperror.ErrIdempotencyKeyInUse, nil)
+			return
+		}
+
+		defer store.Unlock(key)
+
+		if stored, ok := store.Get(key); ok {
+			if stored.Fingerprint != fingerprint {
+				request.FinalResponse(ctx, apperror.ErrIdempotencyKeyReused, nil)
+				return
+			}
+
+			ctx.Header("Idempotent-Replayed", "true")
+			ctx.Data(stored.Status, "application/json; charset=utf-8", stored.Body)
+			ctx.Abort()
+			return
+		}
+
+		recorder := &responseRecorder{ResponseWriter: ctx.Writer}
+		ctx.Writer = recorder
+
+		ctx.Next()
+
+		status := recorder.Status()
+
+		// Transient outcomes are not stored so the retry gets a chance to succeed.
+		if status >= http.StatusInternalServerError || status == http.StatusTooManyRequests || status == http.StatusConflict {
+			return
+		}
+
+		store.Save(key, objects.IdempotentResponse{
+			Fingerprint: fingerprint,
+			Status:      status,
+			Body:        recorder.body.Bytes(),
+			CreatedAt:   time.Now(),
+		})
+	}
+}
@@ -0,0 +1,37 @@
+package middleware
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/webjohny/cashflow-go/apperror"
+	"github.com/webjohny/cashflow-go/helper"
+	"github.com/webjohny/cashflow-go/request"
+	"github.com/webjohny/cashflow-go/service"
+)
+
+// SelfPlayer guards /players/:playerId routes acting on behalf of the current user:
+// the id has to be "me" or the id of the user's own player in the race, the player of the user's team included.
+func SelfPlayer(playerService service.PlayerService) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		playerId := ctx.Param("playerId")
+
+		if playerId == "me" {
+			ctx.Next()
+			return
+		}
+
+		err, player := playerService.GetPlayerByPlayerIdAndRaceId(helper.GetRaceId(ctx), helper.ConvertToUInt64(playerId))
+
+		userId := helper.GetUserId(ctx)
+
+		if err == nil && player.UserID != userId && (player.Team == nil || !player.Team.IsMember(userId)) {
+			err = apperror.ErrPermissionDenied
+		}
+
+		if err != nil {
+			request.FinalResponse(ctx, err, nil)
+			return
+		}
+
+		ctx.Next()
+	}
+}
@@ -0,0 +1,10 @@
+package objects
+
+import "time"
+
+type IdempotentResponse struct {
+	Fingerprint string
+	Status      int
+	Body        []byte
+	CreatedAt   time.Time
+}
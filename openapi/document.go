@@ -0,0 +1,256 @@
+package openapi
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/webjohny/cashflow-go/helper"
+	"github.com/webjohny/cashflow-go/request"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+type Document struct {
+	OpenAPI    string                          `json:"openapi"`
+	Info       Info                            `json:"info"`
+	Paths      map[string]map[string]Operation `json:"paths"`
+	Components Components                      `json:"components"`
+}
+
+type Info struct {
+	Title   string `json:"title"`
+	Version string `json:"version"`
+}
+
+type Components struct {
+	Schemas         map[string]*Schema        `json:"schemas"`
+	SecuritySchemes map[string]SecurityScheme `json:"securitySchemes"`
+}
+
+type SecurityScheme struct {
+	Type string `json:"type"`
+	In   string `json:"in"`
+	Name string `json:"name"`
+}
+
+type Operation struct {
+	OperationID string                `json:"operationId"`
+	Tags        []string              `json:"tags"`
+	Summary     string                `json:"summary,omitempty"`
+	Parameters  []Parameter           `json:"parameters,omitempty"`
+	RequestBody *RequestBody          `json:"requestBody,omitempty"`
+	Responses   map[string]Response   `json:"responses"`
+	Security    []map[string][]string `json:"security,omitempty"`
+	Deprecated  bool                  `json:"deprecated,omitempty"`
+}
+
+type Parameter struct {
+	Name     string  `json:"name"`
+	In       string  `json:"in"`
+	Required bool    `json:"required"`
+	Schema   *Schema `json:"schema"`
+}
+
+type RequestBody struct {
+	Required bool                 `json:"required"`
+	Content  map[string]MediaType `json:"content"`
+}
+
+type Response struct {
+	Description string               `json:"description"`
+	Content     map[string]MediaType `json:"content,omitempty"`
+}
+
+type MediaType struct {
+	Schema *Schema `json:"schema"`
+}
+
+const Version = "1.0.0"
+
+var pathParam = regexp.MustCompile(`[:*]([A-Za-z0-9_]+)`)
+
+// Generate builds the document from the documented routes. Routes registered on the engine but missing in
+// the registry are still listed, untyped unless their handler is documented, so the whole HTTP surface is covered.
+func Generate(registered gin.RoutesInfo) Document {
+	s := newSchemas()
+
+	doc := Document{
+		OpenAPI: "3.0.3",
+		Info:    Info{Title: "Cashflow game API", Version: Version},
+		Paths:   map[string]map[string]Operation{},
+	}
+
+	envelope := s.of(request.Response{})
+	documented := map[string]Route{}
+
+	for _, route := range Routes {
+		documented[route.Method+" "+route.Path] = route
+		doc.add(route, s, envelope)
+	}
+
+	sort.Slice(registered, func(i, j int) bool {
+		return registered[i].Path+registered[i].Method < registered[j].Path+registered[j].Method
+	})
+
+	// Handlers mounted on several paths (legacy and /api/v2) share the documentation of the documented path.
+	byHandler := map[string]Route{}
+
+	for _, info := range registered {
+		if route, ok := documented[info.Method+" "+info.Path]; ok {
+			byHandler[info.Handler] = route
+		}
+	}
+
+	for _, info := range registered {
+		if _, ok := documented[info.Method+" "+info.Path]; ok || strings.Contains(info.Path, "/test/") {
+			continue
+		}
+
+		route := Route{Method: info.Method, Path: info.Path, Tag: tagOf(info.Path), Summary: info.Handler}
+
+		if shared, ok := byHandler[info.Handler]; ok {
+			route.Tag = shared.Tag
+			route.Summary = shared.Summary
+			route.Body = shared.Body
+			route.Response = shared.Response
+			route.Public = shared.Public
+
+			for _, name := range shared.Query {
+				if !strings.Contains(info.Path, ":"+name) {
+					route.Query = append(route.Query, name)
+				}
+			}
+		}
+
+		doc.add(route, s, envelope)
+	}
+
+	doc.Components = Components{
+		Schemas: s.components,
+		SecuritySchemes: map[string]SecurityScheme{
+			"jwt": {Type: "apiKey", In: "header", Name: "Authorization"},
+		},
+	}
+
+	return doc
+}
+
+func (doc Document) add(route Route, s *schemas, envelope *Schema) {
+	path := pathParam.ReplaceAllString(route.Path, "{$1}")
+
+	operation := Operation{
+		OperationID: operationID(route.Method, route.Path),
+		Tags:        []string{route.Tag},
+		Summary:     route.Summary,
+		Responses: map[string]Response{
+			"200": {
+				Description: "OK",
+				Content:     jsonContent(s.data(envelope, route.Response)),
+			},
+			"default": {
+				Description: "Error envelope with code, localized message and details",
+				Content:     jsonContent(envelope),
+			},
+		},
+	}
+
+	for _, match := range pathParam.FindAllStringSubmatch(route.Path, -1) {
+		operation.Parameters = append(operation.Parameters, Parameter{
+			Name:     match[1],
+			In:       "path",
+			Required: true,
+			Schema:   &Schema{Type: "string"},
+		})
+	}
+
+	for _, name := range route.Query {
+		operation.Parameters = append(operation.Parameters, Parameter{
+			Name:   name,
+			In:     "query",
+			Schema: &Schema{Type: "string"},
+		})
+	}
+
+	if route.Method == "POST" && !route.Public && idempotent(route.Path) {
+		operation.Parameters = append(operation.Parameters, Parameter{
+			Name:   helper.IdempotencyKeyHeader,
+			In:     "header",
+			Schema: &Schema{Type: "string"},
+		})
+	}
+
+	if route.Body != nil {
+		operation.RequestBody = &RequestBody{
+			Required: !route.OptionalBody,
+			Content:  jsonContent(s.of(route.Body)),
+		}
+	}
+
+	operation.Deprecated = strings.HasPrefix(route.Path, "/api/") && !strings.HasPrefix(route.Path, "/api/v2/")
+
+	if !route.Public {
+		operation.Security = []map[string][]string{{"jwt": {}}}
+	}
+
+	if doc.Paths[path] == nil {
+		doc.Paths[path] = map[string]Operation{}
+	}
+
+	doc.Paths[path][strings.ToLower(route.Method)] = operation
+}
+
+// data narrows the "data" property of the response envelope to the route's response type.
+func (s *schemas) data(envelope *Schema, response interface{}) *Schema {
+	if response == nil {
+		return envelope
+	}
+
+	return &Schema{
+		Type: "object",
+		Properties: map[string]*Schema{
+			"status":  {Type: "boolean"},
+			"message": {Type: "string"},
+			"data":    s.of(response),
+		},
+	}
+}
+
+// idempotent tells whether POSTs of the path go through the Idempotency-Key middleware.
+func idempotent(path string) bool {
+	for _, prefix := range []string{"/api/v2/", "/api/finance/", "/api/card/"} {
+		if strings.HasPrefix(path, prefix) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func jsonContent(schema *Schema) map[string]MediaType {
+	return map[string]MediaType{
+		"application/json": {Schema: schema},
+	}
+}
+
+func operationID(method string, path string) string {
+	var id strings.Builder
+
+	id.WriteString(strings.ToLower(method))
+
+	for _, part := range strings.FieldsFunc(path, func(r rune) bool {
+		return r == '/' || r == '-' || r == ':' || r == '*'
+	}) {
+		id.WriteString(strings.ToUpper(part[:1]) + part[1:])
+	}
+
+	return id.String()
+}
+
+func tagOf(path string) string {
+	parts := strings.Split(strings.Trim(path, "/"), "/")
+
+	for len(parts) > 1 && (parts[0] == "api" || parts[0] == "v2") {
+		parts = parts[1:]
+	}
+
+	return parts[0]
+}
@@ -0,0 +1,295 @@
+package openapi_test
+
+import (
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"github.com/webjohny/cashflow-go/openapi"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+	"testing"
+	"unicode"
+)
+
+var handlerName = regexp.MustCompile(`controller\.\(?\*?(\w+)\)?\.(\w+)-fm$`)
+
+// handlerContract is what a handler binds and passes to request.FinalResponse as read from its source. A
+// response is the type of the value, or the keys of the map literal it builds.
+type handlerContract struct {
+	bodies    []string
+	responses []string
+}
+
+// jsonKeys names the JSON keys of a struct the way encoding/json does.
+func jsonKeys(names []string, tags []string) string {
+	keys := make([]string, 0)
+
+	for i, name := range names {
+		key := strings.Split(tags[i], ",")[0]
+
+		if key == "-" {
+			continue
+		}
+
+		if key == "" {
+			key = name
+		}
+
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	return "{" + strings.Join(keys, ",") + "}"
+}
+
+// readControllers indexes the methods of the controllers by "receiver.Method" and names the structs they
+// declare by their JSON keys, the registry mirrors those structs since it can not import the controllers.
+func readControllers(t *testing.T) (map[string]*ast.FuncDecl, map[string]string) {
+	packages, err := parser.ParseDir(token.NewFileSet(), "../controller", nil, 0)
+	assert.NoError(t, err)
+
+	methods := map[string]*ast.FuncDecl{}
+	structs := map[string]string{}
+
+	for _, pkg := range packages {
+		for _, file := range pkg.Files {
+			for _, decl := range file.Decls {
+				if general, ok := decl.(*ast.GenDecl); ok && general.Tok == token.TYPE {
+					for _, spec := range general.Specs {
+						spec := spec.(*ast.TypeSpec)
+						fields, ok := spec.Type.(*ast.StructType)
+
+						if !ok {
+							continue
+						}
+
+						names := make([]string, 0)
+						tags := make([]string, 0)
+
+						for _, field := range fields.Fields.List {
+							tag := ""
+
+							if field.Tag != nil {
+								tag = reflect.StructTag(strings.Trim(field.Tag.Value, "`")).Get("json")
+							}
+
+							for _, name := range field.Names {
+								names = append(names, name.Name)
+								tags = append(tags, tag)
+							}
+						}
+
+						structs[spec.Name.Name] = jsonKeys(names, tags)
+					}
+				}
+
+				fn, ok := decl.(*ast.FuncDecl)
+
+				if !ok || fn.Recv == nil || len(fn.Recv.List) == 0 {
+					continue
+				}
+
+				receiver := fn.Recv.List[0].Type
+
+				if star, ok := receiver.(*ast.StarExpr); ok {
+					receiver = star.X
+				}
+
+				methods[types.ExprString(receiver)+"."+fn.Name.Name] = fn
+			}
+		}
+	}
+
+	return methods, structs
+}
+
+// declaredTypes maps the variables of a function to the type they are declared with, variables assigned from
+// calls are left out since their type can not be read without type checking.
+func declaredTypes(fn *ast.FuncDecl) map[string]string {
+	declared := map[string]string{}
+
+	ast.Inspect(fn.Body, func(node ast.Node) bool {
+		switch node := node.(type) {
+		case *ast.ValueSpec:
+			if node.Type != nil {
+				for _, name := range node.Names {
+					declared[name.Name] = types.ExprString(node.Type)
+				}
+			}
+		case *ast.AssignStmt:
+			if node.Tok != token.DEFINE || len(node.Lhs) != len(node.Rhs) {
+				return true
+			}
+
+			for i, rhs := range node.Rhs {
+				if unary, ok := rhs.(*ast.UnaryExpr); ok && unary.Op == token.AND {
+					rhs = unary.X
+				}
+
+				if literal, ok := rhs.(*ast.CompositeLit); ok && literal.Type != nil {
+					declared[node.Lhs[i].(*ast.Ident).Name] = types.ExprString(literal.Type)
+				}
+			}
+		}
+
+		return true
+	})
+
+	return declared
+}
+
+// readContract collects the bodies and responses of a handler and of the methods of the same controller it
+// passes the context to.
+func readContract(methods map[string]*ast.FuncDecl, receiver string, fn *ast.FuncDecl, contract *handlerContract, visited map[string]bool) {
+	if visited[fn.Name.Name] {
+		return
+	}
+
+	visited[fn.Name.Name] = true
+	declared := declaredTypes(fn)
+
+	ast.Inspect(fn.Body, func(node ast.Node) bool {
+		call, ok := node.(*ast.CallExpr)
+
+		if !ok {
+			return true
+		}
+
+		selector, ok := call.Fun.(*ast.SelectorExpr)
+
+		if !ok {
+			return true
+		}
+
+		switch {
+		case strings.HasPrefix(selector.Sel.Name, "ShouldBind") && len(call.Args) > 0:
+			if unary, ok := call.Args[0].(*ast.UnaryExpr); ok && unary.Op == token.AND {
+				contract.bodies = append(contract.bodies, declared[types.ExprString(unary.X)])
+			}
+		case types.ExprString(selector) == "request.FinalResponse" && len(call.Args) == 3:
+			if response := responseOf(call.Args[2], declared); response != "" {
+				contract.responses = append(contract.responses, response)
+			}
+		case types.ExprString(selector.X) == fn.Recv.List[0].Names[0].Name:
+			if method, ok := methods[receiver+"."+selector.Sel.Name]; ok {
+				readContract(methods, receiver, method, contract, visited)
+			}
+		}
+
+		return true
+	})
+}
+
+func responseOf(arg ast.Expr, declared map[string]string) string {
+	if unary, ok := arg.(*ast.UnaryExpr); ok && unary.Op == token.AND {
+		arg = unary.X
+	}
+
+	switch arg := arg.(type) {
+	case *ast.Ident:
+		if declared[arg.Name] == "interface{}" {
+			return ""
+		}
+
+		return declared[arg.Name]
+	case *ast.CompositeLit:
+		if _, ok := arg.Type.(*ast.MapType); !ok {
+			return types.ExprString(arg.Type)
+		}
+
+		keys := make([]string, 0)
+
+		for _, element := range arg.Elts {
+			if pair, ok := element.(*ast.KeyValueExpr); ok {
+				keys = append(keys, strings.Trim(types.ExprString(pair.Key), `"`))
+			}
+		}
+
+		sort.Strings(keys)
+
+		return "{" + strings.Join(keys, ",") + "}"
+	}
+
+	return ""
+}
+
+// documentedType names a registry body or response the way readContract does.
+func documentedType(value interface{}) string {
+	if value == nil {
+		return ""
+	}
+
+	kind := reflect.TypeOf(value)
+
+	if kind.Kind() == reflect.Struct && kind.PkgPath() == reflect.TypeOf(openapi.Route{}).PkgPath() {
+		names := make([]string, 0)
+		tags := make([]string, 0)
+
+		for i := 0; i < kind.NumField(); i++ {
+			names = append(names, kind.Field(i).Name)
+			tags = append(tags, kind.Field(i).Tag.Get("json"))
+		}
+
+		return jsonKeys(names, tags)
+	}
+
+	return strings.TrimPrefix(fmt.Sprintf("%T", value), "*")
+}
+
+// TestHandlersMatchRegistry fails when a v2 handler binds a body or responds with a value that its registry
+// entry does not document, so the published contract follows the handlers instead of the other way round.
+func TestHandlersMatchRegistry(t *testing.T) {
+	methods, structs := readControllers(t)
+	documented := map[string]openapi.Route{}
+
+	for _, route := range openapi.Routes {
+		documented[route.Method+" "+route.Path] = route
+	}
+
+	for _, info := range newV2Engine().Routes() {
+		key := info.Method + " " + info.Path
+		match := handlerName.FindStringSubmatch(info.Handler)
+
+		if !assert.NotNil(t, match, "%s is not served by a controller method: %s", key, info.Handler) {
+			continue
+		}
+
+		receiver := string(unicode.ToLower(rune(match[1][0]))) + match[1][1:]
+		fn, ok := methods[receiver+"."+match[2]]
+
+		if !assert.True(t, ok, "%s: %s.%s is not found", key, receiver, match[2]) {
+			continue
+		}
+
+		contract := handlerContract{}
+		readContract(methods, receiver, fn, &contract, map[string]bool{})
+
+		route := documented[key]
+		body := documentedType(route.Body)
+		response := documentedType(route.Response)
+
+		if len(contract.bodies) == 0 {
+			assert.Empty(t, body, "%s binds no body", key)
+		}
+
+		for _, bound := range contract.bodies {
+			assert.Equal(t, bound, body, "%s binds another body", key)
+		}
+
+		for _, returned := range contract.responses {
+			returned = strings.TrimPrefix(returned, "*")
+
+			if keys, ok := structs[returned]; ok {
+				returned = keys
+			}
+
+			assert.Equal(t, returned, response, "%s responds with another value", key)
+		}
+	}
+}
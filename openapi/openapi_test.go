@@ -0,0 +1,119 @@
+package openapi_test
+
+import (
+	"encoding/json"
+	"flag"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/webjohny/cashflow-go/controller"
+	"github.com/webjohny/cashflow-go/openapi"
+	"github.com/webjohny/cashflow-go/routes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+var update = flag.Bool("update", false, "rewrite testdata/openapi.json from the current DTOs")
+
+// TestContract fails when a request or response shape drifts from the published contract.
+// Run `go test ./openapi/ -update` after an intended change and commit the new document.
+func TestContract(t *testing.T) {
+	actual, err := json.MarshalIndent(openapi.Generate(nil), "", "  ")
+	assert.NoError(t, err)
+
+	golden := filepath.Join("testdata", "openapi.json")
+
+	if *update {
+		assert.NoError(t, os.WriteFile(golden, append(actual, '\n'), 0644))
+	}
+
+	expected, err := os.ReadFile(golden)
+	assert.NoError(t, err)
+	assert.JSONEq(t, string(expected), string(actual))
+}
+
+// newV2Engine registers the v2 routes. The handlers are never called, so they are built without services.
+func newV2Engine() *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+
+	routes.RegisterV2(r, routes.Handlers{
+		AuctionController:      controller.NewAuctionController(nil),
+		AuthController:         controller.NewAuthController(nil, nil),
+		CardController:         controller.NewCardController(nil, nil),
+		ChatController:         controller.NewChatController(nil),
+		FinanceController:      controller.NewFinanceController(nil),
+		GameController:         controller.NewGameController(nil, nil),
+		I18nController:         controller.NewI18nController(),
+		LobbyController:        controller.NewLobbyController(nil, nil),
+		MarketController:       controller.NewMarketController(nil),
+		ModeratorController:    controller.NewModeratorController(nil, nil, nil, nil, nil, nil, nil),
+		NotificationController: controller.NewNotificationController(nil, nil),
+		PlayerController:       controller.NewPlayerController(nil, nil, nil, nil),
+		PlayerTestController:   controller.NewPlayerTestController(nil),
+		RaceTemplateController: controller.NewRaceTemplateController(nil),
+		SharedAssetController:  controller.NewSharedAssetController(nil),
+		TeamController:         controller.NewTeamController(nil),
+		TournamentController:   controller.NewTournamentController(nil),
+		TradeController:        controller.NewTradeController(nil),
+		UserController:         controller.NewUserController(nil, nil),
+		UserRequestController:  controller.NewUserRequestController(nil),
+	})
+
+	return r
+}
+
+// TestRoutesAreRegistered fails when a v2 route is registered without a registry entry or an entry documents
+// a v2 route the engine does not serve.
+func TestRoutesAreRegistered(t *testing.T) {
+	r := newV2Engine()
+
+	registered := map[string]bool{}
+
+	for _, route := range r.Routes() {
+		registered[route.Method+" "+route.Path] = true
+	}
+
+	documented := map[string]bool{}
+
+	for _, route := range openapi.Routes {
+		if strings.HasPrefix(route.Path, "/api/v2/") {
+			documented[route.Method+" "+route.Path] = true
+		}
+	}
+
+	for key := range registered {
+		assert.True(t, documented[key], "%s has no registry entry", key)
+	}
+
+	for key := range documented {
+		assert.True(t, registered[key], "%s is not registered", key)
+	}
+}
+
+func TestRoutesAreUnique(t *testing.T) {
+	seen := map[string]bool{}
+
+	for _, route := range openapi.Routes {
+		key := route.Method + " " + route.Path
+
+		assert.False(t, seen[key], key)
+		assert.NotEmpty(t, route.Tag, key)
+		assert.NotEmpty(t, route.Summary, key)
+
+		seen[key] = true
+	}
+}
+
+func TestGenerate(t *testing.T) {
+	doc := openapi.Generate(nil)
+
+	operation := doc.Paths["/api/trade/{raceId}/counter/{offerId}"]["post"]
+
+	assert.Equal(t, "postApiTradeRaceIdCounterOfferId", operation.OperationID)
+	assert.Len(t, operation.Parameters, 2)
+	assert.Equal(t, "#/components/schemas/TradeOfferBodyDTO", operation.RequestBody.Content["application/json"].Schema.Ref)
+	assert.Contains(t, doc.Components.Schemas, "TradeOffer")
+	assert.Empty(t, doc.Paths["/api/auth/login"]["post"].Security)
+}
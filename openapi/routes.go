@@ -0,0 +1,345 @@
+package openapi
+
+import (
+	"github.com/webjohny/cashflow-go/dto"
+	"github.com/webjohny/cashflow-go/entity"
+)
+
+// Route documents one endpoint of the route tables in main.go and routes. Body and Response are zero values of
+// the structs bound by the handler and passed as "data" of the response envelope, nil when there are none. The
+// contract test reads the v2 handlers and fails when they bind or respond with anything else. OptionalBody marks
+// a body the handler only binds when one is sent.
+type Route struct {
+	Method       string
+	Path         string
+	Tag          string
+	Summary      string
+	Query        []string
+	Body         interface{}
+	OptionalBody bool
+	Response     interface{}
+	Public       bool
+}
+
+type playerResponse struct {
+	Player entity.Player `json:"player"`
+}
+
+type playersResponse struct {
+	Players []dto.GetRacePlayerResponseDTO `json:"players"`
+}
+
+type raceResponse struct {
+	Race entity.Race `json:"race"`
+}
+
+type racesResponse struct {
+	Races []entity.Race `json:"races"`
+}
+
+type userRequestResponse struct {
+	UserRequest entity.UserRequest `json:"userRequest"`
+}
+
+type userRequestsResponse struct {
+	UserRequests []entity.UserRequest `json:"userRequests"`
+}
+
+type tradeOffersResponse struct {
+	Offers []entity.TradeOffer `json:"offers"`
+}
+
+type sharedAssetsResponse struct {
+	SharedAssets []entity.SharedAsset `json:"sharedAssets"`
+}
+
+type moderatorAuditResponse struct {
+	Audit []entity.ModeratorAudit `json:"audit"`
+}
+
+type decksResponse struct {
+	Decks map[string]dto.CardDeckDTO `json:"decks"`
+}
+
+type upcomingCardsResponse struct {
+	Cards []dto.UpcomingCardDTO `json:"cards"`
+}
+
+type cardResponse struct {
+	Card entity.Card `json:"card"`
+}
+
+type notificationResponse struct {
+	Notification entity.Notification `json:"notification"`
+}
+
+type raceTemplatesResponse struct {
+	Templates []entity.RaceTemplate `json:"templates"`
+}
+
+type bigRaceConditionsResponse struct {
+	Conditions entity.BigRaceConditions `json:"conditions"`
+}
+
+type diceResponse struct {
+	Dice []int `json:"dice"`
+}
+
+type teamDecisionsResponse struct {
+	Decisions []entity.TeamDecision `json:"decisions"`
+}
+
+type tournamentsResponse struct {
+	Tournaments []entity.Tournament `json:"tournaments"`
+}
+
+type tournamentStandingsResponse struct {
+	Standings []entity.TournamentParticipant `json:"standings"`
+}
+
+type tournamentTablesResponse struct {
+	Tables []entity.TournamentTable `json:"tables"`
+}
+
+type tilesResponse struct {
+	Tiles []string `json:"tiles"`
+}
+
+// testPlayerResponse mirrors controller.PlayerResponse, the result of a test player action.
+type testPlayerResponse struct {
+	ID               uint64
+	UserID           uint64
+	Card             interface{}
+	Extra            interface{} `json:"Extra,omitempty"`
+	OldCash          int
+	NewCash          int
+	NewPassiveIncome int         `json:"NewPassiveIncome,omitempty"`
+	OldCashFlow      int         `json:"OldCashFlow,omitempty"`
+	NewCashFlow      int         `json:"NewCashFlow,omitempty"`
+	SingleAsset      interface{} `json:"SingleAsset,omitempty"`
+	Assets           interface{} `json:"Assets,omitempty"`
+}
+
+type catalogResponse struct {
+	Language string            `json:"language"`
+	Messages map[string]string `json:"messages"`
+}
+
+var Routes = []Route{
+	{Method: "GET", Path: "/health", Tag: "system", Summary: "Health check", Public: true},
+	{Method: "GET", Path: "/openapi.json", Tag: "system", Summary: "This document", Public: true},
+	{Method: "GET", Path: "/swagger", Tag: "system", Summary: "Swagger UI for this document", Public: true},
+	{Method: "GET", Path: "/api/i18n/:language", Tag: "i18n", Summary: "Message catalog of a language", Response: catalogResponse{}, Public: true},
+	{Method: "GET", Path: "/api/v2/i18n/:language", Tag: "i18n", Summary: "Message catalog of a language", Response: catalogResponse{}, Public: true},
+
+	{Method: "POST", Path: "/api/auth/login", Tag: "auth", Summary: "Log in", Body: dto.LoginDTO{}, Response: entity.User{}, Public: true},
+	{Method: "POST", Path: "/api/auth/register", Tag: "auth", Summary: "Register a user", Body: dto.RegisterDTO{}, Response: entity.User{}, Public: true},
+	{Method: "POST", Path: "/api/v2/auth/login", Tag: "auth", Summary: "Log in", Body: dto.LoginDTO{}, Response: entity.User{}, Public: true},
+	{Method: "POST", Path: "/api/v2/auth/register", Tag: "auth", Summary: "Register a user", Body: dto.RegisterDTO{}, Response: entity.User{}, Public: true},
+
+	{Method: "GET", Path: "/api/user/profile", Tag: "user", Summary: "Profile of the current user", Response: entity.User{}},
+	{Method: "PUT", Path: "/api/user/profile", Tag: "user", Summary: "Update the profile", Body: dto.UserUpdateDTO{}, Response: entity.User{}},
+	{Method: "GET", Path: "/api/v2/users/me", Tag: "user", Summary: "Profile of the current user", Response: entity.User{}},
+	{Method: "PUT", Path: "/api/v2/users/me", Tag: "user", Summary: "Update the profile", Body: dto.UserUpdateDTO{}, Response: entity.User{}},
+
+	{Method: "GET", Path: "/api/card/test/:action", Tag: "card", Summary: "Draw a test card", Query: []string{"raceId", "bigRace"}, Response: entity.Card{}},
+	{Method: "GET", Path: "/api/card/type", Tag: "card", Summary: "Draw a card of the type", Query: []string{"raceId", "type"}, Response: entity.Card{}},
+	{Method: "GET", Path: "/api/card/reset-transaction", Tag: "card", Summary: "Reset the current transaction", Query: []string{"raceId"}},
+	{Method: "POST", Path: "/api/card/cards", Tag: "card", Summary: "Import cards", Body: dto.CreateCardsDTO{}},
+	{Method: "POST", Path: "/api/card/prepare/:family/:type", Tag: "card", Summary: "Prepare the current card", Query: []string{"raceId"}, Body: dto.PrepareCardBodyDTO{}},
+	{Method: "POST", Path: "/api/card/skip/:family/:type", Tag: "card", Summary: "Skip the current card", Query: []string{"raceId", "bigRace"}},
+	{Method: "POST", Path: "/api/card/sell/:family/:type", Tag: "card", Summary: "Sell by the current card", Query: []string{"raceId", "bigRace"}, Body: dto.CardSellingActionDTO{}},
+	{Method: "POST", Path: "/api/card/buy/:family/:type", Tag: "card", Summary: "Buy the current card", Query: []string{"raceId", "bigRace"}, Body: dto.CardPurchaseActionDTO{}},
+	{Method: "POST", Path: "/api/card/ok/:family/:type", Tag: "card", Summary: "Accept the current card", Query: []string{"raceId", "bigRace"}},
+	{Method: "GET", Path: "/api/v2/races/:raceId/cards", Tag: "card", Summary: "Draw a card of the type", Query: []string{"type"}, Response: entity.Card{}},
+	{Method: "POST", Path: "/api/v2/races/:raceId/cards", Tag: "card", Summary: "Import cards", Body: dto.CreateCardsDTO{}},
+	{Method: "POST", Path: "/api/v2/races/:raceId/cards/:family/:type/prepare", Tag: "card", Summary: "Prepare the current card", Body: dto.PrepareCardBodyDTO{}},
+	{Method: "POST", Path: "/api/v2/races/:raceId/cards/:family/:type/skip", Tag: "card", Summary: "Skip the current card", Query: []string{"bigRace"}},
+	{Method: "POST", Path: "/api/v2/races/:raceId/cards/:family/:type/sell", Tag: "card", Summary: "Sell by the current card", Query: []string{"bigRace"}, Body: dto.CardSellingActionDTO{}},
+	{Method: "POST", Path: "/api/v2/races/:raceId/cards/:family/:type/buy", Tag: "card", Summary: "Buy the current card", Query: []string{"bigRace"}, Body: dto.CardPurchaseActionDTO{}},
+	{Method: "POST", Path: "/api/v2/races/:raceId/cards/:family/:type/accept", Tag: "card", Summary: "Accept the current card", Query: []string{"bigRace"}},
+	{Method: "POST", Path: "/api/v2/races/:raceId/transactions/reset", Tag: "card", Summary: "Reset the current transaction"},
+
+	{Method: "POST", Path: "/api/backdoor/:raceId/change-card", Tag: "backdoor", Summary: "Replace the current card", Body: dto.BackdoorCardBodyDTO{}, Response: entity.Race{}, Public: true},
+
+	{Method: "GET", Path: "/api/moderator/:raceId/race", Tag: "moderator", Summary: "Race with user requests", Response: dto.GetRaceResponseDTO{}},
+	{Method: "PUT", Path: "/api/moderator/:raceId/status", Tag: "moderator", Summary: "Change the race status", Body: dto.ModeratorUpdateStatusRaceDto{}},
+	{Method: "PUT", Path: "/api/v2/races/:raceId/moderator/big-race/conditions", Tag: "moderator", Summary: "Replace the fast track entry rules of the race and its players", Body: dto.BigRaceConditionsBodyDTO{}, Response: bigRaceConditionsResponse{}},
+	{Method: "GET", Path: "/api/moderator/:raceId/player", Tag: "moderator", Summary: "Player of the race", Query: []string{"playerId"}, Response: dto.GetRacePlayerResponseDTO{}},
+	{Method: "POST", Path: "/api/moderator/:raceId/send-money", Tag: "moderator", Summary: "Send money from the bank", Body: dto.ModeratorSendMoneyDTO{}},
+	{Method: "GET", Path: "/api/moderator/:raceId/players", Tag: "moderator", Summary: "Players of the race", Response: playersResponse{}},
+	{Method: "PUT", Path: "/api/moderator/:raceId/player/:playerId", Tag: "moderator", Summary: "Edit a player sheet", Body: dto.ModeratorUpdatePlayerDto{}, Response: playerResponse{}},
+	{Method: "PUT", Path: "/api/moderator/:raceId/race", Tag: "moderator", Summary: "Edit the race", Body: dto.ModeratorUpdateRaceDto{}, Response: raceResponse{}},
+	{Method: "PUT", Path: "/api/moderator/:raceId/handle/user-request", Tag: "moderator", Summary: "Approve, partially approve with an adjusted amount or reject a pending user request", Body: dto.HandleUserRequestBodyDto{}, Response: userRequestResponse{}},
+	{Method: "GET", Path: "/api/v2/races/:raceId/moderator/race", Tag: "moderator", Summary: "Race with user requests", Response: dto.GetRaceResponseDTO{}},
+	{Method: "PUT", Path: "/api/v2/races/:raceId/moderator/race", Tag: "moderator", Summary: "Edit the race", Body: dto.ModeratorUpdateRaceDto{}, Response: raceResponse{}},
+	{Method: "PUT", Path: "/api/v2/races/:raceId/moderator/status", Tag: "moderator", Summary: "Change the race status", Body: dto.ModeratorUpdateStatusRaceDto{}},
+	{Method: "GET", Path: "/api/v2/races/:raceId/moderator/players", Tag: "moderator", Summary: "Players of the race", Response: playersResponse{}},
+	{Method: "PUT", Path: "/api/v2/races/:raceId/moderator/players/:playerId", Tag: "moderator", Summary: "Edit a player sheet", Body: dto.ModeratorUpdatePlayerDto{}, Response: playerResponse{}},
+	{Method: "GET", Path: "/api/v2/races/:raceId/moderator/users/:userId", Tag: "moderator", Summary: "Player of the user in the race", Response: dto.GetRacePlayerResponseDTO{}},
+	{Method: "POST", Path: "/api/v2/races/:raceId/moderator/transfers", Tag: "moderator", Summary: "Send money from the bank", Body: dto.ModeratorSendMoneyDTO{}},
+	{Method: "PUT", Path: "/api/v2/races/:raceId/moderator/user-requests", Tag: "moderator", Summary: "Approve, partially approve with an adjusted amount or reject a pending user request", Body: dto.HandleUserRequestBodyDto{}, Response: userRequestResponse{}},
+	{Method: "GET", Path: "/api/v2/races/:raceId/moderator/audit", Tag: "moderator", Summary: "Interventions of the moderators with the changes they made, only on the player when playerId is set", Query: []string{"playerId"}, Response: moderatorAuditResponse{}},
+	{Method: "POST", Path: "/api/v2/races/:raceId/moderator/notifications", Tag: "moderator", Summary: "Notify a player, the players of a role or the whole race", Body: dto.SendNotificationBodyDTO{}, Response: notificationResponse{}},
+	{Method: "GET", Path: "/api/v2/races/:raceId/moderator/decks", Tag: "moderator", Summary: "Active card collection of the race with the order of every deck, only in manager or hand mode", Response: decksResponse{}},
+	{Method: "GET", Path: "/api/v2/races/:raceId/moderator/decks/:deck/upcoming", Tag: "moderator", Summary: "Next cards of the deck without drawing them", Query: []string{"count"}, Response: upcomingCardsResponse{}},
+	{Method: "POST", Path: "/api/v2/races/:raceId/moderator/decks/:deck/deal", Tag: "moderator", Summary: "Give a card of the deck to the current player", Body: dto.DealCardBodyDTO{}, Response: cardResponse{}},
+	{Method: "POST", Path: "/api/v2/races/:raceId/moderator/decks/:deck/shuffle", Tag: "moderator", Summary: "Reshuffle the deck", Body: dto.ShuffleDeckBodyDTO{}, OptionalBody: true},
+	{Method: "POST", Path: "/api/v2/races/:raceId/moderator/decks/:deck/removals", Tag: "moderator", Summary: "Take cards of the deck out of play", Body: dto.RemoveCardsBodyDTO{}},
+	{Method: "POST", Path: "/api/v2/races/:raceId/moderator/dice/confirmation", Tag: "moderator", Summary: "Apply the physical dice entered in hand mode, optionally corrected", Body: dto.ConfirmRollBodyDTO{}, Response: diceResponse{}},
+	{Method: "POST", Path: "/api/v2/races/:raceId/moderator/dice/rejection", Tag: "moderator", Summary: "Reject the physical dice entered in hand mode", Body: dto.RejectRollBodyDTO{}, OptionalBody: true},
+
+	{Method: "GET", Path: "/api/lobby/:lobbyId", Tag: "lobby", Summary: "Lobby", Response: dto.GetLobbyResponseDTO{}},
+	{Method: "POST", Path: "/api/lobby/create", Tag: "lobby", Summary: "Create a lobby, optionally from a race template", Body: dto.CreateLobbyBodyDTO{}, Response: entity.Lobby{}},
+	{Method: "GET", Path: "/api/lobby/join/:lobbyId", Tag: "lobby", Summary: "Join a lobby", Response: entity.LobbyPlayer{}},
+	{Method: "GET", Path: "/api/lobby/spectate/:lobbyId", Tag: "lobby", Summary: "Join a lobby as a spectator", Response: entity.LobbyPlayer{}},
+	{Method: "GET", Path: "/api/lobby/leave/:lobbyId", Tag: "lobby", Summary: "Leave a lobby"},
+	{Method: "GET", Path: "/api/lobby/cancel/:lobbyId", Tag: "lobby", Summary: "Cancel a lobby"},
+	{Method: "PUT", Path: "/api/lobby/options/:lobbyId", Tag: "lobby", Summary: "Set the hand mode, links and language of the lobby", Body: dto.SetOptionsLobbyRequestDTO{}},
+	{Method: "POST", Path: "/api/v2/lobbies", Tag: "lobby", Summary: "Create a lobby, optionally from a race template", Body: dto.CreateLobbyBodyDTO{}, Response: entity.Lobby{}},
+	{Method: "GET", Path: "/api/v2/lobbies/:lobbyId", Tag: "lobby", Summary: "Lobby", Response: dto.GetLobbyResponseDTO{}},
+	{Method: "DELETE", Path: "/api/v2/lobbies/:lobbyId", Tag: "lobby", Summary: "Cancel a lobby"},
+	{Method: "PUT", Path: "/api/v2/lobbies/:lobbyId/options", Tag: "lobby", Summary: "Set the hand mode, links and language of the lobby", Body: dto.SetOptionsLobbyRequestDTO{}},
+	{Method: "PUT", Path: "/api/v2/lobbies/:lobbyId/options/all", Tag: "lobby", Summary: "Replace all the options of the lobby", Body: dto.ReplaceOptionsLobbyRequestDTO{}, Response: entity.Lobby{}},
+	{Method: "POST", Path: "/api/v2/lobbies/:lobbyId/players", Tag: "lobby", Summary: "Join a lobby", Response: entity.LobbyPlayer{}},
+	{Method: "DELETE", Path: "/api/v2/lobbies/:lobbyId/players/me", Tag: "lobby", Summary: "Leave a lobby"},
+	{Method: "POST", Path: "/api/v2/lobbies/:lobbyId/spectators", Tag: "lobby", Summary: "Join a lobby as a spectator", Response: entity.LobbyPlayer{}},
+	{Method: "POST", Path: "/api/v2/lobbies/:lobbyId/template", Tag: "lobby", Summary: "Replace the lobby options with a race template", Body: dto.ApplyRaceTemplateBodyDTO{}, Response: entity.Lobby{}},
+	{Method: "PUT", Path: "/api/v2/lobbies/:lobbyId/teams", Tag: "lobby", Summary: "Group the lobby players into teams sharing one player sheet", Body: dto.SetTeamsBodyDTO{}, Response: entity.Lobby{}},
+
+	{Method: "GET", Path: "/api/v2/race-templates", Tag: "race-template", Summary: "Preset race templates and the templates of the current user", Response: raceTemplatesResponse{}},
+	{Method: "POST", Path: "/api/v2/race-templates", Tag: "race-template", Summary: "Save a race template", Body: dto.RaceTemplateBodyDTO{}, Response: entity.RaceTemplate{}},
+	{Method: "GET", Path: "/api/v2/race-templates/:templateCode", Tag: "race-template", Summary: "Preset, own or shared race template", Response: entity.RaceTemplate{}},
+	{Method: "PUT", Path: "/api/v2/race-templates/:templateCode", Tag: "race-template", Summary: "Update an own race template", Body: dto.RaceTemplateBodyDTO{}, Response: entity.RaceTemplate{}},
+	{Method: "DELETE", Path: "/api/v2/race-templates/:templateCode", Tag: "race-template", Summary: "Delete an own race template"},
+	{Method: "GET", Path: "/api/v2/tournaments", Tag: "tournament", Summary: "Tournaments open to join or being played", Response: tournamentsResponse{}},
+	{Method: "POST", Path: "/api/v2/tournaments", Tag: "tournament", Summary: "Create a bracket or swiss tournament", Body: dto.TournamentBodyDTO{}, Response: entity.Tournament{}},
+	{Method: "GET", Path: "/api/v2/tournaments/:tournamentId", Tag: "tournament", Summary: "Tournament with the tables of every round and the standings", Response: dto.TournamentResponseDTO{}},
+	{Method: "DELETE", Path: "/api/v2/tournaments/:tournamentId", Tag: "tournament", Summary: "Cancel an own tournament", Response: entity.Tournament{}},
+	{Method: "GET", Path: "/api/v2/tournaments/:tournamentId/standings", Tag: "tournament", Summary: "Standings of the tournament", Response: tournamentStandingsResponse{}},
+	{Method: "POST", Path: "/api/v2/tournaments/:tournamentId/participants", Tag: "tournament", Summary: "Join a tournament before it starts", Response: entity.Tournament{}},
+	{Method: "DELETE", Path: "/api/v2/tournaments/:tournamentId/participants/me", Tag: "tournament", Summary: "Leave a tournament before it starts", Response: entity.Tournament{}},
+	{Method: "POST", Path: "/api/v2/tournaments/:tournamentId/rounds", Tag: "tournament", Summary: "Seat the next round and create a lobby for every table", Response: tournamentTablesResponse{}},
+	{Method: "POST", Path: "/api/v2/tournaments/:tournamentId/tables/:tableId/closure", Tag: "tournament", Summary: "Finish the race of a table and rank its players as they stand", Response: entity.TournamentTable{}},
+
+	{Method: "GET", Path: "/api/game/:raceId", Tag: "game", Summary: "Game state of the current player", Response: dto.GetGameResponseDTO{}},
+	{Method: "GET", Path: "/api/game/spectate/:raceId", Tag: "game", Summary: "Read-only game state", Response: dto.GetGameResponseDTO{}},
+	{Method: "GET", Path: "/api/game/cancel/:raceId", Tag: "game", Summary: "Cancel the game"},
+	{Method: "GET", Path: "/api/game/reset/:raceId", Tag: "game", Summary: "Reset the game"},
+	{Method: "POST", Path: "/api/game/start/:lobbyId", Tag: "game", Summary: "Start a game from the lobby", Response: dto.StartGameResponseDto{}},
+	{Method: "POST", Path: "/api/game/promote/:raceId", Tag: "game", Summary: "Promote a player from the wait list", Body: dto.PromoteWaitListBodyDTO{}, Response: playerResponse{}},
+	{Method: "POST", Path: "/api/game/roll-dice", Tag: "game", Summary: "Roll the dice, in hand mode the faces of the physical dice are entered instead", Query: []string{"raceId"}, Body: dto.RollDiceDto{}, Response: dto.RollDiceResponseDto{}},
+	{Method: "GET", Path: "/api/game/change-turn", Tag: "game", Summary: "Pass the turn", Query: []string{"raceId", "forced"}},
+	{Method: "GET", Path: "/api/game/get/tiles", Tag: "game", Summary: "Board tiles", Query: []string{"raceId", "bigRace"}, Response: tilesResponse{}},
+	{Method: "POST", Path: "/api/v2/lobbies/:lobbyId/races", Tag: "game", Summary: "Start a game from the lobby", Response: dto.StartGameResponseDto{}},
+	{Method: "GET", Path: "/api/v2/races/:raceId", Tag: "game", Summary: "Game state of the current player", Response: dto.GetGameResponseDTO{}},
+	{Method: "DELETE", Path: "/api/v2/races/:raceId", Tag: "game", Summary: "Cancel the game"},
+	{Method: "GET", Path: "/api/v2/races/:raceId/view", Tag: "game", Summary: "Read-only game state", Response: dto.GetGameResponseDTO{}},
+	{Method: "POST", Path: "/api/v2/races/:raceId/reset", Tag: "game", Summary: "Reset the game"},
+	{Method: "POST", Path: "/api/v2/races/:raceId/dice", Tag: "game", Summary: "Roll the dice, in hand mode the faces of the physical dice are entered instead", Body: dto.RollDiceDto{}, Response: dto.RollDiceResponseDto{}},
+	{Method: "POST", Path: "/api/v2/races/:raceId/turns", Tag: "game", Summary: "Pass the turn", Query: []string{"forced"}},
+	{Method: "GET", Path: "/api/v2/races/:raceId/tiles", Tag: "game", Summary: "Board tiles", Query: []string{"bigRace"}, Response: tilesResponse{}},
+	{Method: "POST", Path: "/api/v2/races/:raceId/wait-list/promotions", Tag: "game", Summary: "Promote a player from the wait list", Body: dto.PromoteWaitListBodyDTO{}, Response: playerResponse{}},
+	{Method: "POST", Path: "/api/v2/races/:raceId/pause", Tag: "game", Summary: "Pause the race, the players cannot act and the deadlines stop until it is resumed", Response: raceResponse{}},
+	{Method: "POST", Path: "/api/v2/races/:raceId/resume", Tag: "game", Summary: "Resume a paused race", Response: raceResponse{}},
+	{Method: "GET", Path: "/api/v2/users/me/paused-races", Tag: "game", Summary: "Paused races the current user owns or moderates", Response: racesResponse{}},
+
+	{Method: "POST", Path: "/api/finance/send/money", Tag: "finance", Summary: "Send money to a player, the bank or pay taxes", Query: []string{"raceId"}, Body: dto.SendMoneyBodyDTO{}},
+	{Method: "POST", Path: "/api/finance/send/assets", Tag: "finance", Summary: "Send assets to a player", Query: []string{"raceId"}, Body: dto.SendAssetsBodyDTO{}},
+	{Method: "POST", Path: "/api/finance/loan/take", Tag: "finance", Summary: "Take a bank loan", Query: []string{"raceId"}, Body: dto.TakeLoanBodyDTO{}},
+	{Method: "POST", Path: "/api/v2/races/:raceId/players/:playerId/transfers", Tag: "finance", Summary: "Send money to a player, the bank or pay taxes", Body: dto.SendMoneyBodyDTO{}},
+	{Method: "POST", Path: "/api/v2/races/:raceId/players/:playerId/asset-transfers", Tag: "finance", Summary: "Send assets to a player", Body: dto.SendAssetsBodyDTO{}},
+	{Method: "POST", Path: "/api/v2/races/:raceId/players/:playerId/loans", Tag: "finance", Summary: "Take a bank loan", Body: dto.TakeLoanBodyDTO{}},
+	{Method: "POST", Path: "/api/v2/races/:raceId/players/:playerId/loan-repayments", Tag: "finance", Summary: "Pay off a bank loan or another liability", Body: dto.PayLoanBodyDTO{}},
+	{Method: "GET", Path: "/api/v2/races/:raceId/players/:playerId/loans", Tag: "finance", Summary: "Bank loans with payment schedules, the race loan policy and the borrowing limit", Response: dto.PlayerLoansResponseDTO{}},
+	{Method: "GET", Path: "/api/v2/races/:raceId/players/:playerId/insurances", Tag: "finance", Summary: "Insurance products of the race and the policies of the player with their claims", Response: dto.PlayerInsurancesResponseDTO{}},
+	{Method: "POST", Path: "/api/v2/races/:raceId/players/:playerId/insurances", Tag: "finance", Summary: "Buy an insurance, the first premium is paid upfront and the next ones on every payday", Body: dto.BuyInsuranceBodyDTO{}},
+	{Method: "DELETE", Path: "/api/v2/races/:raceId/players/:playerId/insurances/:insuranceType", Tag: "finance", Summary: "Cancel an insurance"},
+	{Method: "GET", Path: "/api/v2/races/:raceId/players/:playerId/user-requests", Tag: "finance", Summary: "Requests of the player to the moderators with their statuses", Response: userRequestsResponse{}},
+	{Method: "POST", Path: "/api/v2/races/:raceId/players/:playerId/user-requests", Tag: "finance", Summary: "Ask the moderators for a salary, baby money, loan, loan repayment, charity or an asset sale, only in manager mode", Body: dto.UserRequestBodyDTO{}, Response: userRequestResponse{}},
+	{Method: "DELETE", Path: "/api/v2/races/:raceId/players/:playerId/user-requests/:userRequestId", Tag: "finance", Summary: "Cancel a pending request"},
+	{Method: "POST", Path: "/api/finance/ask/money", Tag: "finance", Summary: "Ask the moderator for money", Query: []string{"raceId"}, Body: dto.AskMoneyBodyDto{}, Response: dto.MessageResponseDto{}},
+	{Method: "POST", Path: "/api/v2/races/:raceId/players/:playerId/money-requests", Tag: "finance", Summary: "Ask the moderator for money", Body: dto.AskMoneyBodyDto{}, Response: dto.MessageResponseDto{}},
+
+	{Method: "GET", Path: "/api/player/info", Tag: "player", Summary: "Sheet of the current player", Query: []string{"raceId"}, Response: dto.GetRacePlayerResponseDTO{}},
+	{Method: "POST", Path: "/api/player/on-big-race/:raceId", Tag: "player", Summary: "Move to the big race"},
+	{Method: "GET", Path: "/api/v2/races/:raceId/players/:playerId/big-race/progress", Tag: "player", Summary: "Progress of the player on every fast track entry rule", Response: dto.BigRaceProgressResponseDTO{}},
+	{Method: "GET", Path: "/api/v2/races/:raceId/team-decisions", Tag: "team", Summary: "Deals proposed in the team of the current user", Response: teamDecisionsResponse{}},
+	{Method: "POST", Path: "/api/v2/races/:raceId/team-decisions/:decisionId/votes", Tag: "team", Summary: "Approve or reject a deal proposed in the team", Body: dto.TeamDecisionVoteBodyDTO{}, Response: entity.TeamDecision{}},
+	{Method: "POST", Path: "/api/player/dream/:raceId", Tag: "player", Summary: "Choose a dream", Body: entity.PlayerDream{}},
+	{Method: "GET", Path: "/api/player/data/:raceId", Tag: "player", Summary: "Free-form player data", Response: entity.PlayerInfoData{}},
+	{Method: "PUT", Path: "/api/player/data/:raceId", Tag: "player", Summary: "Save free-form player data", Body: entity.PlayerInfoData{}},
+	{Method: "POST", Path: "/api/player/moderator/:raceId", Tag: "player", Summary: "Become the moderator"},
+	{Method: "POST", Path: "/api/player/read-notification/:notificationId/:raceId", Tag: "player", Summary: "Mark a notification as read"},
+	{Method: "GET", Path: "/api/v2/races/:raceId/players/:playerId", Tag: "player", Summary: "Sheet of the player", Response: dto.GetRacePlayerResponseDTO{}},
+	{Method: "POST", Path: "/api/v2/races/:raceId/players/:playerId/big-race", Tag: "player", Summary: "Move to the big race"},
+	{Method: "PUT", Path: "/api/v2/races/:raceId/players/:playerId/dream", Tag: "player", Summary: "Choose a dream", Body: entity.PlayerDream{}},
+	{Method: "GET", Path: "/api/v2/races/:raceId/players/:playerId/data", Tag: "player", Summary: "Free-form player data", Response: entity.PlayerInfoData{}},
+	{Method: "PUT", Path: "/api/v2/races/:raceId/players/:playerId/data", Tag: "player", Summary: "Save free-form player data", Body: entity.PlayerInfoData{}},
+	{Method: "DELETE", Path: "/api/v2/races/:raceId/players/:playerId/notifications/:notificationId", Tag: "player", Summary: "Mark a notification as read"},
+	{Method: "POST", Path: "/api/v2/races/:raceId/moderator", Tag: "player", Summary: "Become the moderator"},
+
+	{Method: "GET", Path: "/api/chat/:raceId", Tag: "chat", Summary: "Chat messages, newest first", Query: []string{"cursor", "limit"}, Response: dto.GetChatMessagesResponseDTO{}},
+	{Method: "POST", Path: "/api/chat/:raceId", Tag: "chat", Summary: "Send a chat message", Body: dto.SendChatMessageBodyDTO{}, Response: entity.ChatMessage{}},
+	{Method: "POST", Path: "/api/chat/:raceId/react/:messageId", Tag: "chat", Summary: "Toggle a reaction", Body: dto.ReactChatMessageBodyDTO{}, Response: entity.ChatMessage{}},
+	{Method: "DELETE", Path: "/api/chat/:raceId/:messageId", Tag: "chat", Summary: "Delete a chat message"},
+	{Method: "GET", Path: "/api/v2/races/:raceId/messages", Tag: "chat", Summary: "Chat messages, newest first", Query: []string{"cursor", "limit"}, Response: dto.GetChatMessagesResponseDTO{}},
+	{Method: "POST", Path: "/api/v2/races/:raceId/messages", Tag: "chat", Summary: "Send a chat message", Body: dto.SendChatMessageBodyDTO{}, Response: entity.ChatMessage{}},
+	{Method: "DELETE", Path: "/api/v2/races/:raceId/messages/:messageId", Tag: "chat", Summary: "Delete a chat message"},
+	{Method: "POST", Path: "/api/v2/races/:raceId/messages/:messageId/reactions", Tag: "chat", Summary: "Toggle a reaction", Body: dto.ReactChatMessageBodyDTO{}, Response: entity.ChatMessage{}},
+
+	{Method: "GET", Path: "/api/trade/:raceId", Tag: "trade", Summary: "Trade offers of the current player", Response: tradeOffersResponse{}},
+	{Method: "POST", Path: "/api/trade/:raceId", Tag: "trade", Summary: "Propose a trade", Body: dto.TradeOfferBodyDTO{}, Response: entity.TradeOffer{}},
+	{Method: "POST", Path: "/api/trade/:raceId/counter/:offerId", Tag: "trade", Summary: "Counter a trade offer", Body: dto.TradeOfferBodyDTO{}, Response: entity.TradeOffer{}},
+	{Method: "POST", Path: "/api/trade/:raceId/accept/:offerId", Tag: "trade", Summary: "Accept a trade offer", Response: entity.TradeOffer{}},
+	{Method: "POST", Path: "/api/trade/:raceId/decline/:offerId", Tag: "trade", Summary: "Decline a trade offer", Response: entity.TradeOffer{}},
+	{Method: "POST", Path: "/api/trade/:raceId/cancel/:offerId", Tag: "trade", Summary: "Cancel a trade offer", Response: entity.TradeOffer{}},
+	{Method: "GET", Path: "/api/v2/races/:raceId/trades", Tag: "trade", Summary: "Trade offers of the current player", Response: tradeOffersResponse{}},
+	{Method: "POST", Path: "/api/v2/races/:raceId/trades", Tag: "trade", Summary: "Propose a trade", Body: dto.TradeOfferBodyDTO{}, Response: entity.TradeOffer{}},
+	{Method: "DELETE", Path: "/api/v2/races/:raceId/trades/:offerId", Tag: "trade", Summary: "Cancel a trade offer", Response: entity.TradeOffer{}},
+	{Method: "POST", Path: "/api/v2/races/:raceId/trades/:offerId/counter-offers", Tag: "trade", Summary: "Counter a trade offer", Body: dto.TradeOfferBodyDTO{}, Response: entity.TradeOffer{}},
+	{Method: "POST", Path: "/api/v2/races/:raceId/trades/:offerId/acceptance", Tag: "trade", Summary: "Accept a trade offer", Response: entity.TradeOffer{}},
+	{Method: "POST", Path: "/api/v2/races/:raceId/trades/:offerId/decline", Tag: "trade", Summary: "Decline a trade offer", Response: entity.TradeOffer{}},
+
+	{Method: "GET", Path: "/api/auction/:raceId", Tag: "auction", Summary: "Current auction", Response: entity.RaceAuction{}},
+	{Method: "POST", Path: "/api/auction/:raceId/bid", Tag: "auction", Summary: "Place a bid", Body: dto.AuctionBidBodyDTO{}, Response: entity.RaceAuction{}},
+	{Method: "POST", Path: "/api/auction/:raceId/close", Tag: "auction", Summary: "Close the auction", Response: entity.RaceAuction{}},
+	{Method: "GET", Path: "/api/v2/races/:raceId/auction", Tag: "auction", Summary: "Current auction", Response: entity.RaceAuction{}},
+	{Method: "DELETE", Path: "/api/v2/races/:raceId/auction", Tag: "auction", Summary: "Close the auction", Response: entity.RaceAuction{}},
+	{Method: "POST", Path: "/api/v2/races/:raceId/auction/bids", Tag: "auction", Summary: "Place a bid", Body: dto.AuctionBidBodyDTO{}, Response: entity.RaceAuction{}},
+
+	{Method: "GET", Path: "/api/v2/races/:raceId/market", Tag: "market", Summary: "Last traded price, range and volume of every stock symbol in the race", Response: dto.MarketResponseDTO{}},
+	{Method: "GET", Path: "/api/v2/races/:raceId/market/:symbol", Tag: "market", Summary: "Price history of a stock symbol for charts", Response: entity.MarketSymbol{}},
+	{Method: "GET", Path: "/api/v2/races/:raceId/players/:playerId/portfolio", Tag: "market", Summary: "Stocks of the player valued at the race market", Response: dto.PortfolioResponseDTO{}},
+	{Method: "GET", Path: "/api/v2/races/:raceId/players/:playerId/notifications", Tag: "notifications", Summary: "Notifications of the player with their read state, newest first", Query: []string{"cursor", "limit"}, Response: dto.GetNotificationsResponseDTO{}},
+	{Method: "GET", Path: "/api/v2/races/:raceId/players/:playerId/notifications/stream", Tag: "notifications", Summary: "Server-sent events pushing new notifications of the player while the connection is open"},
+	{Method: "POST", Path: "/api/v2/races/:raceId/players/:playerId/notifications/receipts", Tag: "notifications", Summary: "Mark all notifications as read"},
+	{Method: "POST", Path: "/api/v2/races/:raceId/players/:playerId/notifications/:notificationId/receipt", Tag: "notifications", Summary: "Mark a notification as read"},
+	{Method: "GET", Path: "/api/v2/races/:raceId/players/:playerId/balance-sheet", Tag: "finance", Summary: "Balance sheet valued at the race market with the net worth recorded on every payday", Response: dto.BalanceSheetResponseDTO{}},
+
+	{Method: "GET", Path: "/api/v2/races/:raceId/shared-assets", Tag: "shared-assets", Summary: "Assets the current player owns in partnership with their shares", Response: sharedAssetsResponse{}},
+	{Method: "POST", Path: "/api/v2/races/:raceId/shared-assets/:sharedAssetId/buyouts", Tag: "shared-assets", Summary: "Offer a partner a price for a part of their share", Body: dto.SharedAssetBuyoutBodyDTO{}, Response: entity.SharedAsset{}},
+	{Method: "POST", Path: "/api/v2/races/:raceId/shared-assets/:sharedAssetId/buyouts/:buyoutId/acceptance", Tag: "shared-assets", Summary: "Sell the share to the buyer", Response: entity.SharedAsset{}},
+	{Method: "POST", Path: "/api/v2/races/:raceId/shared-assets/:sharedAssetId/buyouts/:buyoutId/decline", Tag: "shared-assets", Summary: "Decline or cancel a buyout", Response: entity.SharedAsset{}},
+
+	{Method: "POST", Path: "/api/v2/test/player/extra-money", Tag: "test", Summary: "Give the test player money", Public: true},
+	{Method: "POST", Path: "/api/v2/test/player/sell-stocks", Tag: "test", Summary: "Sell stocks of the test player", Response: testPlayerResponse{}, Public: true},
+	{Method: "POST", Path: "/api/v2/test/player/sell-business", Tag: "test", Summary: "Sell a business of the test player", Response: testPlayerResponse{}, Public: true},
+	{Method: "POST", Path: "/api/v2/test/player/sell-real-estate", Tag: "test", Summary: "Sell real estate of the test player", Response: testPlayerResponse{}, Public: true},
+	{Method: "POST", Path: "/api/v2/test/player/sell-other-assets", Tag: "test", Summary: "Sell other assets of the test player", Response: testPlayerResponse{}, Public: true},
+	{Method: "POST", Path: "/api/v2/test/player/damage-real-estate", Tag: "test", Summary: "Damage real estate of the test player", Response: testPlayerResponse{}, Public: true},
+	{Method: "POST", Path: "/api/v2/test/player/increase-stocks", Tag: "test", Summary: "Split the stocks of the test player", Response: testPlayerResponse{}, Public: true},
+	{Method: "POST", Path: "/api/v2/test/player/decrease-stocks", Tag: "test", Summary: "Reverse split the stocks of the test player", Response: testPlayerResponse{}, Public: true},
+	{Method: "POST", Path: "/api/v2/test/player/buy-stocks", Tag: "test", Summary: "Buy stocks for the test player", Response: testPlayerResponse{}, Public: true},
+	{Method: "POST", Path: "/api/v2/test/player/buy-other-assets", Tag: "test", Summary: "Buy other assets for the test player", Response: testPlayerResponse{}, Public: true},
+	{Method: "POST", Path: "/api/v2/test/player/buy-real-estate", Tag: "test", Summary: "Buy real estate for the test player", Response: testPlayerResponse{}, Public: true},
+	{Method: "POST", Path: "/api/v2/test/player/buy-lottery", Tag: "test", Summary: "Buy a lottery for the test player", Response: testPlayerResponse{}, Public: true},
+	{Method: "POST", Path: "/api/v2/test/player/buy-business", Tag: "test", Summary: "Buy a business for the test player", Response: testPlayerResponse{}, Public: true},
+	{Method: "POST", Path: "/api/v2/test/player/buy-partner-other-assets", Tag: "test", Summary: "Buy other assets in partnership for the test player", Public: true},
+	{Method: "POST", Path: "/api/v2/test/player/buy-partner-real-estate", Tag: "test", Summary: "Buy real estate in partnership for the test player", Public: true},
+	{Method: "POST", Path: "/api/v2/test/player/buy-partner-business", Tag: "test", Summary: "Buy a business in partnership for the test player", Public: true},
+	{Method: "POST", Path: "/api/v2/test/player/buy-dream", Tag: "test", Summary: "Buy a dream for the test player", Response: testPlayerResponse{}, Public: true},
+	{Method: "POST", Path: "/api/v2/test/player/buy-big-business", Tag: "test", Summary: "Buy a big business for the test player", Response: testPlayerResponse{}, Public: true},
+	{Method: "POST", Path: "/api/v2/test/player/buy-risk-business", Tag: "test", Summary: "Buy a risk business for the test player", Response: testPlayerResponse{}, Public: true},
+	{Method: "POST", Path: "/api/v2/test/player/buy-risk-stocks", Tag: "test", Summary: "Buy risk stocks for the test player", Response: testPlayerResponse{}, Public: true},
+}
@@ -0,0 +1,77 @@
+package repository
+
+import (
+	logger "github.com/sirupsen/logrus"
+	"github.com/webjohny/cashflow-go/entity"
+	"github.com/webjohny/cashflow-go/helper"
+	"gorm.io/gorm"
+	"time"
+)
+
+type ChatRepository interface {
+	InsertMessage(b *entity.ChatMessage) (error, entity.ChatMessage)
+	UpdateMessage(b *entity.ChatMessage) (error, entity.ChatMessage)
+	FindMessageById(ID uint64) entity.ChatMessage
+	GetRaceMessages(raceId uint64, beforeId uint64, limit int) []entity.ChatMessage
+}
+
+const ChatMessagesTable = "chat_messages"
+
+type chatConnection struct {
+	connection *gorm.DB
+}
+
+func NewChatRepository(dbConn *gorm.DB) ChatRepository {
+	return &chatConnection{
+		connection: dbConn,
+	}
+}
+
+func (db *chatConnection) InsertMessage(b *entity.ChatMessage) (error, entity.ChatMessage) {
+	b.CreatedAt = time.Now()
+	result := db.connection.Save(&b)
+
+	if result.Error != nil {
+		logger.Error(result.Error, helper.JsonSerialize(b))
+
+		return result.Error, entity.ChatMessage{}
+	}
+
+	db.connection.Find(&b)
+	return nil, *b
+}
+
+func (db *chatConnection) UpdateMessage(b *entity.ChatMessage) (error, entity.ChatMessage) {
+	result := db.connection.Select("*").Updates(&b)
+
+	if result.Error != nil {
+		logger.Error(result.Error, helper.JsonSerialize(b))
+
+		return result.Error, entity.ChatMessage{}
+	}
+
+	db.connection.Find(&b)
+	return nil, *b
+}
+
+func (db *chatConnection) FindMessageById(ID uint64) entity.ChatMessage {
+	var message entity.ChatMessage
+
+	db.connection.Find(&message, ID)
+
+	return message
+}
+
+func (db *chatConnection) GetRaceMessages(raceId uint64, beforeId uint64, limit int) []entity.ChatMessage {
+	var messages []entity.ChatMessage
+
+	query := db.connection.Where("race_id = ?", raceId)
+
+	if beforeId > 0 {
+		query = query.Where("id < ?", beforeId)
+	}
+
+	query.Order("id DESC").Limit(limit).Find(&messages)
+
+	return messages
+}
@@ -0,0 +1,47 @@
+package repository
+
+import (
+	logger "github.com/sirupsen/logrus"
+	"github.com/webjohny/cashflow-go/entity"
+	"gorm.io/gorm"
+	"time"
+)
+
+type IdempotencyRepository interface {
+	InsertKey(b *entity.IdempotencyKey) error
+	FindByKey(key string) entity.IdempotencyKey
+	DeleteOlderThan(moment time.Time) error
+}
+
+type idempotencyConnection struct {
+	connection *gorm.DB
+}
+
+func NewIdempotencyRepository(dbConn *gorm.DB) IdempotencyRepository {
+	return &idempotencyConnection{
+		connection: dbConn,
+	}
+}
+
+func (db *idempotencyConnection) InsertKey(b *entity.IdempotencyKey) error {
+	b.CreatedAt = time.Now()
+	result := db.connection.Create(&b)
+
+	if result.Error != nil {
+		logger.Error(result.Error, b.Key)
+	}
+
+	return result.Error
+}
+
+func (db *idempotencyConnection) FindByKey(key string) entity.IdempotencyKey {
+	var idempotencyKey entity.IdempotencyKey
+
+	db.connection.Where("`key` = ?", key).Limit(1).Find(&idempotencyKey)
+
+	return idempotencyKey
+}
+
+func (db *idempotencyConnection) DeleteOlderThan(moment time.Time) error {
+	return db.connection.Where("created_at < ?", moment).Delete(&entity.IdempotencyKey{}).Error
+}
@@ -1,31 +1,32 @@
 package repository_mocks
 
 import (
+	"github.com/webjohny/cashflow-go/apperror"
 	"github.com/webjohny/cashflow-go/entity"
-	"github.com/webjohny/cashflow-go/storage"
 )
 
 type MockLobbyRepository struct {
-	InsertLobbyFunc   func(lobby *entity.Lobby) (error, entity.Lobby)
-	UpdateLobbyFunc   func(lobby *entity.Lobby) (error, entity.Lobby)
-	DeleteLobbyFunc   func(lobby *entity.Lobby)
-	FindLobbyByIdFunc func(ID uint64) entity.Lobby
-	AllFunc           func() []entity.Lobby
-	CancelLobbyFunc   func(lobby *entity.Lobby)
+	InsertLobbyFunc       func(lobby *entity.Lobby) (error, entity.Lobby)
+	UpdateLobbyFunc       func(lobby *entity.Lobby) (error, entity.Lobby)
+	DeleteLobbyFunc       func(lobby *entity.Lobby)
+	FindLobbyByIdFunc     func(ID uint64) entity.Lobby
+	FindLobbyByGameIdFunc func(gameId uint64) entity.Lobby
+	AllFunc               func() []entity.Lobby
+	CancelLobbyFunc       func(lobby *entity.Lobby)
 }
 
 func (m *MockLobbyRepository) InsertLobby(lobby *entity.Lobby) (error, entity.Lobby) {
 	if m.InsertLobbyFunc != nil {
 		return m.InsertLobbyFunc(lobby)
 	}
-	return errors.New(storage.ErrorUndefinedLobby), entity.Lobby{}
+	return apperror.ErrUndefinedLobby, entity.Lobby{}
 }
 
 func (m *MockLobbyRepository) UpdateLobby(lobby *entity.Lobby) (error, entity.Lobby) {
 	if m.UpdateLobbyFunc != nil {
 		return m.UpdateLobbyFunc(lobby)
 	}
-	return errors.New(storage.ErrorUndefinedLobby), entity.Lobby{}
+	return apperror.ErrUndefinedLobby, entity.Lobby{}
 }
 
 func (m *MockLobbyRepository) DeleteLobby(lobby *entity.Lobby) {
@@ -35,8 +36,8 @@ func (m *MockLobbyRepository) DeleteLobby(lobby *entity.Lobby) {
 }
 
 func (m *MockLobbyRepository) CancelLobby(lobby *entity.Lobby) {
-	if m.DeleteLobbyFunc != nil {
-		m.DeleteLobbyFunc(lobby)
+	if m.CancelLobbyFunc != nil {
+		m.CancelLobbyFunc(lobby)
 	}
 }
 
@@ -47,6 +48,13 @@ func (m *MockLobbyRepository) FindLobbyById(ID uint64) entity.Lobby {
 	return entity.Lobby{}
 }
 
+func (m *MockLobbyRepository) FindLobbyByGameId(gameId uint64) entity.Lobby {
+	if m.FindLobbyByGameIdFunc != nil {
+		return m.FindLobbyByGameIdFunc(gameId)
+	}
+	return entity.Lobby{}
+}
+
 func (m *MockLobbyRepository) All() []entity.Lobby {
 	if m.AllFunc != nil {
 		return m.AllFunc()
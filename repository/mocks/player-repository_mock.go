@@ -1,32 +1,45 @@
 package repository_mocks
 
 import (
-	"errors"
+	"github.com/webjohny/cashflow-go/apperror"
 	"github.com/webjohny/cashflow-go/entity"
-	"github.com/webjohny/cashflow-go/storage"
 )
 
 type MockPlayerRepository struct {
-	InsertPlayerFunc                  func(b *entity.Player) (error, entity.Player)
-	UpdatePlayerFunc                  func(b *entity.Player) (error, entity.Player)
-	UpdateCashFunc                    func(b *entity.Player, cash int)
-	AllByRaceIdFunc                   func(raceId uint64) []entity.Player
-	DeletePlayerFunc                  func(b *entity.Player) error
-	FindPlayerByIdFunc                func(ID uint64) entity.Player
-	FindPlayerByUsernameFunc          func(username string) entity.Player
-	FindPlayerByUsernameAndRaceIdFunc func(raceId uint64, username string) entity.Player
-	FindPlayerByUserIdAndRaceIdFunc   func(raceId uint64, userId uint64) entity.Player
+	InsertPlayerFunc                     func(b *entity.Player) (error, entity.Player)
+	UpdatePlayerFunc                     func(b *entity.Player) (error, entity.Player)
+	UpdatePlayersFunc                    func(players []*entity.Player) error
+	UpdateCashFunc                       func(b *entity.Player, cash int)
+	AllByRaceIdFunc                      func(raceId uint64) []entity.Player
+	AllActiveByRaceIdFunc                func(raceId uint64) []entity.Player
+	AllModeratorsByRaceIdFunc            func(raceId uint64) []entity.Player
+	DeletePlayerFunc                     func(b *entity.Player) error
+	IsCurrentPlayerOnTheRaceFunc         func(player entity.Player) bool
+	FindPlayerByRaceIdAndInfoDreamIdFunc func(raceId uint64, dreamId int) entity.Player
+	FindPlayerByIdFunc                   func(ID uint64) entity.Player
+	FindPlayerByUsernameFunc             func(username string) entity.Player
+	FindPlayerByUsernameAndRaceIdFunc    func(raceId uint64, username string) entity.Player
+	FindPlayerByUserIdAndRaceIdFunc      func(raceId uint64, userId uint64) entity.Player
+	FindPlayerByPlayerIdAndRaceIdFunc    func(raceId uint64, playerId uint64) entity.Player
+	InsertTeamMembersFunc                func(members []entity.TeamMember) error
 }
 
 func (m *MockPlayerRepository) UpdatePlayer(player *entity.Player) (error, entity.Player) {
 	if m.UpdatePlayerFunc != nil {
 		return m.UpdatePlayerFunc(player)
 	}
-	return errors.New(storage.ErrorUndefinedPlayer), entity.Player{}
+	return apperror.ErrUndefinedPlayer, entity.Player{}
+}
+
+func (m *MockPlayerRepository) UpdatePlayers(players []*entity.Player) error {
+	if m.UpdatePlayersFunc != nil {
+		return m.UpdatePlayersFunc(players)
+	}
+	return apperror.ErrUndefinedPlayer
 }
 
 func (m *MockPlayerRepository) UpdateCash(player *entity.Player, cash int) {
-	if m.UpdatePlayerFunc != nil {
+	if m.UpdateCashFunc != nil {
 		m.UpdateCashFunc(player, cash)
 	}
 }
@@ -39,12 +52,42 @@ func (m *MockPlayerRepository) AllByRaceId(raceId uint64) []entity.Player {
 	return make([]entity.Player, 0)
 }
 
+func (m *MockPlayerRepository) AllActiveByRaceId(raceId uint64) []entity.Player {
+	if m.AllActiveByRaceIdFunc != nil {
+		return m.AllActiveByRaceIdFunc(raceId)
+	}
+
+	return make([]entity.Player, 0)
+}
+
+func (m *MockPlayerRepository) AllModeratorsByRaceId(raceId uint64) []entity.Player {
+	if m.AllModeratorsByRaceIdFunc != nil {
+		return m.AllModeratorsByRaceIdFunc(raceId)
+	}
+
+	return make([]entity.Player, 0)
+}
+
 func (m *MockPlayerRepository) DeletePlayer(player *entity.Player) error {
 	if m.DeletePlayerFunc != nil {
 		return m.DeletePlayerFunc(player)
 	}
 
-	return errors.New(storage.ErrorUndefinedPlayer)
+	return apperror.ErrUndefinedPlayer
+}
+
+func (m *MockPlayerRepository) IsCurrentPlayerOnTheRace(player entity.Player) bool {
+	if m.IsCurrentPlayerOnTheRaceFunc != nil {
+		return m.IsCurrentPlayerOnTheRaceFunc(player)
+	}
+	return false
+}
+
+func (m *MockPlayerRepository) FindPlayerByRaceIdAndInfoDreamId(raceId uint64, dreamId int) entity.Player {
+	if m.FindPlayerByRaceIdAndInfoDreamIdFunc != nil {
+		return m.FindPlayerByRaceIdAndInfoDreamIdFunc(raceId, dreamId)
+	}
+	return entity.Player{}
 }
 
 func (m *MockPlayerRepository) FindPlayerById(ID uint64) entity.Player {
@@ -69,16 +112,31 @@ func (m *MockPlayerRepository) FindPlayerByUsernameAndRaceId(raceId uint64, user
 }
 
 func (m *MockPlayerRepository) FindPlayerByUserIdAndRaceId(raceId uint64, userId uint64) entity.Player {
-	if m.FindPlayerByUsernameAndRaceIdFunc != nil {
+	if m.FindPlayerByUserIdAndRaceIdFunc != nil {
 		return m.FindPlayerByUserIdAndRaceIdFunc(raceId, userId)
 	}
 	return entity.Player{}
 }
 
+func (m *MockPlayerRepository) FindPlayerByPlayerIdAndRaceId(raceId uint64, playerId uint64) entity.Player {
+	if m.FindPlayerByPlayerIdAndRaceIdFunc != nil {
+		return m.FindPlayerByPlayerIdAndRaceIdFunc(raceId, playerId)
+	}
+	return entity.Player{}
+}
+
 func (m *MockPlayerRepository) InsertPlayer(player *entity.Player) (error, entity.Player) {
 	if m.InsertPlayerFunc != nil {
 		return m.InsertPlayerFunc(player)
 	}
 
-	return errors.New(storage.ErrorUndefinedPlayer), entity.Player{}
+	return apperror.ErrUndefinedPlayer, entity.Player{}
+}
+
+func (m *MockPlayerRepository) InsertTeamMembers(members []entity.TeamMember) error {
+	if m.InsertTeamMembersFunc != nil {
+		return m.InsertTeamMembersFunc(members)
+	}
+
+	return nil
 }
@@ -1,24 +1,41 @@
 package repository_mocks
 
-import "github.com/webjohny/cashflow-go/entity"
+import (
+	"github.com/webjohny/cashflow-go/apperror"
+	"github.com/webjohny/cashflow-go/dto"
+	"github.com/webjohny/cashflow-go/entity"
+)
 
 type MockProfessionRepository struct {
-	AllFunc                func() []entity.Profession
-	FindProfessionByIdFunc func(ID uint64) entity.Profession
-	PickProfessionFunc     func(excluded *[]int) entity.Profession
+	AllFunc                func(language string) (error, []entity.Profession)
+	FindProfessionByIdFunc func(ID uint64, language string) (error, entity.Profession)
+	PickProfessionFunc     func(language string, excluded *[]int) (error, entity.Profession)
+	SetProfessionsFunc     func(professions dto.ProfessionsSetBodyDTO)
 }
 
-func (m MockProfessionRepository) All() []entity.Profession {
-	//TODO implement me
-	panic("implement me")
+func (m *MockProfessionRepository) All(language string) (error, []entity.Profession) {
+	if m.AllFunc != nil {
+		return m.AllFunc(language)
+	}
+	return nil, []entity.Profession{}
 }
 
-func (m MockProfessionRepository) FindProfessionById(ID uint64) entity.Profession {
-	//TODO implement me
-	panic("implement me")
+func (m *MockProfessionRepository) FindProfessionById(ID uint64, language string) (error, entity.Profession) {
+	if m.FindProfessionByIdFunc != nil {
+		return m.FindProfessionByIdFunc(ID, language)
+	}
+	return apperror.ErrUndefinedProfession, entity.Profession{}
 }
 
-func (m MockProfessionRepository) PickProfession(excluded *[]int) entity.Profession {
-	//TODO implement me
-	panic("implement me")
+func (m *MockProfessionRepository) PickProfession(language string, excluded *[]int) (error, entity.Profession) {
+	if m.PickProfessionFunc != nil {
+		return m.PickProfessionFunc(language, excluded)
+	}
+	return apperror.ErrUndefinedProfession, entity.Profession{}
+}
+
+func (m *MockProfessionRepository) SetProfessions(professions dto.ProfessionsSetBodyDTO) {
+	if m.SetProfessionsFunc != nil {
+		m.SetProfessionsFunc(professions)
+	}
 }
@@ -1,9 +1,8 @@
 package repository_mocks
 
 import (
-	"errors"
+	"github.com/webjohny/cashflow-go/apperror"
 	"github.com/webjohny/cashflow-go/entity"
-	"github.com/webjohny/cashflow-go/storage"
 )
 
 type MockRaceRepository struct {
@@ -12,20 +11,21 @@ type MockRaceRepository struct {
 	DeleteRaceFunc   func(race *entity.Race) error
 	FindRaceByIdFunc func(ID uint64) entity.Race
 	AllFunc          func() []entity.Race
+	AllByStatusFunc  func(status string) []entity.Race
 }
 
 func (m *MockRaceRepository) UpdateRace(race *entity.Race) (error, entity.Race) {
 	if m.UpdateRaceFunc != nil {
 		return m.UpdateRaceFunc(race)
 	}
-	return errors.New(storage.ErrorUndefinedGame), entity.Race{}
+	return apperror.ErrUndefinedGame, entity.Race{}
 }
 
 func (m *MockRaceRepository) DeleteRace(race *entity.Race) error {
 	if m.DeleteRaceFunc != nil {
 		return m.DeleteRaceFunc(race)
 	}
-	return errors.New(storage.ErrorUndefinedGame)
+	return apperror.ErrUndefinedGame
 }
 
 func (m *MockRaceRepository) FindRaceById(ID uint64) entity.Race {
@@ -39,7 +39,14 @@ func (m *MockRaceRepository) InsertRace(race *entity.Race) (error, entity.Race)
 	if m.InsertRaceFunc != nil {
 		return m.InsertRaceFunc(race)
 	}
-	return errors.New(storage.ErrorUndefinedGame), entity.Race{}
+	return apperror.ErrUndefinedGame, entity.Race{}
+}
+
+func (m *MockRaceRepository) AllByStatus(status string) []entity.Race {
+	if m.AllByStatusFunc != nil {
+		return m.AllByStatusFunc(status)
+	}
+	return []entity.Race{}
 }
 
 func (m *MockRaceRepository) All() []entity.Race {
@@ -1,43 +1,72 @@
 package repository_mocks
 
-import "github.com/webjohny/cashflow-go/entity"
+import (
+	"github.com/webjohny/cashflow-go/dto"
+	"github.com/webjohny/cashflow-go/entity"
+)
 
 type MockTransactionRepository struct {
-	InsertTransactionFunc func(b *entity.Transaction) entity.Transaction
-	UpdateTransactionFunc func(b *entity.Transaction) entity.Transaction
-	GetPlayerTransactionsFunc func(playerId uint64) []entity.Transaction
-	GetRaceTransactionsFunc func(raceId uint64) []entity.Transaction
-	DeleteTransactionFunc func(b *entity.Transaction)
+	InsertTransactionFunc         func(b *entity.Transaction) error
+	UpdateTransactionFunc         func(b *entity.Transaction) entity.Transaction
+	GetPlayerTransactionsFunc     func(playerId uint64) []entity.Transaction
+	GetRaceTransactionsFunc       func(raceId uint64) []entity.Transaction
+	DeleteTransactionFunc         func(b *entity.Transaction)
 	FindTransactionByPlayerIdFunc func(ID uint64) entity.Transaction
+	FindRaceTransactionFunc       func(player entity.Player, data dto.TransactionCardDTO) entity.Transaction
+	FindTransactionFunc           func(data dto.TransactionDTO) entity.Transaction
 }
 
-func (m MockTransactionRepository) InsertTransaction(b *entity.Transaction) entity.Transaction {
-	//TODO implement me
-	panic("implement me")
+func (m *MockTransactionRepository) InsertTransaction(b *entity.Transaction) error {
+	if m.InsertTransactionFunc != nil {
+		return m.InsertTransactionFunc(b)
+	}
+	return nil
 }
 
-func (m MockTransactionRepository) UpdateTransaction(b *entity.Transaction) entity.Transaction {
-	//TODO implement me
-	panic("implement me")
+func (m *MockTransactionRepository) UpdateTransaction(b *entity.Transaction) entity.Transaction {
+	if m.UpdateTransactionFunc != nil {
+		return m.UpdateTransactionFunc(b)
+	}
+	return *b
 }
 
-func (m MockTransactionRepository) GetPlayerTransactions(playerId uint64) []entity.Transaction {
-	//TODO implement me
-	panic("implement me")
+func (m *MockTransactionRepository) GetPlayerTransactions(playerId uint64) []entity.Transaction {
+	if m.GetPlayerTransactionsFunc != nil {
+		return m.GetPlayerTransactionsFunc(playerId)
+	}
+	return []entity.Transaction{}
 }
 
-func (m MockTransactionRepository) GetRaceTransactions(raceId uint64) []entity.Transaction {
-	//TODO implement me
-	panic("implement me")
+func (m *MockTransactionRepository) GetRaceTransactions(raceId uint64) []entity.Transaction {
+	if m.GetRaceTransactionsFunc != nil {
+		return m.GetRaceTransactionsFunc(raceId)
+	}
+	return []entity.Transaction{}
 }
 
-func (m MockTransactionRepository) DeleteTransaction(b *entity.Transaction) {
-	//TODO implement me
-	panic("implement me")
+func (m *MockTransactionRepository) DeleteTransaction(b *entity.Transaction) {
+	if m.DeleteTransactionFunc != nil {
+		m.DeleteTransactionFunc(b)
+	}
 }
 
-func (m MockTransactionRepository) FindTransactionByPlayerId(ID uint64) entity.Transaction {
-	//TODO implement me
-	panic("implement me")
+func (m *MockTransactionRepository) FindTransactionByPlayerId(ID uint64) entity.Transaction {
+	if m.FindTransactionByPlayerIdFunc != nil {
+		return m.FindTransactionByPlayerIdFunc(ID)
+	}
+	return entity.Transaction{}
 }
 
+func (m *MockTransactionRepository) FindRaceTransaction(player entity.Player, data dto.TransactionCardDTO) entity.Transaction {
+	if m.FindRaceTransactionFunc != nil {
+		return m.FindRaceTransactionFunc(player, data)
+	}
+	return entity.Transaction{}
+}
+
+func (m *MockTransactionRepository) FindTransaction(data dto.TransactionDTO) entity.Transaction {
+	if m.FindTransactionFunc != nil {
+		return m.FindTransactionFunc(data)
+	}
+	return entity.Transaction{}
+}
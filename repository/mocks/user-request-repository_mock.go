@@ -0,0 +1,41 @@
+package repository_mocks
+
+import (
+	"github.com/webjohny/cashflow-go/apperror"
+	"github.com/webjohny/cashflow-go/entity"
+)
+
+type MockUserRequestRepository struct {
+	InsertFunc      func(b *entity.UserRequest) (error, entity.UserRequest)
+	UpdateFunc      func(b *entity.UserRequest) (error, entity.UserRequest)
+	AllFunc         func(where string, params []interface{}) []entity.UserRequest
+	FindOneByIdFunc func(userRequestId uint64) entity.UserRequest
+}
+
+func (m *MockUserRequestRepository) Insert(b *entity.UserRequest) (error, entity.UserRequest) {
+	if m.InsertFunc != nil {
+		return m.InsertFunc(b)
+	}
+	return apperror.ErrUndefinedUserRequest, entity.UserRequest{}
+}
+
+func (m *MockUserRequestRepository) Update(b *entity.UserRequest) (error, entity.UserRequest) {
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(b)
+	}
+	return apperror.ErrUndefinedUserRequest, entity.UserRequest{}
+}
+
+func (m *MockUserRequestRepository) All(where string, params []interface{}) []entity.UserRequest {
+	if m.AllFunc != nil {
+		return m.AllFunc(where, params)
+	}
+	return []entity.UserRequest{}
+}
+
+func (m *MockUserRequestRepository) FindOneById(userRequestId uint64) entity.UserRequest {
+	if m.FindOneByIdFunc != nil {
+		return m.FindOneByIdFunc(userRequestId)
+	}
+	return entity.UserRequest{}
+}
@@ -0,0 +1,64 @@
+package repository
+
+import (
+	logger "github.com/sirupsen/logrus"
+	"github.com/webjohny/cashflow-go/entity"
+	"github.com/webjohny/cashflow-go/helper"
+	"gorm.io/gorm"
+	"time"
+)
+
+type ModeratorAuditRepository interface {
+	InsertModeratorAudit(b *entity.ModeratorAudit) (error, entity.ModeratorAudit)
+	AllByRaceId(raceId uint64) []entity.ModeratorAudit
+	AllByRaceIdAndPlayerId(raceId uint64, playerId uint64) []entity.ModeratorAudit
+}
+
+const ModeratorAuditsTable = "moderator_audits"
+
+type moderatorAuditConnection struct {
+	connection *gorm.DB
+}
+
+func NewModeratorAuditRepository(dbConn *gorm.DB) ModeratorAuditRepository {
+	return &moderatorAuditConnection{
+		connection: dbConn,
+	}
+}
+
+func (db *moderatorAuditConnection) InsertModeratorAudit(b *entity.ModeratorAudit) (error, entity.ModeratorAudit) {
+	b.CreatedAt = time.Now()
+	result := db.connection.Save(&b)
+
+	if result.Error != nil {
+		logger.Error(result.Error, helper.JsonSerialize(b))
+
+		return result.Error, entity.ModeratorAudit{}
+	}
+
+	db.connection.Find(&b)
+	return nil, *b
+}
+
+func (db *moderatorAuditConnection) AllByRaceId(raceId uint64) []entity.ModeratorAudit {
+	var audits []entity.ModeratorAudit
+
+	db.connection.
+		Where("race_id = ?", raceId).
+		Order("id DESC").
+		Find(&audits)
+
+	return audits
+}
+
+func (db *moderatorAuditConnection) AllByRaceIdAndPlayerId(raceId uint64, playerId uint64) []entity.ModeratorAudit {
+	var audits []entity.ModeratorAudit
+
+	db.connection.
+		Where("race_id = ?", raceId).
+		Where("player_id = ?", playerId).
+		Order("id DESC").
+		Find(&audits)
+
+	return audits
+}
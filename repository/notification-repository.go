@@ -0,0 +1,124 @@
+package repository
+
+import (
+	logger "github.com/sirupsen/logrus"
+	"github.com/webjohny/cashflow-go/entity"
+	"github.com/webjohny/cashflow-go/helper"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"time"
+)
+
+type NotificationRepository interface {
+	InsertNotification(b *entity.Notification) (error, entity.Notification)
+	FindNotificationById(ID uint64) entity.Notification
+	FindNotificationByUID(UID string) entity.Notification
+	FindExistingUIDs(UIDs []string) []string
+	GetPlayerNotifications(player entity.Player, beforeId uint64, limit int) []entity.Notification
+	GetUnreadIds(player entity.Player) []uint64
+	GetReceipts(playerId uint64, notificationIds []uint64) []entity.NotificationReceipt
+	InsertReceipts(receipts []entity.NotificationReceipt) error
+}
+
+type notificationConnection struct {
+	connection *gorm.DB
+}
+
+func NewNotificationRepository(dbConn *gorm.DB) NotificationRepository {
+	return &notificationConnection{
+		connection: dbConn,
+	}
+}
+
+func (db *notificationConnection) InsertNotification(b *entity.Notification) (error, entity.Notification) {
+	b.CreatedAt = time.Now()
+	result := db.connection.Save(&b)
+
+	if result.Error != nil {
+		logger.Error(result.Error, helper.JsonSerialize(b))
+
+		return result.Error, entity.Notification{}
+	}
+
+	db.connection.Find(&b)
+	return nil, *b
+}
+
+func (db *notificationConnection) FindNotificationById(ID uint64) entity.Notification {
+	var notification entity.Notification
+
+	db.connection.Find(&notification, ID)
+
+	return notification
+}
+
+func (db *notificationConnection) FindNotificationByUID(UID string) entity.Notification {
+	var notification entity.Notification
+
+	db.connection.Where("uid = ?", UID).Find(&notification)
+
+	return notification
+}
+
+func (db *notificationConnection) FindExistingUIDs(UIDs []string) []string {
+	var existing []string
+
+	db.connection.Model(&entity.Notification{}).Where("uid IN ?", UIDs).Pluck("uid", &existing)
+
+	return existing
+}
+
+func (db *notificationConnection) GetPlayerNotifications(player entity.Player, beforeId uint64, limit int) []entity.Notification {
+	var notifications []entity.Notification
+
+	query := db.playerScope(player)
+
+	if beforeId > 0 {
+		query = query.Where("id < ?", beforeId)
+	}
+
+	query.Order("id DESC").Limit(limit).Find(&notifications)
+
+	return notifications
+}
+
+func (db *notificationConnection) GetUnreadIds(player entity.Player) []uint64 {
+	var ids []uint64
+
+	db.playerScope(player).
+		Where("NOT EXISTS (SELECT 1 FROM notification_receipts r WHERE r.notification_id = notifications.id AND r.player_id = ?)", player.ID).
+		Pluck("id", &ids)
+
+	return ids
+}
+
+func (db *notificationConnection) GetReceipts(playerId uint64, notificationIds []uint64) []entity.NotificationReceipt {
+	var receipts []entity.NotificationReceipt
+
+	if len(notificationIds) == 0 {
+		return receipts
+	}
+
+	db.connection.Where("player_id = ? AND notification_id IN ?", playerId, notificationIds).Find(&receipts)
+
+	return receipts
+}
+
+func (db *notificationConnection) InsertReceipts(receipts []entity.NotificationReceipt) error {
+	if len(receipts) == 0 {
+		return nil
+	}
+
+	result := db.connection.Clauses(clause.OnConflict{DoNothing: true}).Create(&receipts)
+
+	if result.Error != nil {
+		logger.Error(result.Error, helper.JsonSerialize(receipts))
+	}
+
+	return result.Error
+}
+
+func (db *notificationConnection) playerScope(player entity.Player) *gorm.DB {
+	return db.connection.Model(&entity.Notification{}).
+		Where("race_id = ? AND (player_id = 0 OR player_id = ?) AND (role = '' OR role = ?)", player.RaceID, player.ID, player.Role)
+}
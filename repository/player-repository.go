@@ -10,9 +10,11 @@ import (
 type PlayerRepository interface {
 	InsertPlayer(b *entity.Player) (error, entity.Player)
 	UpdatePlayer(b *entity.Player) (error, entity.Player)
+	UpdatePlayers(players []*entity.Player) error
 	UpdateCash(b *entity.Player, cash int)
 	AllByRaceId(raceId uint64) []entity.Player
 	AllActiveByRaceId(raceId uint64) []entity.Player
+	AllModeratorsByRaceId(raceId uint64) []entity.Player
 	DeletePlayer(b *entity.Player) error
 	IsCurrentPlayerOnTheRace(player entity.Player) bool
 	FindPlayerByRaceIdAndInfoDreamId(raceId uint64, dreamId int) entity.Player
@@ -21,6 +23,7 @@ type PlayerRepository interface {
 	FindPlayerByUsernameAndRaceId(raceId uint64, username string) entity.Player
 	FindPlayerByUserIdAndRaceId(raceId uint64, userId uint64) entity.Player
 	FindPlayerByPlayerIdAndRaceId(raceId uint64, playerId uint64) entity.Player
+	InsertTeamMembers(members []entity.TeamMember) error
 }
 
 const PlayerTable = "players"
@@ -56,6 +59,12 @@ func (db *playerConnection) AllActiveByRaceId(raceId uint64) []entity.Player {
 	return players
 }
 
+func (db *playerConnection) AllModeratorsByRaceId(raceId uint64) []entity.Player {
+	var players []entity.Player
+	db.connection.Where("race_id = ? AND role = 'moderator'", raceId).Find(&players)
+	return players
+}
+
 func (db *playerConnection) AllByRaceId(raceId uint64) []entity.Player {
 	var players []entity.Player
 	db.connection.Where("race_id = ? AND role != 'moderator'", raceId).Find(&players)
@@ -77,6 +86,24 @@ func (db *playerConnection) UpdatePlayer(b *entity.Player) (error, entity.Player
 	return nil, *b
 }
 
+func (db *playerConnection) UpdatePlayers(players []*entity.Player) error {
+	logger.Info("PlayerRepository.UpdatePlayers", len(players))
+
+	return db.connection.Transaction(func(tx *gorm.DB) error {
+		for _, player := range players {
+			result := tx.Select("*").Updates(player)
+
+			if result.Error != nil {
+				logger.Error(result.Error, helper.JsonSerialize(player))
+
+				return result.Error
+			}
+		}
+
+		return nil
+	})
+}
+
 func (db *playerConnection) UpdateCash(b *entity.Player, cash int) {
 	logger.Info("PlayerRepository.UpdateCash", map[string]interface{}{
 		"cash":     cash,
@@ -177,9 +204,36 @@ func (db *playerConnection) FindPlayerByUserIdAndRaceId(raceId uint64, userId ui
 
 	db.connection.Where("`user_id` = ? AND `race_id` = ?", userId, raceId).Find(&player)
 
+	if player.ID == 0 {
+		var member entity.TeamMember
+
+		db.connection.Where("`user_id` = ? AND `race_id` = ?", userId, raceId).Find(&member)
+
+		if member.PlayerID != 0 {
+			db.connection.Where("`id` = ? AND `race_id` = ?", member.PlayerID, raceId).Find(&player)
+		}
+	}
+
 	return player
 }
 
+// InsertTeamMembers links the members of a team to the player they share.
+func (db *playerConnection) InsertTeamMembers(members []entity.TeamMember) error {
+	logger.Info("PlayerRepository.InsertTeamMembers", len(members))
+
+	if len(members) == 0 {
+		return nil
+	}
+
+	result := db.connection.Create(&members)
+
+	if result.Error != nil {
+		logger.Error(result.Error, helper.JsonSerialize(members))
+	}
+
+	return result.Error
+}
+
 func (db *playerConnection) FindPlayerByPlayerIdAndRaceId(raceId uint64, playerId uint64) entity.Player {
 	logger.Info("PlayerRepository.FindPlayerByPlayerIdAndRaceId", map[string]interface{}{
 		"raceId":   raceId,
@@ -2,11 +2,10 @@ package repository
 
 import (
 	"encoding/json"
+	"github.com/webjohny/cashflow-go/apperror"
 	"github.com/webjohny/cashflow-go/dto"
 	"github.com/webjohny/cashflow-go/entity"
 	"github.com/webjohny/cashflow-go/helper"
-	"github.com/webjohny/cashflow-go/storage"
-	"gopkg.in/errgo.v2/errors"
 	"os"
 )
 
@@ -34,7 +33,7 @@ func (db *professionConnection) AllFromMemory(language string) (error, []entity.
 	professions := db.professions["default"][language]
 
 	if professions == nil {
-		return errors.New(storage.ErrorCardsNotFound), make([]entity.Profession, 0)
+		return apperror.ErrCardsNotFound, make([]entity.Profession, 0)
 	}
 
 	return nil, professions
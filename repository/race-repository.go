@@ -13,6 +13,7 @@ type RaceRepository interface {
 	All() []entity.Race
 	DeleteRace(b *entity.Race) error
 	FindRaceById(ID uint64) entity.Race
+	AllByStatus(status string) []entity.Race
 }
 
 const RaceTable = "races"
@@ -71,6 +72,14 @@ func (db *raceConnection) DeleteRace(b *entity.Race) error {
 	return nil
 }
 
+func (db *raceConnection) AllByStatus(status string) []entity.Race {
+	var races []entity.Race
+
+	db.connection.Where("status = ?", status).Order("id DESC").Find(&races)
+
+	return races
+}
+
 func (db *raceConnection) FindRaceById(ID uint64) entity.Race {
 	var race entity.Race
 
@@ -0,0 +1,87 @@
+package repository
+
+import (
+	logger "github.com/sirupsen/logrus"
+	"github.com/webjohny/cashflow-go/entity"
+	"github.com/webjohny/cashflow-go/helper"
+	"gorm.io/gorm"
+	"time"
+)
+
+type RaceTemplateRepository interface {
+	InsertRaceTemplate(b *entity.RaceTemplate) (error, entity.RaceTemplate)
+	UpdateRaceTemplate(b *entity.RaceTemplate) (error, entity.RaceTemplate)
+	DeleteRaceTemplate(b *entity.RaceTemplate) error
+	FindRaceTemplateByCode(code string) entity.RaceTemplate
+	AllByUserId(userId uint64) []entity.RaceTemplate
+}
+
+const RaceTemplatesTable = "race_templates"
+
+type raceTemplateConnection struct {
+	connection *gorm.DB
+}
+
+func NewRaceTemplateRepository(dbConn *gorm.DB) RaceTemplateRepository {
+	return &raceTemplateConnection{
+		connection: dbConn,
+	}
+}
+
+func (db *raceTemplateConnection) InsertRaceTemplate(b *entity.RaceTemplate) (error, entity.RaceTemplate) {
+	b.CreatedAt = time.Now()
+	b.UpdatedAt = time.Now()
+	result := db.connection.Save(&b)
+
+	if result.Error != nil {
+		logger.Error(result.Error, helper.JsonSerialize(b))
+
+		return result.Error, entity.RaceTemplate{}
+	}
+
+	db.connection.Find(&b)
+	return nil, *b
+}
+
+func (db *raceTemplateConnection) UpdateRaceTemplate(b *entity.RaceTemplate) (error, entity.RaceTemplate) {
+	b.UpdatedAt = time.Now()
+	result := db.connection.Select("*").Updates(&b)
+
+	if result.Error != nil {
+		logger.Error(result.Error, helper.JsonSerialize(b))
+
+		return result.Error, entity.RaceTemplate{}
+	}
+
+	db.connection.Find(&b)
+	return nil, *b
+}
+
+func (db *raceTemplateConnection) DeleteRaceTemplate(b *entity.RaceTemplate) error {
+	result := db.connection.Delete(&b)
+
+	if result.Error != nil {
+		logger.Error(result.Error, helper.JsonSerialize(b))
+	}
+
+	return result.Error
+}
+
+func (db *raceTemplateConnection) FindRaceTemplateByCode(code string) entity.RaceTemplate {
+	var template entity.RaceTemplate
+
+	db.connection.Where("code = ?", code).Find(&template)
+
+	return template
+}
+
+func (db *raceTemplateConnection) AllByUserId(userId uint64) []entity.RaceTemplate {
+	var templates []entity.RaceTemplate
+
+	db.connection.
+		Where("user_id = ?", userId).
+		Order("id DESC").
+		Find(&templates)
+
+	return templates
+}
@@ -0,0 +1,92 @@
+package repository
+
+import (
+	logger "github.com/sirupsen/logrus"
+	"github.com/webjohny/cashflow-go/entity"
+	"github.com/webjohny/cashflow-go/helper"
+	"gorm.io/gorm"
+	"time"
+)
+
+type SharedAssetRepository interface {
+	InsertSharedAsset(b *entity.SharedAsset) (error, entity.SharedAsset)
+	UpdateSharedAsset(b *entity.SharedAsset) (error, entity.SharedAsset)
+	FindSharedAssetById(ID uint64) entity.SharedAsset
+	FindActiveByAssetId(raceId uint64, assetId string) entity.SharedAsset
+	AllActiveByRaceId(raceId uint64) []entity.SharedAsset
+}
+
+const SharedAssetsTable = "shared_assets"
+
+type sharedAssetConnection struct {
+	connection *gorm.DB
+}
+
+func NewSharedAssetRepository(dbConn *gorm.DB) SharedAssetRepository {
+	return &sharedAssetConnection{
+		connection: dbConn,
+	}
+}
+
+func (db *sharedAssetConnection) InsertSharedAsset(b *entity.SharedAsset) (error, entity.SharedAsset) {
+	b.CreatedAt = time.Now()
+	b.UpdatedAt = time.Now()
+	result := db.connection.Save(&b)
+
+	if result.Error != nil {
+		logger.Error(result.Error, helper.JsonSerialize(b))
+
+		return result.Error, entity.SharedAsset{}
+	}
+
+	db.connection.Find(&b)
+	return nil, *b
+}
+
+func (db *sharedAssetConnection) UpdateSharedAsset(b *entity.SharedAsset) (error, entity.SharedAsset) {
+	b.UpdatedAt = time.Now()
+	result := db.connection.Select("*").Updates(&b)
+
+	if result.Error != nil {
+		logger.Error(result.Error, helper.JsonSerialize(b))
+
+		return result.Error, entity.SharedAsset{}
+	}
+
+	db.connection.Find(&b)
+	return nil, *b
+}
+
+func (db *sharedAssetConnection) FindSharedAssetById(ID uint64) entity.SharedAsset {
+	var asset entity.SharedAsset
+
+	db.connection.Find(&asset, ID)
+
+	return asset
+}
+
+func (db *sharedAssetConnection) FindActiveByAssetId(raceId uint64, assetId string) entity.SharedAsset {
+	var asset entity.SharedAsset
+
+	db.connection.
+		Where("race_id = ?", raceId).
+		Where("asset_id = ?", assetId).
+		Where("status = ?", entity.SharedAssetStatuses.Active).
+		Order("id DESC").
+		Limit(1).
+		Find(&asset)
+
+	return asset
+}
+
+func (db *sharedAssetConnection) AllActiveByRaceId(raceId uint64) []entity.SharedAsset {
+	var assets []entity.SharedAsset
+
+	db.connection.
+		Where("race_id = ?", raceId).
+		Where("status = ?", entity.SharedAssetStatuses.Active).
+		Order("id DESC").
+		Find(&assets)
+
+	return assets
+}
@@ -0,0 +1,74 @@
+package repository
+
+import (
+	logger "github.com/sirupsen/logrus"
+	"github.com/webjohny/cashflow-go/entity"
+	"github.com/webjohny/cashflow-go/helper"
+	"gorm.io/gorm"
+	"time"
+)
+
+type TeamDecisionRepository interface {
+	InsertTeamDecision(b *entity.TeamDecision) (error, entity.TeamDecision)
+	UpdateTeamDecision(b *entity.TeamDecision) (error, entity.TeamDecision)
+	FindTeamDecisionById(ID uint64, raceId uint64) entity.TeamDecision
+	AllByPlayerId(raceId uint64, playerId uint64) []entity.TeamDecision
+}
+
+const TeamDecisionsTable = "team_decisions"
+
+type teamDecisionConnection struct {
+	connection *gorm.DB
+}
+
+func NewTeamDecisionRepository(dbConn *gorm.DB) TeamDecisionRepository {
+	return &teamDecisionConnection{
+		connection: dbConn,
+	}
+}
+
+func (db *teamDecisionConnection) InsertTeamDecision(b *entity.TeamDecision) (error, entity.TeamDecision) {
+	b.CreatedAt = time.Now()
+	result := db.connection.Save(&b)
+
+	if result.Error != nil {
+		logger.Error(result.Error, helper.JsonSerialize(b))
+
+		return result.Error, entity.TeamDecision{}
+	}
+
+	db.connection.Find(&b)
+	return nil, *b
+}
+
+func (db *teamDecisionConnection) UpdateTeamDecision(b *entity.TeamDecision) (error, entity.TeamDecision) {
+	result := db.connection.Select("*").Updates(&b)
+
+	if result.Error != nil {
+		logger.Error(result.Error, helper.JsonSerialize(b))
+
+		return result.Error, entity.TeamDecision{}
+	}
+
+	db.connection.Find(&b)
+	return nil, *b
+}
+
+func (db *teamDecisionConnection) FindTeamDecisionById(ID uint64, raceId uint64) entity.TeamDecision {
+	var decision entity.TeamDecision
+
+	db.connection.Where("id = ? AND race_id = ?", ID, raceId).Find(&decision)
+
+	return decision
+}
+
+func (db *teamDecisionConnection) AllByPlayerId(raceId uint64, playerId uint64) []entity.TeamDecision {
+	var decisions []entity.TeamDecision
+
+	db.connection.
+		Where("race_id = ? AND player_id = ?", raceId, playerId).
+		Order("id DESC").
+		Find(&decisions)
+
+	return decisions
+}
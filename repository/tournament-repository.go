@@ -0,0 +1,168 @@
+package repository
+
+import (
+	logger "github.com/sirupsen/logrus"
+	"github.com/webjohny/cashflow-go/entity"
+	"github.com/webjohny/cashflow-go/helper"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"time"
+)
+
+type TournamentRepository interface {
+	InsertTournament(b *entity.Tournament) (error, entity.Tournament)
+	UpdateTournament(b *entity.Tournament) (error, entity.Tournament)
+	FindTournamentById(ID uint64) entity.Tournament
+	AllByStatuses(statuses ...string) []entity.Tournament
+	InsertTournamentTable(b *entity.TournamentTable) (error, entity.TournamentTable)
+	UpdateTournamentTable(b *entity.TournamentTable) (error, entity.TournamentTable)
+	FinishTournamentTable(b *entity.TournamentTable, apply func(tournament *entity.Tournament, tables []entity.TournamentTable)) (error, bool)
+	AllTablesByTournamentId(tournamentId uint64) []entity.TournamentTable
+}
+
+const TournamentsTable = "tournaments"
+
+type tournamentConnection struct {
+	connection *gorm.DB
+}
+
+func NewTournamentRepository(dbConn *gorm.DB) TournamentRepository {
+	return &tournamentConnection{
+		connection: dbConn,
+	}
+}
+
+func (db *tournamentConnection) InsertTournament(b *entity.Tournament) (error, entity.Tournament) {
+	b.CreatedAt = time.Now()
+	b.UpdatedAt = time.Now()
+	result := db.connection.Save(&b)
+
+	if result.Error != nil {
+		logger.Error(result.Error, helper.JsonSerialize(b))
+
+		return result.Error, entity.Tournament{}
+	}
+
+	db.connection.Find(&b)
+	return nil, *b
+}
+
+func (db *tournamentConnection) UpdateTournament(b *entity.Tournament) (error, entity.Tournament) {
+	b.UpdatedAt = time.Now()
+	result := db.connection.Select("*").Updates(&b)
+
+	if result.Error != nil {
+		logger.Error(result.Error, helper.JsonSerialize(b))
+
+		return result.Error, entity.Tournament{}
+	}
+
+	db.connection.Find(&b)
+	return nil, *b
+}
+
+func (db *tournamentConnection) FindTournamentById(ID uint64) entity.Tournament {
+	var tournament entity.Tournament
+
+	db.connection.Where("id = ?", ID).Find(&tournament)
+
+	return tournament
+}
+
+func (db *tournamentConnection) AllByStatuses(statuses ...string) []entity.Tournament {
+	var tournaments []entity.Tournament
+
+	db.connection.
+		Where("status IN ?", statuses).
+		Order("id DESC").
+		Find(&tournaments)
+
+	return tournaments
+}
+
+func (db *tournamentConnection) InsertTournamentTable(b *entity.TournamentTable) (error, entity.TournamentTable) {
+	b.CreatedAt = time.Now()
+	result := db.connection.Save(&b)
+
+	if result.Error != nil {
+		logger.Error(result.Error, helper.JsonSerialize(b))
+
+		return result.Error, entity.TournamentTable{}
+	}
+
+	db.connection.Find(&b)
+	return nil, *b
+}
+
+func (db *tournamentConnection) UpdateTournamentTable(b *entity.TournamentTable) (error, entity.TournamentTable) {
+	result := db.connection.Select("*").Updates(&b)
+
+	if result.Error != nil {
+		logger.Error(result.Error, helper.JsonSerialize(b))
+
+		return result.Error, entity.TournamentTable{}
+	}
+
+	db.connection.Find(&b)
+	return nil, *b
+}
+
+// FinishTournamentTable saves the results of the table unless another request has finished it first, in which
+// case false is returned. The tournament is locked while apply updates it with the tables of the tournament, so
+// concurrent requests can neither apply the results twice nor overwrite the results of each other.
+func (db *tournamentConnection) FinishTournamentTable(b *entity.TournamentTable, apply func(tournament *entity.Tournament, tables []entity.TournamentTable)) (error, bool) {
+	b.IsFinished = true
+	finished := false
+
+	err := db.connection.Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(b).
+			Where("is_finished = ?", false).
+			Select("RaceID", "Results", "IsFinished").
+			Updates(b)
+
+		if result.Error != nil || result.RowsAffected == 0 {
+			return result.Error
+		}
+
+		var tournament entity.Tournament
+		var tables []entity.TournamentTable
+
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("id = ?", b.TournamentID).Find(&tournament).Error; err != nil {
+			return err
+		}
+
+		if err := tx.Where("tournament_id = ?", b.TournamentID).Order("round ASC, number ASC").Find(&tables).Error; err != nil {
+			return err
+		}
+
+		apply(&tournament, tables)
+		tournament.UpdatedAt = time.Now()
+
+		if err := tx.Select("*").Updates(&tournament).Error; err != nil {
+			return err
+		}
+
+		finished = true
+
+		return nil
+	})
+
+	if err != nil {
+		logger.Error(err, helper.JsonSerialize(b))
+
+		return err, false
+	}
+
+	return nil, finished
+}
+
+func (db *tournamentConnection) AllTablesByTournamentId(tournamentId uint64) []entity.TournamentTable {
+	var tables []entity.TournamentTable
+
+	db.connection.
+		Where("tournament_id = ?", tournamentId).
+		Order("round ASC, number ASC").
+		Find(&tables)
+
+	return tables
+}
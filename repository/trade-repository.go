@@ -0,0 +1,77 @@
+package repository
+
+import (
+	logger "github.com/sirupsen/logrus"
+	"github.com/webjohny/cashflow-go/entity"
+	"github.com/webjohny/cashflow-go/helper"
+	"gorm.io/gorm"
+	"time"
+)
+
+type TradeRepository interface {
+	InsertTradeOffer(b *entity.TradeOffer) (error, entity.TradeOffer)
+	UpdateTradeOffer(b *entity.TradeOffer) (error, entity.TradeOffer)
+	FindTradeOfferById(ID uint64) entity.TradeOffer
+	AllByRaceIdAndPlayerId(raceId uint64, playerId uint64) []entity.TradeOffer
+}
+
+const TradeOffersTable = "trade_offers"
+
+type tradeConnection struct {
+	connection *gorm.DB
+}
+
+func NewTradeRepository(dbConn *gorm.DB) TradeRepository {
+	return &tradeConnection{
+		connection: dbConn,
+	}
+}
+
+func (db *tradeConnection) InsertTradeOffer(b *entity.TradeOffer) (error, entity.TradeOffer) {
+	b.CreatedAt = time.Now()
+	b.UpdatedAt = time.Now()
+	result := db.connection.Save(&b)
+
+	if result.Error != nil {
+		logger.Error(result.Error, helper.JsonSerialize(b))
+
+		return result.Error, entity.TradeOffer{}
+	}
+
+	db.connection.Find(&b)
+	return nil, *b
+}
+
+func (db *tradeConnection) UpdateTradeOffer(b *entity.TradeOffer) (error, entity.TradeOffer) {
+	b.UpdatedAt = time.Now()
+	result := db.connection.Select("*").Updates(&b)
+
+	if result.Error != nil {
+		logger.Error(result.Error, helper.JsonSerialize(b))
+
+		return result.Error, entity.TradeOffer{}
+	}
+
+	db.connection.Find(&b)
+	return nil, *b
+}
+
+func (db *tradeConnection) FindTradeOfferById(ID uint64) entity.TradeOffer {
+	var offer entity.TradeOffer
+
+	db.connection.Find(&offer, ID)
+
+	return offer
+}
+
+func (db *tradeConnection) AllByRaceIdAndPlayerId(raceId uint64, playerId uint64) []entity.TradeOffer {
+	var offers []entity.TradeOffer
+
+	db.connection.
+		Where("race_id = ?", raceId).
+		Where("sender_id = ? OR receiver_id = ?", playerId, playerId).
+		Order("id DESC").
+		Find(&offers)
+
+	return offers
+}
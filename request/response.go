@@ -3,6 +3,9 @@ package request
 import (
 	"fmt"
 	"github.com/gin-gonic/gin"
+	"github.com/webjohny/cashflow-go/apperror"
+	"github.com/webjohny/cashflow-go/helper"
+	"github.com/webjohny/cashflow-go/i18n"
 	"net/http"
 	"strings"
 )
@@ -10,7 +13,9 @@ import (
 type Response struct {
 	Status  bool        `json:"status"`
 	Message string      `json:"message"`
+	Code    string      `json:"code,omitempty"`
 	Errors  interface{} `json:"errors"`
+	Details interface{} `json:"details,omitempty"`
 	Data    interface{} `json:"data"`
 }
 
@@ -50,13 +55,26 @@ func BuildErrorResponse(message string, err string, data interface{}) Response {
 func FinalResponse(ctx *gin.Context, err error, response interface{}) {
 	if err != nil {
 		fmt.Println("REQUEST ERROR: ", err)
-		response := BuildErrorResponse("Failed to process request", err.Error(), EmptyObj{})
-		ctx.AbortWithStatusJSON(http.StatusBadRequest, response)
+		ErrorResponse(ctx, apperror.From(err))
 		return
 	}
 	ctx.JSON(http.StatusOK, SuccessResponse(response))
 }
 
+// ErrorResponse writes the error envelope: HTTP status and code of the domain error, localized message and details.
+func ErrorResponse(ctx *gin.Context, err *apperror.Error) {
+	message := i18n.Translate(helper.GetLanguage(ctx), err.Code, i18n.Params(err.Details))
+
+	if err.Code == apperror.CodeBadRequest && err.Details["error"] != nil {
+		message = fmt.Sprint(err.Details["error"])
+	}
+
+	response := BuildErrorResponse(message, message, EmptyObj{})
+	response.Code = err.Code
+	response.Details = err.Details
+	ctx.AbortWithStatusJSON(err.Status, response)
+}
+
 func TooManyRequests(ctx *gin.Context) {
-	ctx.JSON(http.StatusTooManyRequests, BuildResponse(false, "", nil))
+	ErrorResponse(ctx, apperror.ErrTooManyRequests)
 }
@@ -0,0 +1,34 @@
+package main
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/webjohny/cashflow-go/routes"
+)
+
+func registerV2Routes(r *gin.Engine) {
+	routes.RegisterV2(r, routes.Handlers{
+		JWTService:             jwtService,
+		IdempotencyService:     idempotencyService,
+		PlayerService:          playerService,
+		AuctionController:      auctionController,
+		AuthController:         authController,
+		CardController:         cardController,
+		ChatController:         chatController,
+		FinanceController:      financeController,
+		GameController:         gameController,
+		I18nController:         i18nController,
+		LobbyController:        lobbyController,
+		MarketController:       marketController,
+		ModeratorController:    moderatorController,
+		NotificationController: notificationController,
+		PlayerController:       playerController,
+		PlayerTestController:   playerTestController,
+		RaceTemplateController: raceTemplateController,
+		SharedAssetController:  sharedAssetController,
+		TeamController:         teamController,
+		TournamentController:   tournamentController,
+		TradeController:        tradeController,
+		UserController:         userController,
+		UserRequestController:  userRequestController,
+	})
+}
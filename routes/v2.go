@@ -0,0 +1,223 @@
+package routes
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/webjohny/cashflow-go/controller"
+	"github.com/webjohny/cashflow-go/middleware"
+	"github.com/webjohny/cashflow-go/service"
+)
+
+// Handlers are the controllers the v2 routes are bound to and the services their middlewares use.
+type Handlers struct {
+	JWTService             service.JWTService
+	IdempotencyService     service.IdempotencyService
+	PlayerService          service.PlayerService
+	AuctionController      controller.AuctionController
+	AuthController         controller.AuthController
+	CardController         controller.CardController
+	ChatController         controller.ChatController
+	FinanceController      controller.FinanceController
+	GameController         controller.GameController
+	I18nController         controller.I18nController
+	LobbyController        controller.LobbyController
+	MarketController       controller.MarketController
+	ModeratorController    controller.ModeratorController
+	NotificationController controller.NotificationController
+	PlayerController       controller.PlayerController
+	PlayerTestController   controller.PlayerTestController
+	RaceTemplateController controller.RaceTemplateController
+	SharedAssetController  controller.SharedAssetController
+	TeamController         controller.TeamController
+	TournamentController   controller.TournamentController
+	TradeController        controller.TradeController
+	UserController         controller.UserController
+	UserRequestController  controller.UserRequestController
+}
+
+// RegisterV2 registers the resource-oriented API: state changes are never GET, every race scoped
+// route takes the race from the path and POSTs may carry an Idempotency-Key.
+func RegisterV2(r *gin.Engine, h Handlers) {
+	v2 := r.Group("api/v2")
+
+	v2.GET("/i18n/:language", h.I18nController.Catalog)
+
+	authRoutes := v2.Group("auth")
+	{
+		authRoutes.POST("/login", h.AuthController.Login)
+		authRoutes.POST("/register", h.AuthController.Register)
+	}
+
+	userRoutes := v2.Group("users", middleware.AuthorizeJWT(h.JWTService))
+	{
+		userRoutes.GET("/me", h.UserController.Profile)
+		userRoutes.PUT("/me", h.UserController.Update)
+		userRoutes.GET("/me/paused-races", h.GameController.GetPausedRaces)
+	}
+
+	raceTemplateRoutes := v2.Group("race-templates", middleware.AuthorizeJWT(h.JWTService), middleware.Idempotency(h.IdempotencyService))
+	{
+		raceTemplateRoutes.GET("", h.RaceTemplateController.GetTemplates)
+		raceTemplateRoutes.POST("", h.RaceTemplateController.Create)
+		raceTemplateRoutes.GET("/:templateCode", h.RaceTemplateController.GetTemplate)
+		raceTemplateRoutes.PUT("/:templateCode", h.RaceTemplateController.Update)
+		raceTemplateRoutes.DELETE("/:templateCode", h.RaceTemplateController.Delete)
+	}
+
+	tournamentRoutes := v2.Group("tournaments", middleware.AuthorizeJWT(h.JWTService), middleware.Idempotency(h.IdempotencyService))
+	{
+		tournamentRoutes.GET("", h.TournamentController.GetTournaments)
+		tournamentRoutes.POST("", h.TournamentController.Create)
+		tournamentRoutes.GET("/:tournamentId", h.TournamentController.GetTournament)
+		tournamentRoutes.DELETE("/:tournamentId", h.TournamentController.Cancel)
+		tournamentRoutes.GET("/:tournamentId/standings", h.TournamentController.GetStandings)
+		tournamentRoutes.POST("/:tournamentId/participants", h.TournamentController.Join)
+		tournamentRoutes.DELETE("/:tournamentId/participants/me", h.TournamentController.Leave)
+		tournamentRoutes.POST("/:tournamentId/rounds", h.TournamentController.StartRound)
+		tournamentRoutes.POST("/:tournamentId/tables/:tableId/closure", h.TournamentController.CloseTable)
+	}
+
+	lobbyRoutes := v2.Group("lobbies", middleware.AuthorizeJWT(h.JWTService), middleware.Idempotency(h.IdempotencyService))
+	{
+		lobbyRoutes.POST("", h.LobbyController.Create)
+		lobbyRoutes.GET("/:lobbyId", h.LobbyController.GetLobby)
+		lobbyRoutes.DELETE("/:lobbyId", h.LobbyController.Cancel)
+		lobbyRoutes.PUT("/:lobbyId/options", h.LobbyController.SetOptions)
+		lobbyRoutes.PUT("/:lobbyId/options/all", h.LobbyController.ReplaceOptions)
+		lobbyRoutes.POST("/:lobbyId/template", h.LobbyController.ApplyTemplate)
+		lobbyRoutes.PUT("/:lobbyId/teams", h.LobbyController.SetTeams)
+		lobbyRoutes.POST("/:lobbyId/players", h.LobbyController.Join)
+		lobbyRoutes.DELETE("/:lobbyId/players/me", h.LobbyController.Leave)
+		lobbyRoutes.POST("/:lobbyId/spectators", h.LobbyController.Spectate)
+		lobbyRoutes.POST("/:lobbyId/races", h.GameController.Start)
+	}
+
+	raceRoutes := v2.Group("races/:raceId", middleware.AuthorizeJWT(h.JWTService), middleware.GetGameId(), middleware.Idempotency(h.IdempotencyService))
+	{
+		raceRoutes.GET("", h.GameController.GetGame)
+		raceRoutes.DELETE("", h.GameController.Cancel)
+		raceRoutes.GET("/view", h.GameController.Spectate)
+		raceRoutes.POST("/reset", h.GameController.Reset)
+		raceRoutes.POST("/pause", h.GameController.Pause)
+		raceRoutes.POST("/resume", h.GameController.Resume)
+		raceRoutes.POST("/dice", h.GameController.RollDice)
+		raceRoutes.POST("/turns", h.GameController.ChangeTurn)
+		raceRoutes.GET("/tiles", h.GameController.GetTiles)
+		raceRoutes.POST("/wait-list/promotions", h.GameController.PromoteWaitList)
+		raceRoutes.POST("/moderator", h.PlayerController.BecomeModerator)
+
+		raceRoutes.GET("/cards", h.CardController.Type)
+		raceRoutes.POST("/cards", h.CardController.SetCards)
+		raceRoutes.POST("/cards/:family/:type/prepare", h.CardController.Prepare)
+		raceRoutes.POST("/cards/:family/:type/skip", h.CardController.Skip)
+		raceRoutes.POST("/cards/:family/:type/sell", h.CardController.Selling)
+		raceRoutes.POST("/cards/:family/:type/buy", h.CardController.Purchase)
+		raceRoutes.POST("/cards/:family/:type/accept", h.CardController.Accept)
+		raceRoutes.POST("/transactions/reset", h.CardController.ResetTransaction)
+
+		raceRoutes.GET("/team-decisions", h.TeamController.GetDecisions)
+		raceRoutes.POST("/team-decisions/:decisionId/votes", h.TeamController.Vote)
+
+		raceRoutes.GET("/messages", h.ChatController.GetMessages)
+		raceRoutes.POST("/messages", h.ChatController.Send)
+		raceRoutes.DELETE("/messages/:messageId", h.ChatController.Delete)
+		raceRoutes.POST("/messages/:messageId/reactions", h.ChatController.React)
+
+		raceRoutes.GET("/trades", h.TradeController.GetOffers)
+		raceRoutes.POST("/trades", h.TradeController.Propose)
+		raceRoutes.DELETE("/trades/:offerId", h.TradeController.Cancel)
+		raceRoutes.POST("/trades/:offerId/counter-offers", h.TradeController.Counter)
+		raceRoutes.POST("/trades/:offerId/acceptance", h.TradeController.Accept)
+		raceRoutes.POST("/trades/:offerId/decline", h.TradeController.Decline)
+
+		raceRoutes.GET("/auction", h.AuctionController.GetAuction)
+		raceRoutes.DELETE("/auction", h.AuctionController.Close)
+		raceRoutes.POST("/auction/bids", h.AuctionController.Bid)
+
+		raceRoutes.GET("/market", h.MarketController.GetMarket)
+		raceRoutes.GET("/market/:symbol", h.MarketController.GetChart)
+
+		raceRoutes.GET("/shared-assets", h.SharedAssetController.GetSharedAssets)
+		raceRoutes.POST("/shared-assets/:sharedAssetId/buyouts", h.SharedAssetController.ProposeBuyout)
+		raceRoutes.POST("/shared-assets/:sharedAssetId/buyouts/:buyoutId/acceptance", h.SharedAssetController.AcceptBuyout)
+		raceRoutes.POST("/shared-assets/:sharedAssetId/buyouts/:buyoutId/decline", h.SharedAssetController.DeclineBuyout)
+	}
+
+	playerRoutes := raceRoutes.Group("players/:playerId", middleware.SelfPlayer(h.PlayerService))
+	{
+		playerRoutes.GET("", h.PlayerController.GetRacePlayer)
+		playerRoutes.GET("/data", h.PlayerController.GetPlayerData)
+		playerRoutes.PUT("/data", h.PlayerController.SetPlayerData)
+		playerRoutes.PUT("/dream", h.PlayerController.SetDream)
+		playerRoutes.POST("/big-race", h.PlayerController.MoveOnBigRace)
+		playerRoutes.GET("/big-race/progress", h.PlayerController.GetBigRaceProgress)
+		playerRoutes.DELETE("/notifications/:notificationId", h.PlayerController.IsReadNotification)
+		playerRoutes.GET("/notifications", h.NotificationController.GetNotifications)
+		playerRoutes.GET("/notifications/stream", h.NotificationController.Stream)
+		playerRoutes.POST("/notifications/receipts", h.NotificationController.MarkAllRead)
+		playerRoutes.POST("/notifications/:notificationId/receipt", h.NotificationController.MarkRead)
+		playerRoutes.GET("/portfolio", h.MarketController.GetPortfolio)
+		playerRoutes.GET("/balance-sheet", h.FinanceController.GetBalanceSheet)
+
+		playerRoutes.GET("/loans", h.FinanceController.GetLoans)
+		playerRoutes.POST("/loans", h.FinanceController.TakeLoan)
+		playerRoutes.POST("/loan-repayments", h.FinanceController.PayLoan)
+		playerRoutes.GET("/insurances", h.FinanceController.GetInsurances)
+		playerRoutes.POST("/insurances", h.FinanceController.BuyInsurance)
+		playerRoutes.DELETE("/insurances/:insuranceType", h.FinanceController.CancelInsurance)
+		playerRoutes.POST("/transfers", h.FinanceController.SendMoney)
+		playerRoutes.POST("/asset-transfers", h.FinanceController.SendAssets)
+		playerRoutes.POST("/money-requests", h.FinanceController.AskMoney)
+		playerRoutes.GET("/user-requests", h.UserRequestController.GetUserRequests)
+		playerRoutes.POST("/user-requests", h.UserRequestController.Create)
+		playerRoutes.DELETE("/user-requests/:userRequestId", h.UserRequestController.Cancel)
+	}
+
+	moderatorRoutes := raceRoutes.Group("moderator")
+	{
+		moderatorRoutes.GET("/race", h.ModeratorController.GetRace)
+		moderatorRoutes.PUT("/race", h.ModeratorController.UpdateRace)
+		moderatorRoutes.PUT("/status", h.ModeratorController.UpdateStatusRace)
+		moderatorRoutes.PUT("/big-race/conditions", h.ModeratorController.SetBigRaceConditions)
+		moderatorRoutes.GET("/players", h.ModeratorController.GetRacePlayers)
+		moderatorRoutes.PUT("/players/:playerId", h.ModeratorController.UpdatePlayer)
+		moderatorRoutes.GET("/users/:userId", h.ModeratorController.GetRacePlayer)
+		moderatorRoutes.POST("/transfers", h.ModeratorController.SendMoney)
+		moderatorRoutes.PUT("/user-requests", h.ModeratorController.HandleUserRequest)
+		moderatorRoutes.GET("/audit", h.ModeratorController.GetAudit)
+		moderatorRoutes.POST("/notifications", h.NotificationController.Send)
+		moderatorRoutes.GET("/decks", h.ModeratorController.GetDecks)
+		moderatorRoutes.GET("/decks/:deck/upcoming", h.ModeratorController.PeekCards)
+		moderatorRoutes.POST("/decks/:deck/deal", h.ModeratorController.DealCard)
+		moderatorRoutes.POST("/decks/:deck/shuffle", h.ModeratorController.ShuffleDeck)
+		moderatorRoutes.POST("/decks/:deck/removals", h.ModeratorController.RemoveCards)
+		moderatorRoutes.POST("/dice/confirmation", h.ModeratorController.ConfirmRoll)
+		moderatorRoutes.POST("/dice/rejection", h.ModeratorController.RejectRoll)
+	}
+
+	playerTestRoutes := v2.Group("test/player")
+	{
+		playerTestRoutes.POST("/extra-money", h.PlayerTestController.AddMoney)
+		playerTestRoutes.POST("/sell-stocks", h.PlayerTestController.SellStocks)
+		playerTestRoutes.POST("/sell-business", h.PlayerTestController.SellBusiness)
+		playerTestRoutes.POST("/sell-real-estate", h.PlayerTestController.SellRealEstate)
+		playerTestRoutes.POST("/sell-other-assets", h.PlayerTestController.SellOtherAssets)
+
+		playerTestRoutes.POST("/damage-real-estate", h.PlayerTestController.DamageRealEstate)
+		playerTestRoutes.POST("/increase-stocks", h.PlayerTestController.IncreaseStocks)
+		playerTestRoutes.POST("/decrease-stocks", h.PlayerTestController.DecreaseStocks)
+
+		playerTestRoutes.POST("/buy-stocks", h.PlayerTestController.BuyStocks)
+		playerTestRoutes.POST("/buy-other-assets", h.PlayerTestController.BuyOtherAssets)
+		playerTestRoutes.POST("/buy-real-estate", h.PlayerTestController.BuyRealEstate)
+		playerTestRoutes.POST("/buy-lottery", h.PlayerTestController.BuyLottery)
+		playerTestRoutes.POST("/buy-business", h.PlayerTestController.BuyBusiness)
+		playerTestRoutes.POST("/buy-partner-other-assets", h.PlayerTestController.BuyOtherAssetsInPartnership)
+		playerTestRoutes.POST("/buy-partner-real-estate", h.PlayerTestController.BuyRealEstateInPartnership)
+		playerTestRoutes.POST("/buy-partner-business", h.PlayerTestController.BuyBusinessInPartnership)
+
+		playerTestRoutes.POST("/buy-dream", h.PlayerTestController.BuyDream)
+		playerTestRoutes.POST("/buy-big-business", h.PlayerTestController.BuyBigBusiness)
+		playerTestRoutes.POST("/buy-risk-business", h.PlayerTestController.BuyRiskBusiness)
+		playerTestRoutes.POST("/buy-risk-stocks", h.PlayerTestController.BuyRiskStocks)
+	}
+}
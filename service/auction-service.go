@@ -0,0 +1,299 @@
+package service
+
+import (
+	logger "github.com/sirupsen/logrus"
+	"github.com/webjohny/cashflow-go/apperror"
+	"github.com/webjohny/cashflow-go/dto"
+	"github.com/webjohny/cashflow-go/entity"
+	"github.com/webjohny/cashflow-go/storage"
+)
+
+type AuctionService interface {
+	GetAuction(raceId uint64, userId uint64) (error, *entity.RaceAuction)
+	Bid(raceId uint64, userId uint64, body dto.AuctionBidBodyDTO) (error, *entity.RaceAuction)
+	Close(raceId uint64, userId uint64) (error, *entity.RaceAuction)
+}
+
+const AuctionDefaultDuration = 30
+
+type auctionService struct {
+	raceService   RaceService
+	playerService PlayerService
+}
+
+func NewAuctionService(raceService RaceService, playerService PlayerService) AuctionService {
+	return &auctionService{
+		raceService:   raceService,
+		playerService: playerService,
+	}
+}
+
+func (service *auctionService) GetAuction(raceId uint64, userId uint64) (error, *entity.RaceAuction) {
+	err, race, _ := service.raceService.GetRaceAndPlayer(raceId, userId)
+
+	if err != nil {
+		return err, nil
+	}
+
+	if race.Auction == nil {
+		return apperror.ErrUndefinedAuction, nil
+	}
+
+	if race.HasActiveAuction() && race.Auction.IsExpired() && !race.IsPaused() {
+		err = service.resolve(&race)
+	}
+
+	return err, race.Auction
+}
+
+func (service *auctionService) Bid(raceId uint64, userId uint64, body dto.AuctionBidBodyDTO) (error, *entity.RaceAuction) {
+	logger.Info("AuctionService.Bid", map[string]interface{}{
+		"raceId": raceId,
+		"userId": userId,
+		"body":   body,
+	})
+
+	err, race, player := service.raceService.GetActiveRaceAndPlayer(raceId, userId)
+
+	if err != nil {
+		return err, nil
+	}
+
+	if !race.HasActiveAuction() {
+		return apperror.ErrUndefinedAuction, nil
+	}
+
+	auction := race.Auction
+
+	if auction.IsExpired() {
+		err = service.resolve(&race)
+
+		if err != nil {
+			return err, nil
+		}
+
+		return apperror.ErrAuctionIsFinished, race.Auction
+	}
+
+	if player.ID == auction.Seller.ID {
+		return apperror.ErrPermissionDenied, nil
+	}
+
+	if body.Amount <= auction.HighestBid().Amount {
+		return apperror.ErrBidIsTooLow, nil
+	}
+
+	if auction.Card.Type == "stock" && body.Count <= 0 {
+		return apperror.ErrIncorrectCount, nil
+	}
+
+	if player.Cash < body.Amount+service.getCardCost(auction.Card, body.Count) {
+		return apperror.ErrNotEnoughMoney, nil
+	}
+
+	auction.AddBid(entity.RaceAuctionBid{
+		PlayerID: player.ID,
+		UserId:   player.UserID,
+		Username: player.Username,
+		Amount:   body.Amount,
+		Count:    body.Count,
+	})
+
+	err, _ = service.raceService.UpdateRace(&race)
+
+	return err, race.Auction
+}
+
+func (service *auctionService) Close(raceId uint64, userId uint64) (error, *entity.RaceAuction) {
+	logger.Info("AuctionService.Close", map[string]interface{}{
+		"raceId": raceId,
+		"userId": userId,
+	})
+
+	err, race, player := service.raceService.GetActiveRaceAndPlayer(raceId, userId)
+
+	if err != nil {
+		return err, nil
+	}
+
+	if !race.HasActiveAuction() {
+		return apperror.ErrUndefinedAuction, nil
+	}
+
+	if !race.Auction.IsExpired() && player.Role != entity.PlayerRoles.Moderator {
+		return apperror.ErrPermissionDenied, nil
+	}
+
+	err = service.resolve(&race)
+
+	return err, race.Auction
+}
+
+// resolve sells the card to the highest bidder able to pay the premium and buy the card, the premium is paid
+// first and refunded when the purchase fails, then the next bid is tried.
+func (service *auctionService) resolve(race *entity.Race) error {
+	auction := race.Auction
+	auction.Status = entity.RaceAuctionStatuses.Closed
+
+	for _, bid := range auction.SortedBids() {
+		err, winner := service.playerService.GetPlayerByPlayerIdAndRaceId(race.ID, bid.PlayerID)
+
+		if err == nil && winner.Cash < bid.Amount+service.getCardCost(auction.Card, bid.Count) {
+			err = apperror.ErrNotEnoughMoney
+		}
+
+		if err == nil {
+			err = service.payPremium(race.ID, auction, bid)
+		}
+
+		if err == nil {
+			err, winner = service.playerService.GetPlayerByPlayerIdAndRaceId(race.ID, bid.PlayerID)
+		}
+
+		if err == nil {
+			err = service.buy(auction.Card, winner, bid.Count)
+
+			if err != nil {
+				service.refundPremium(race.ID, auction, bid)
+			}
+		}
+
+		if err != nil {
+			logger.Warn("AuctionService.resolve: bid was skipped", map[string]interface{}{
+				"raceId":   race.ID,
+				"playerId": bid.PlayerID,
+				"error":    err.Error(),
+			})
+
+			continue
+		}
+
+		winnerBid := bid
+		auction.Winner = &winnerBid
+		auction.Status = entity.RaceAuctionStatuses.Sold
+
+		break
+	}
+
+	err, _ := service.raceService.UpdateRace(race)
+
+	return err
+}
+
+func (service *auctionService) buy(card entity.Card, player entity.Player, count int) error {
+	switch card.Type {
+	case "business":
+		return service.playerService.BuyBusiness(entity.CardBusiness{
+			ID:          card.ID,
+			Type:        card.Type,
+			AssetType:   card.AssetType,
+			Symbol:      card.Symbol,
+			Heading:     card.Heading,
+			Description: card.Description,
+			Rule:        card.Rule,
+			History:     card.History,
+			Percent:     card.Percent,
+			Cost:        card.Cost,
+			Limit:       card.Limit,
+			ExtraDices:  card.ExtraDices,
+			Mortgage:    card.Mortgage,
+			DownPayment: card.DownPayment,
+			CashFlow:    card.CashFlow,
+			IsOwner:     true,
+		}, player, count, true)
+
+	case "realEstate":
+		return service.playerService.BuyRealEstate(entity.CardRealEstate{
+			ID:          card.ID,
+			Type:        card.Type,
+			AssetType:   card.AssetType,
+			Symbol:      card.Symbol,
+			Heading:     card.Heading,
+			Description: card.Description,
+			Rule:        card.Rule,
+			Percent:     card.Percent,
+			Count:       card.Count,
+			Cost:        card.Cost,
+			CashFlow:    card.CashFlow,
+			Mortgage:    card.Mortgage,
+			DownPayment: card.DownPayment,
+			IsOwner:     true,
+		}, player)
+
+	case "stock":
+		cardStocks := entity.CardStocks{}
+		card.Count = count
+		cardStocks.Fill(card)
+
+		return service.playerService.BuyStocks(cardStocks, player, true)
+	}
+
+	return apperror.ErrInvalidTypeOfCard
+}
+
+func (service *auctionService) payPremium(raceId uint64, auction *entity.RaceAuction, bid entity.RaceAuctionBid) error {
+	return service.transferPremium(raceId, auction, bid.PlayerID, auction.Seller.ID, bid.Amount,
+		storage.TransactionAuctionPremiumPaid, storage.TransactionAuctionPremium)
+}
+
+// refundPremium gives the premium back to the bidder when the card could not be bought.
+func (service *auctionService) refundPremium(raceId uint64, auction *entity.RaceAuction, bid entity.RaceAuctionBid) {
+	err := service.transferPremium(raceId, auction, auction.Seller.ID, bid.PlayerID, bid.Amount,
+		storage.TransactionAuctionPremiumReturned, storage.TransactionAuctionPremiumRefunded)
+
+	if err != nil {
+		logger.Error("AuctionService.refundPremium", err, bid.PlayerID)
+	}
+}
+
+func (service *auctionService) transferPremium(raceId uint64, auction *entity.RaceAuction, senderId uint64, receiverId uint64, amount int, sentCode string, receivedCode string) error {
+	err, sender := service.playerService.GetPlayerByPlayerIdAndRaceId(raceId, senderId)
+
+	if err != nil {
+		return err
+	}
+
+	err, receiver := service.playerService.GetPlayerByPlayerIdAndRaceId(raceId, receiverId)
+
+	if err != nil {
+		return err
+	}
+
+	params := map[string]interface{}{
+		"amount": amount,
+		"card":   auction.Card.Heading,
+	}
+
+	err = service.playerService.UpdateCash(&sender, -amount, &dto.TransactionDTO{
+		CardID:   auction.Card.ID,
+		CardType: entity.TransactionCardType.Auction,
+		Code:     sentCode,
+		Params:   params,
+	})
+
+	if err != nil {
+		return err
+	}
+
+	return service.playerService.UpdateCash(&receiver, amount, &dto.TransactionDTO{
+		CardID:   auction.Card.ID,
+		CardType: entity.TransactionCardType.Auction,
+		Code:     receivedCode,
+		Params:   params,
+	})
+}
+
+func (service *auctionService) getCardCost(card entity.Card, count int) int {
+	switch card.Type {
+	case "stock":
+		return card.Price * count
+	case "business":
+		if card.DownPayment > 0 {
+			return card.DownPayment
+		}
+
+		return card.Cost
+	}
+
+	return card.DownPayment
+}
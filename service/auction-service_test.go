@@ -0,0 +1,208 @@
+package service
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/webjohny/cashflow-go/apperror"
+	"github.com/webjohny/cashflow-go/dto"
+	"github.com/webjohny/cashflow-go/entity"
+	"testing"
+	"time"
+)
+
+type auctionRaceService struct {
+	RaceService
+	race   entity.Race
+	player entity.Player
+}
+
+func (s *auctionRaceService) GetRaceAndPlayer(raceId uint64, userId uint64) (error, entity.Race, entity.Player) {
+	return nil, s.race, s.player
+}
+
+func (s *auctionRaceService) GetActiveRaceAndPlayer(raceId uint64, userId uint64) (error, entity.Race, entity.Player) {
+	return nil, s.race, s.player
+}
+
+func (s *auctionRaceService) UpdateRace(race *entity.Race) (error, entity.Race) {
+	s.race = *race
+
+	return nil, *race
+}
+
+type auctionPlayerService struct {
+	PlayerService
+	players map[uint64]*entity.Player
+	failing map[uint64]bool
+}
+
+func (s *auctionPlayerService) GetPlayerByPlayerIdAndRaceId(raceId uint64, playerId uint64) (error, entity.Player) {
+	player, ok := s.players[playerId]
+
+	if !ok {
+		return apperror.ErrUndefinedPlayer, entity.Player{}
+	}
+
+	return nil, *player
+}
+
+func (s *auctionPlayerService) UpdateCash(player *entity.Player, amount int, data *dto.TransactionDTO) error {
+	player.Cash += amount
+	s.players[player.ID].Cash += amount
+
+	return nil
+}
+
+func (s *auctionPlayerService) BuyRealEstate(card entity.CardRealEstate, player entity.Player) error {
+	if s.failing[player.ID] || player.Cash < card.DownPayment {
+		return apperror.ErrNotEnoughMoney
+	}
+
+	s.players[player.ID].Cash -= card.DownPayment
+	s.players[player.ID].Assets.RealEstates = append(s.players[player.ID].Assets.RealEstates, card)
+
+	return nil
+}
+
+func newAuctionService(bids []entity.RaceAuctionBid, failing map[uint64]bool) (*auctionService, *auctionRaceService, *auctionPlayerService) {
+	players := &auctionPlayerService{
+		players: map[uint64]*entity.Player{
+			1: {ID: 1, Cash: 0},
+			2: {ID: 2, Cash: 5000},
+			3: {ID: 3, Cash: 5000},
+			4: {ID: 4, Cash: 1500},
+		},
+		failing: failing,
+	}
+	races := &auctionRaceService{
+		race: entity.Race{
+			ID: 1,
+			Auction: &entity.RaceAuction{
+				Card:   entity.Card{ID: "card", Type: "realEstate", DownPayment: 1000},
+				Seller: entity.RacePlayer{ID: 1},
+				Bids:   bids,
+				Status: entity.RaceAuctionStatuses.Active,
+				EndsAt: time.Now().Add(-time.Second),
+			},
+		},
+		player: entity.Player{ID: 2, Role: entity.PlayerRoles.Guest},
+	}
+
+	return &auctionService{raceService: races, playerService: players}, races, players
+}
+
+func TestAuctionServiceResolve(t *testing.T) {
+	tests := []struct {
+		name    string
+		bids    []entity.RaceAuctionBid
+		failing map[uint64]bool
+		winner  uint64
+		status  string
+		cash    map[uint64]int
+	}{
+		{
+			name:   "the highest bid wins",
+			bids:   []entity.RaceAuctionBid{{PlayerID: 2, Amount: 500}, {PlayerID: 3, Amount: 800}},
+			winner: 3,
+			status: entity.RaceAuctionStatuses.Sold,
+			cash:   map[uint64]int{1: 800, 2: 5000, 3: 3200, 4: 1500},
+		},
+		{
+			name:   "a bidder who cannot pay the premium and the card is skipped",
+			bids:   []entity.RaceAuctionBid{{PlayerID: 4, Amount: 800}, {PlayerID: 2, Amount: 500}},
+			winner: 2,
+			status: entity.RaceAuctionStatuses.Sold,
+			cash:   map[uint64]int{1: 500, 2: 3500, 3: 5000, 4: 1500},
+		},
+		{
+			name:    "the premium is refunded when the purchase fails",
+			bids:    []entity.RaceAuctionBid{{PlayerID: 3, Amount: 800}, {PlayerID: 2, Amount: 500}},
+			failing: map[uint64]bool{3: true},
+			winner:  2,
+			status:  entity.RaceAuctionStatuses.Sold,
+			cash:    map[uint64]int{1: 500, 2: 3500, 3: 5000, 4: 1500},
+		},
+		{
+			name:   "a bidder who left the race is skipped",
+			bids:   []entity.RaceAuctionBid{{PlayerID: 9, Amount: 900}, {PlayerID: 2, Amount: 500}},
+			winner: 2,
+			status: entity.RaceAuctionStatuses.Sold,
+			cash:   map[uint64]int{1: 500, 2: 3500, 3: 5000, 4: 1500},
+		},
+		{
+			name:   "nobody can afford the card",
+			bids:   []entity.RaceAuctionBid{{PlayerID: 4, Amount: 600}},
+			status: entity.RaceAuctionStatuses.Closed,
+			cash:   map[uint64]int{1: 0, 2: 5000, 3: 5000, 4: 1500},
+		},
+		{
+			name:   "no bids",
+			status: entity.RaceAuctionStatuses.Closed,
+			cash:   map[uint64]int{1: 0, 2: 5000, 3: 5000, 4: 1500},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			service, races, players := newAuctionService(test.bids, test.failing)
+
+			err := service.resolve(&races.race)
+
+			assert.NoError(t, err)
+			assert.Equal(t, test.status, races.race.Auction.Status)
+
+			if test.winner == 0 {
+				assert.Nil(t, races.race.Auction.Winner)
+			} else {
+				assert.Equal(t, test.winner, races.race.Auction.Winner.PlayerID)
+				assert.Len(t, players.players[test.winner].Assets.RealEstates, 1)
+			}
+
+			for ID, cash := range test.cash {
+				assert.Equal(t, cash, players.players[ID].Cash, "cash of player %d", ID)
+			}
+		})
+	}
+}
+
+func TestAuctionServiceClose(t *testing.T) {
+	tests := []struct {
+		name    string
+		expired bool
+		role    string
+		status  string
+		err     error
+	}{
+		{name: "an expired auction by a player", expired: true, role: entity.PlayerRoles.Guest, status: entity.RaceAuctionStatuses.Sold},
+		{name: "a running auction by a moderator", expired: false, role: entity.PlayerRoles.Moderator, status: entity.RaceAuctionStatuses.Sold},
+		{name: "a running auction by a player", expired: false, role: entity.PlayerRoles.Guest, status: entity.RaceAuctionStatuses.Active, err: apperror.ErrPermissionDenied},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			service, races, _ := newAuctionService([]entity.RaceAuctionBid{{PlayerID: 3, Amount: 800}}, nil)
+			races.player.Role = test.role
+
+			if !test.expired {
+				races.race.Auction.EndsAt = time.Now().Add(time.Minute)
+			}
+
+			err, auction := service.Close(1, 2)
+
+			assert.Equal(t, test.err, err)
+			assert.Equal(t, test.status, races.race.Auction.Status)
+
+			if test.err == nil {
+				assert.Equal(t, test.status, auction.Status)
+			}
+		})
+	}
+
+	t.Run("no active auction", func(t *testing.T) {
+		service, races, _ := newAuctionService(nil, nil)
+		races.race.Auction.Status = entity.RaceAuctionStatuses.Closed
+
+		err, _ := service.Close(1, 2)
+
+		assert.Equal(t, apperror.ErrUndefinedAuction, err)
+	})
+}
@@ -1,18 +1,18 @@
 package service
 
 import (
-	"errors"
 	logger "github.com/sirupsen/logrus"
+	"github.com/webjohny/cashflow-go/apperror"
 	"github.com/webjohny/cashflow-go/dto"
 	"github.com/webjohny/cashflow-go/entity"
 	"github.com/webjohny/cashflow-go/helper"
-	"github.com/webjohny/cashflow-go/storage"
 	"log"
 	"strconv"
 )
 
 type CardService interface {
 	SetCards(body dto.CreateCardsDTO)
+	GetCollections() map[string][]string
 	Prepare(actionType string, raceId uint64, family string, userId uint64, data dto.PrepareCardBodyDTO) (error, interface{})
 	Accept(actionType string, raceId uint64, family string, userId uint64, isBigRace bool) (error, interface{})
 	Purchase(actionType string, raceId uint64, userId uint64, isBigRace bool, dto dto.CardPurchaseActionDTO) (error, interface{})
@@ -21,7 +21,12 @@ type CardService interface {
 	GetCard(action string, raceId uint64, userId uint64, cardType string) (error, entity.Card)
 	TestCard(action string, raceId uint64, userId uint64, isBigRace bool) (error, entity.Card)
 	CheckPayDay(player entity.Player) int
-	ProcessCard(race entity.Race) error
+	ProcessCard(race *entity.Race) error
+	GetDecks(raceId uint64, userId uint64) (error, map[string]dto.CardDeckDTO)
+	PeekCards(raceId uint64, userId uint64, deck string, count int) (error, []dto.UpcomingCardDTO)
+	DealCard(raceId uint64, userId uint64, deck string, index int) (error, entity.Race)
+	ShuffleDeck(raceId uint64, userId uint64, deck string) (error, entity.Race)
+	RemoveCards(raceId uint64, userId uint64, deck string, cards []int) (error, entity.Race)
 }
 
 type CardRatRace struct {
@@ -131,7 +136,7 @@ func (service *cardService) TestCard(action string, raceId uint64, userId uint64
 		race.IsMultiFlow = false
 	}
 
-	err = service.ProcessCard(race)
+	err = service.ProcessCard(&race)
 
 	if err == nil {
 		err, _ = service.raceService.UpdateRace(&race)
@@ -196,7 +201,7 @@ func (service *cardService) Prepare(actionType string, raceId uint64, family str
 	//if actionType == "risk" || actionType == "riskStock" {
 	//	err = service.raceService.PreRiskAction(raceId, username, actionType)
 	//}
-	return errors.New(storage.ErrorForbidden), nil
+	return apperror.ErrForbidden, nil
 }
 
 func (service *cardService) Accept(actionType string, raceId uint64, family string, userId uint64, isBigRace bool) (error, interface{}) {
@@ -307,28 +312,28 @@ func (service *cardService) Selling(actionType string, raceId uint64, userId uin
 	switch actionType {
 	case "realEstate":
 		if dto.ID == "" {
-			return errors.New(storage.ErrorIsNotValidRealEstate), nil
+			return apperror.ErrIsNotValidRealEstate, nil
 		}
 
 		err = service.raceService.SellRealEstateAction(raceId, userId, dto.ID)
 		break
 	case "business":
 		if dto.ID == "" {
-			return errors.New(storage.ErrorIsNotValidBusiness), nil
+			return apperror.ErrIsNotValidBusiness, nil
 		}
 
 		err = service.raceService.SellBusinessAction(raceId, userId, dto.ID, dto.Count)
 		break
 	case "stock":
 		if dto.Count < 1 {
-			return errors.New(storage.ErrorIsNotValidCountValue), nil
+			return apperror.ErrIsNotValidCountValue, nil
 		}
 
 		err = service.raceService.SellStocksAction(raceId, userId, dto.Count)
 		break
 	case "other":
 		if dto.ID == "" {
-			return errors.New(storage.ErrorIsNotValidOtherAssets), nil
+			return apperror.ErrIsNotValidOtherAssets, nil
 		}
 
 		err = service.raceService.SellOtherAssetsAction(raceId, userId, dto.ID, dto.Count)
@@ -348,7 +353,7 @@ func (service *cardService) GetCard(action string, raceId uint64, userId uint64,
 		"action": action,
 	})
 
-	err, race, player := service.raceService.GetRaceAndPlayer(raceId, userId)
+	err, race, player := service.raceService.GetActiveRaceAndPlayer(raceId, userId)
 
 	if err != nil {
 		return err, entity.Card{}
@@ -362,7 +367,7 @@ func (service *cardService) GetCard(action string, raceId uint64, userId uint64,
 		tile = service.getRatCardType(int(player.CurrentPosition))
 
 		if action == "big" && player.Cash < 10000 {
-			return errors.New(storage.ErrorCannotTakeBigDeals), entity.Card{}
+			return apperror.ErrCannotTakeBigDeals, entity.Card{}
 		}
 
 		if action != "" && (action == "small" || action == "big") {
@@ -434,7 +439,7 @@ func (service *cardService) GetCard(action string, raceId uint64, userId uint64,
 
 	race.CurrentCard = card
 
-	err = service.ProcessCard(race)
+	err = service.ProcessCard(&race)
 
 	logger.Info("CardService.GetCard", map[string]interface{}{
 		"raceId":   raceId,
@@ -456,9 +461,26 @@ func (service *cardService) SetCards(body dto.CreateCardsDTO) {
 	service.cards[body.Type][body.Language] = body.Cards
 }
 
-func (service *cardService) ProcessCard(race entity.Race) error {
+// GetCollections returns the loaded card collections with the languages each of them is available in.
+func (service *cardService) GetCollections() map[string][]string {
+	collections := make(map[string][]string)
+
+	for collection, languages := range service.cards {
+		collections[collection] = make([]string, 0)
+
+		for language := range languages {
+			collections[collection] = append(collections[collection], language)
+		}
+	}
+
+	return collections
+}
+
+func (service *cardService) ProcessCard(race *entity.Race) error {
 	card := race.CurrentCard
 
+	race.Market.Observe(card)
+
 	if card.Name == "smallDeal" {
 		if card.AssetType == entity.StockTypes.Manipulation {
 			players := service.playerService.GetAllPlayersByRaceId(race.ID)
@@ -500,13 +522,13 @@ func (service *cardService) getCards(race entity.Race) (error, map[string][]enti
 	logger.Info("CardService.getCards", race.Options.CardCollection, race.Options.Language)
 
 	if race.Options.CardCollection == "" {
-		race.Options.CardCollection = "default"
+		race.Options.CardCollection = DefaultCardCollection
 	}
 
 	cards := service.cards[race.Options.CardCollection][race.Options.Language]
 
 	if cards == nil {
-		return errors.New(storage.ErrorCardsNotFound), make(map[string][]entity.Card)
+		return apperror.ErrCardsNotFound, make(map[string][]entity.Card)
 	}
 
 	return nil, cards
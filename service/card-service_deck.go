@@ -0,0 +1,168 @@
+package service
+
+import (
+	logger "github.com/sirupsen/logrus"
+	"github.com/webjohny/cashflow-go/apperror"
+	"github.com/webjohny/cashflow-go/dto"
+	"github.com/webjohny/cashflow-go/entity"
+)
+
+// GetDecks returns the active card collection of the race with the order of every deck.
+func (service *cardService) GetDecks(raceId uint64, userId uint64) (error, map[string]dto.CardDeckDTO) {
+	err, race, cardList := service.getManagedDecks(raceId, userId)
+
+	if err != nil {
+		return err, nil
+	}
+
+	decks := make(map[string]dto.CardDeckDTO)
+
+	for deck, cards := range cardList {
+		decks[deck] = dto.CardDeckDTO{
+			Cards:  cards,
+			Order:  race.CardMap.Map[deck],
+			Active: race.CardMap.Active[deck],
+		}
+	}
+
+	return nil, decks
+}
+
+// PeekCards returns the next count cards of the deck without drawing them.
+func (service *cardService) PeekCards(raceId uint64, userId uint64, deck string, count int) (error, []dto.UpcomingCardDTO) {
+	err, race, cardList := service.getManagedDecks(raceId, userId)
+
+	if err != nil {
+		return err, nil
+	}
+
+	if _, ok := cardList[deck]; !ok {
+		return apperror.ErrUndefinedDeck, nil
+	}
+
+	cards := make([]dto.UpcomingCardDTO, 0)
+
+	for _, index := range race.CardMap.Upcoming(deck, count) {
+		cards = append(cards, dto.UpcomingCardDTO{
+			Index: index,
+			Card:  cardList[deck][index],
+		})
+	}
+
+	return nil, cards
+}
+
+// DealCard gives the card of the deck to the current player instead of the drawn one.
+func (service *cardService) DealCard(raceId uint64, userId uint64, deck string, index int) (error, entity.Race) {
+	logger.Info("CardService.DealCard", map[string]interface{}{
+		"raceId": raceId,
+		"userId": userId,
+		"deck":   deck,
+		"index":  index,
+	})
+
+	err, race, cardList := service.getManagedDecks(raceId, userId)
+
+	if err != nil {
+		return err, entity.Race{}
+	}
+
+	if index < 0 || index >= len(cardList[deck]) {
+		return apperror.ErrInvalidCard, entity.Race{}
+	}
+
+	card := service.getCardByTile(deck, index, cardList)
+
+	if card.Name == "" {
+		return apperror.ErrUndefinedDeck, entity.Race{}
+	}
+
+	race.CurrentCard = card
+
+	if card.Family == "market" || card.Type == "stock" {
+		race.IsMultiFlow = card.OnlyYou == false
+	} else {
+		race.IsMultiFlow = false
+	}
+
+	err = service.ProcessCard(&race)
+
+	if err != nil {
+		return err, entity.Race{}
+	}
+
+	return service.raceService.UpdateRace(&race)
+}
+
+func (service *cardService) ShuffleDeck(raceId uint64, userId uint64, deck string) (error, entity.Race) {
+	logger.Info("CardService.ShuffleDeck", map[string]interface{}{
+		"raceId": raceId,
+		"userId": userId,
+		"deck":   deck,
+	})
+
+	err, race, cardList := service.getManagedDecks(raceId, userId)
+
+	if err != nil {
+		return err, entity.Race{}
+	}
+
+	if _, ok := cardList[deck]; !ok {
+		return apperror.ErrUndefinedDeck, entity.Race{}
+	}
+
+	race.CardMap.Shuffle(deck)
+
+	return service.raceService.UpdateRace(&race)
+}
+
+// RemoveCards takes the cards out of play for the rest of the race.
+func (service *cardService) RemoveCards(raceId uint64, userId uint64, deck string, cards []int) (error, entity.Race) {
+	logger.Info("CardService.RemoveCards", map[string]interface{}{
+		"raceId": raceId,
+		"userId": userId,
+		"deck":   deck,
+		"cards":  cards,
+	})
+
+	err, race, cardList := service.getManagedDecks(raceId, userId)
+
+	if err != nil {
+		return err, entity.Race{}
+	}
+
+	if _, ok := cardList[deck]; !ok {
+		return apperror.ErrUndefinedDeck, entity.Race{}
+	}
+
+	if !race.CardMap.Remove(deck, cards) {
+		return apperror.ErrDeckCannotBeEmpty, entity.Race{}
+	}
+
+	return service.raceService.UpdateRace(&race)
+}
+
+func (service *cardService) getManagedDecks(raceId uint64, userId uint64) (error, entity.Race, map[string][]entity.Card) {
+	err, race := service.gameService.GetManagedRace(raceId, userId)
+
+	if err != nil {
+		return err, entity.Race{}, nil
+	}
+
+	// In hand mode the moderator mirrors the physical deck by dealing the drawn card from the catalog.
+	if !race.Options.EnableManager && !race.Options.HandMode {
+		return apperror.ErrManagerModeDisabled, entity.Race{}, nil
+	}
+
+	err, cardList := service.getCards(race)
+
+	if err != nil {
+		return err, entity.Race{}, nil
+	}
+
+	if !race.CardMap.HasMapping() {
+		race.CardMap.SetMap(cardList)
+	}
+
+	return nil, race, cardList
+}
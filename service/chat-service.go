@@ -0,0 +1,204 @@
+package service
+
+import (
+	logger "github.com/sirupsen/logrus"
+	"github.com/webjohny/cashflow-go/apperror"
+	"github.com/webjohny/cashflow-go/dto"
+	"github.com/webjohny/cashflow-go/entity"
+	"github.com/webjohny/cashflow-go/repository"
+	"strings"
+	"unicode/utf8"
+)
+
+type ChatService interface {
+	GetMessages(raceId uint64, userId uint64, cursor uint64, limit int) (error, dto.GetChatMessagesResponseDTO)
+	Send(raceId uint64, userId uint64, message string) (error, entity.ChatMessage)
+	React(raceId uint64, userId uint64, messageId uint64, emoji string) (error, entity.ChatMessage)
+	Delete(raceId uint64, userId uint64, messageId uint64) error
+	SendSystemMessage(raceId uint64, message string, params map[string]interface{}) error
+}
+
+const (
+	ChatMessageMaxLength = 500
+	ChatReactionMaxSize  = 16
+	ChatPageLimit        = 50
+	ChatPageMaxLimit     = 100
+)
+
+type chatService struct {
+	chatRepository   repository.ChatRepository
+	playerRepository repository.PlayerRepository
+}
+
+func NewChatService(chatRepository repository.ChatRepository, playerRepository repository.PlayerRepository) ChatService {
+	return &chatService{
+		chatRepository:   chatRepository,
+		playerRepository: playerRepository,
+	}
+}
+
+func (service *chatService) GetMessages(raceId uint64, userId uint64, cursor uint64, limit int) (error, dto.GetChatMessagesResponseDTO) {
+	logger.Info("ChatService.GetMessages", map[string]interface{}{
+		"raceId": raceId,
+		"userId": userId,
+		"cursor": cursor,
+		"limit":  limit,
+	})
+
+	err, _ := service.getPlayer(raceId, userId)
+
+	if err != nil {
+		return err, dto.GetChatMessagesResponseDTO{}
+	}
+
+	if limit <= 0 {
+		limit = ChatPageLimit
+	} else if limit > ChatPageMaxLimit {
+		limit = ChatPageMaxLimit
+	}
+
+	messages := service.chatRepository.GetRaceMessages(raceId, cursor, limit+1)
+
+	response := dto.GetChatMessagesResponseDTO{
+		Messages: make([]entity.ChatMessage, 0),
+	}
+
+	if len(messages) > limit {
+		messages = messages[:limit]
+		response.HasMore = true
+	}
+
+	if len(messages) > 0 {
+		response.Messages = messages
+		response.NextCursor = messages[len(messages)-1].ID
+	}
+
+	return nil, response
+}
+
+func (service *chatService) Send(raceId uint64, userId uint64, message string) (error, entity.ChatMessage) {
+	logger.Info("ChatService.Send", map[string]interface{}{
+		"raceId": raceId,
+		"userId": userId,
+	})
+
+	err, player := service.getPlayer(raceId, userId)
+
+	if err != nil {
+		return err, entity.ChatMessage{}
+	}
+
+	message = strings.TrimSpace(message)
+
+	if message == "" {
+		return apperror.ErrEmptyMessage, entity.ChatMessage{}
+	}
+
+	if utf8.RuneCountInString(message) > ChatMessageMaxLength {
+		return apperror.ErrMessageIsTooLong, entity.ChatMessage{}
+	}
+
+	return service.chatRepository.InsertMessage(&entity.ChatMessage{
+		RaceID:    raceId,
+		PlayerID:  player.ID,
+		UserID:    player.UserID,
+		Username:  player.Username,
+		Color:     player.Color,
+		Type:      entity.ChatMessageTypes.Message,
+		Message:   message,
+		Reactions: make(map[string][]uint64),
+	})
+}
+
+func (service *chatService) React(raceId uint64, userId uint64, messageId uint64, emoji string) (error, entity.ChatMessage) {
+	logger.Info("ChatService.React", map[string]interface{}{
+		"raceId":    raceId,
+		"userId":    userId,
+		"messageId": messageId,
+		"emoji":     emoji,
+	})
+
+	err, _ := service.getPlayer(raceId, userId)
+
+	if err != nil {
+		return err, entity.ChatMessage{}
+	}
+
+	emoji = strings.TrimSpace(emoji)
+
+	if emoji == "" || len(emoji) > ChatReactionMaxSize {
+		return apperror.ErrIncorrectReaction, entity.ChatMessage{}
+	}
+
+	message := service.chatRepository.FindMessageById(messageId)
+
+	if message.ID == 0 || message.RaceID != raceId || message.IsDeleted {
+		return apperror.ErrUndefinedMessage, entity.ChatMessage{}
+	}
+
+	message.ToggleReaction(emoji, userId)
+
+	return service.chatRepository.UpdateMessage(&message)
+}
+
+func (service *chatService) Delete(raceId uint64, userId uint64, messageId uint64) error {
+	logger.Info("ChatService.Delete", map[string]interface{}{
+		"raceId":    raceId,
+		"userId":    userId,
+		"messageId": messageId,
+	})
+
+	err, player := service.getPlayer(raceId, userId)
+
+	if err != nil {
+		return err
+	}
+
+	if player.Role != entity.PlayerRoles.Moderator {
+		return apperror.ErrPermissionDenied
+	}
+
+	message := service.chatRepository.FindMessageById(messageId)
+
+	if message.ID == 0 || message.RaceID != raceId {
+		return apperror.ErrUndefinedMessage
+	}
+
+	message.Delete(userId)
+
+	err, _ = service.chatRepository.UpdateMessage(&message)
+
+	return err
+}
+
+func (service *chatService) SendSystemMessage(raceId uint64, message string, params map[string]interface{}) error {
+	logger.Info("ChatService.SendSystemMessage", map[string]interface{}{
+		"raceId":  raceId,
+		"message": message,
+		"params":  params,
+	})
+
+	err, _ := service.chatRepository.InsertMessage(&entity.ChatMessage{
+		RaceID:    raceId,
+		Type:      entity.ChatMessageTypes.System,
+		Message:   message,
+		Params:    params,
+		Reactions: make(map[string][]uint64),
+	})
+
+	if err != nil {
+		logger.Error(err)
+	}
+
+	return err
+}
+
+func (service *chatService) getPlayer(raceId uint64, userId uint64) (error, entity.Player) {
+	player := service.playerRepository.FindPlayerByUserIdAndRaceId(raceId, userId)
+
+	if player.ID == 0 {
+		return apperror.ErrUndefinedPlayer, entity.Player{}
+	}
+
+	return nil, player
+}
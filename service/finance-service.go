@@ -1,39 +1,43 @@
 package service
 
 import (
-	"errors"
-	"fmt"
 	logger "github.com/sirupsen/logrus"
+	"github.com/webjohny/cashflow-go/apperror"
 	"github.com/webjohny/cashflow-go/dto"
 	"github.com/webjohny/cashflow-go/entity"
 	"github.com/webjohny/cashflow-go/helper"
-	"github.com/webjohny/cashflow-go/repository"
 	"github.com/webjohny/cashflow-go/storage"
 	"strconv"
+	"time"
 )
 
 type FinanceService interface {
-	SendMoney(raceId uint64, userId uint64, amount int, player string) error
-	SendAssets(raceId uint64, userId uint64, dto dto.SendAssetsBodyDTO) error
-	PayLoan(raceId uint64, userId uint64, amount int) error
+	SendMoney(raceId uint64, userId uint64, amount int, player string, idempotencyKey string) error
+	SendAssets(raceId uint64, userId uint64, dto dto.SendAssetsBodyDTO, idempotencyKey string) error
+	PayLoan(raceId uint64, userId uint64, liability string, amount int) error
 	PayTax(raceId uint64, userId uint64, amount int) error
 	TakeLoan(raceId uint64, userId uint64, amount int) error
+	GetLoans(raceId uint64, userId uint64) (error, dto.PlayerLoansResponseDTO)
+	GetBalanceSheet(raceId uint64, userId uint64) (error, dto.BalanceSheetResponseDTO)
+	GetInsurances(raceId uint64, userId uint64) (error, dto.PlayerInsurancesResponseDTO)
+	BuyInsurance(raceId uint64, userId uint64, insuranceType string) error
+	CancelInsurance(raceId uint64, userId uint64, insuranceType string) error
 	AskMoney(raceId uint64, userId uint64, dto dto.AskMoneyBodyDto) (error, bool)
 }
 
 type financeService struct {
-	userRequestRepository repository.UserRequestRepository
-	cardService           CardService
-	raceService           RaceService
-	playerService         PlayerService
+	userRequestService UserRequestService
+	cardService        CardService
+	raceService        RaceService
+	playerService      PlayerService
 }
 
-func NewFinanceService(userRequestRepository repository.UserRequestRepository, cardService CardService, raceService RaceService, playerService PlayerService) FinanceService {
+func NewFinanceService(userRequestService UserRequestService, cardService CardService, raceService RaceService, playerService PlayerService) FinanceService {
 	return &financeService{
-		userRequestRepository: userRequestRepository,
-		cardService:           cardService,
-		raceService:           raceService,
-		playerService:         playerService,
+		userRequestService: userRequestService,
+		cardService:        cardService,
+		raceService:        raceService,
+		playerService:      playerService,
 	}
 }
 
@@ -44,17 +48,17 @@ func (service *financeService) AskMoney(raceId uint64, userId uint64, data dto.A
 		"dto":    data,
 	})
 
-	err, race, player := service.raceService.GetRaceAndPlayer(raceId, userId)
+	err, race, player := service.raceService.GetActiveRaceAndPlayer(raceId, userId)
 
 	if err != nil {
 		return err, false
 	}
 
 	if race.ID == 0 {
-		return errors.New(storage.ErrorUndefinedGame), false
+		return apperror.ErrUndefinedGame, false
 	}
 	if player.ID == 0 {
-		return errors.New(storage.ErrorUndefinedPlayer), false
+		return apperror.ErrUndefinedPlayer, false
 	}
 
 	if data.Type == "" {
@@ -65,8 +69,10 @@ func (service *financeService) AskMoney(raceId uint64, userId uint64, data dto.A
 
 	cardType := entity.TransactionCardType.ReceiveMoney
 
+	countPayDay := 0
+
 	if !race.Options.EnableManager {
-		countPayDay := service.cardService.CheckPayDay(player)
+		countPayDay = service.cardService.CheckPayDay(player)
 
 		logger.Info("FinanceService.AskMoney: have no manager", map[string]interface{}{
 			"playerId":        player.ID,
@@ -80,7 +86,7 @@ func (service *financeService) AskMoney(raceId uint64, userId uint64, data dto.A
 
 		if player.LastPosition == 0 && player.CurrentPosition == 0 {
 			if data.Amount != (player.CalculateCashFlow() + player.Assets.Savings) {
-				return errors.New(storage.ErrorWrongAmount), false
+				return apperror.ErrWrongAmount, false
 			}
 
 			player.Assets.Savings = 0
@@ -90,22 +96,36 @@ func (service *financeService) AskMoney(raceId uint64, userId uint64, data dto.A
 			race.CurrentCard.Type == entity.TransactionCardType.CashFlowDay {
 			cardType = race.CurrentCard.Type
 
-			if data.Amount != player.CalculateCashFlow() && data.Amount != (player.CalculateCashFlow()*countPayDay) {
-				return errors.New(storage.ErrorWrongAmount), false
+			paydays, ok := service.salaryPaydays(player, data, countPayDay)
+
+			if !ok {
+				return apperror.ErrWrongAmount, false
 			}
+
+			data.Paydays = paydays
 		} else if data.Type == entity.UserRequestTypes.Salary {
 
 			cardType = entity.TransactionCardType.Payday
 			if countPayDay == 0 {
-				return errors.New(storage.ErrorTransactionDeclined), false
-			} else if data.Amount != player.CalculateCashFlow() && data.Amount != (player.CalculateCashFlow()*countPayDay) {
-				return errors.New(storage.ErrorWrongAmount), false
+				return apperror.ErrTransactionDeclined, false
 			}
+
+			paydays, ok := service.salaryPaydays(player, data, countPayDay)
+
+			if !ok {
+				return apperror.ErrWrongAmount, false
+			}
+
+			data.Paydays = paydays
 		} else if data.Type == entity.UserRequestTypes.Baby && data.Amount != 1000 {
-			return errors.New(storage.ErrorWrongAmount), false
+			return apperror.ErrWrongAmount, false
 		}
 	}
 
+	if data.Paydays < 1 {
+		data.Paydays = 1
+	}
+
 	updatedCash := player.Cash - data.Amount
 
 	if race.CurrentCard.ID == "" {
@@ -124,7 +144,7 @@ func (service *financeService) AskMoney(raceId uint64, userId uint64, data dto.A
 	}
 
 	if trx := service.playerService.GetTransaction(transaction); trx.ID != 0 {
-		return errors.New(storage.ErrorTransactionAlreadyExists), false
+		return apperror.ErrTransactionAlreadyExists, false
 	}
 
 	if race.Options.EnableManager {
@@ -139,14 +159,23 @@ func (service *financeService) AskMoney(raceId uint64, userId uint64, data dto.A
 		request.Data = map[string]interface{}{
 			"last":    player.LastPosition,
 			"current": player.CurrentPosition,
+			"paydays": data.Paydays,
 		}
 
-		err, _ = service.userRequestRepository.Insert(&request)
+		err, _ = service.userRequestService.Insert(request)
 
 		if err != nil {
 			return err, false
 		}
 	} else {
+		if cardType == entity.TransactionCardType.Payday || cardType == entity.TransactionCardType.CashFlowDay {
+			player.RecordNetWorth(race.Market)
+		}
+
+		if cardType == entity.TransactionCardType.Payday {
+			return service.playerService.PayPaydays(player, data.Amount, data.Paydays, &transaction), true
+		}
+
 		err = service.playerService.UpdateCash(&player, data.Amount, &transaction)
 
 		return err, true
@@ -155,24 +184,49 @@ func (service *financeService) AskMoney(raceId uint64, userId uint64, data dto.A
 	return nil, false
 }
 
-func (service *financeService) SendMoney(raceId uint64, userId uint64, amount int, receiverUsername string) error {
+// salaryPaydays checks the salary covers the paydays the player passed, one or all of them, at the current cash
+// flow and returns how many it covers. Older clients send the amount only, then the paydays follow from it.
+func (service *financeService) salaryPaydays(player entity.Player, data dto.AskMoneyBodyDto, countPayDay int) (int, bool) {
+	cashFlow := player.CalculateCashFlow()
+
+	if data.Paydays == 0 {
+		if data.Amount == cashFlow {
+			return 1, true
+		}
+
+		if countPayDay > 1 && data.Amount == cashFlow*countPayDay {
+			return countPayDay, true
+		}
+
+		return 0, false
+	}
+
+	if data.Paydays != 1 && data.Paydays != countPayDay {
+		return 0, false
+	}
+
+	return data.Paydays, data.Amount == cashFlow*data.Paydays
+}
+
+func (service *financeService) SendMoney(raceId uint64, userId uint64, amount int, receiverUsername string, idempotencyKey string) error {
 	logger.Info("FinanceService.SendMoney", map[string]interface{}{
 		"raceId":           raceId,
 		"userId":           userId,
 		"amount":           amount,
 		"receiverUsername": receiverUsername,
+		"idempotencyKey":   idempotencyKey,
 	})
 
-	err, race, sender := service.raceService.GetRaceAndPlayer(raceId, userId)
+	err, race, sender := service.raceService.GetActiveRaceAndPlayer(raceId, userId)
 
 	if err != nil {
 		return err
 	}
 	if sender.ID == 0 {
-		return errors.New(storage.ErrorUndefinedPlayer)
+		return apperror.ErrUndefinedPlayer
 	}
 	if sender.Cash < amount {
-		return errors.New(storage.ErrorNotEnoughMoney)
+		return apperror.ErrNotEnoughMoney
 	}
 
 	if receiverUsername == "" {
@@ -181,10 +235,10 @@ func (service *financeService) SendMoney(raceId uint64, userId uint64, amount in
 			-amount,
 			&dto.TransactionDTO{
 				CardType: entity.TransactionCardType.SendMoneyToBank,
-				Details: fmt.Sprintf(
-					"Вы перевели $%s банк",
-					strconv.Itoa(amount),
-				),
+				Code:     storage.TransactionSentMoneyToBank,
+				Params: map[string]interface{}{
+					"amount": amount,
+				},
 			},
 		)
 
@@ -194,22 +248,21 @@ func (service *financeService) SendMoney(raceId uint64, userId uint64, amount in
 	receiver := service.playerService.GetPlayerByUsernameAndRaceId(raceId, receiverUsername)
 
 	if receiver.ID == 0 {
-		return errors.New(storage.ErrorUndefinedReceiverPlayer)
+		return apperror.ErrUndefinedReceiverPlayer
 	}
 
 	var transactionData = dto.TransactionDTO{
 		CardType: entity.TransactionCardType.SendMoney,
-		Details: fmt.Sprintf(
-			"Перевёл $%s игроку %s (#%s)",
-			strconv.Itoa(amount),
-			helper.CamelToCapitalize(receiverUsername),
-			receiver.GetStringID(),
-		),
+		Code:     storage.TransactionSentMoney,
+		Params: map[string]interface{}{
+			"amount":   amount,
+			"username": helper.CamelToCapitalize(receiverUsername),
+			"playerId": receiver.GetStringID(),
+		},
 	}
 
-	if race.CurrentCard.ID != "" {
-		cardID := fmt.Sprintf("%s-%s", race.CurrentCard.ID, strconv.Itoa(helper.Random(1000)))
-		transactionData.CardID = cardID
+	if race.CurrentCard.ID != "" || idempotencyKey != "" {
+		transactionData.CardID = transferCardID(race.CurrentCard.ID, idempotencyKey)
 	}
 
 	err = service.playerService.UpdateCash(
@@ -223,14 +276,15 @@ func (service *financeService) SendMoney(raceId uint64, userId uint64, amount in
 	}
 
 	transactionData.CardType = entity.TransactionCardType.ReceiveMoney
-	transactionData.Details = fmt.Sprintf(
-		"%s (#%s) перевёл Вам $%s",
-		helper.CamelToCapitalize(sender.Username),
-		sender.GetStringID(),
-		strconv.Itoa(amount),
-	)
+	transactionData.Code = storage.TransactionReceivedMoney
+	transactionData.Details = ""
+	transactionData.Params = map[string]interface{}{
+		"amount":   amount,
+		"username": helper.CamelToCapitalize(sender.Username),
+		"playerId": sender.GetStringID(),
+	}
 
-	receiver.SetNotification(transactionData.Details, entity.NotificationTypes.Success)
+	receiver.SetNotificationWithParams(transactionData.Code, entity.NotificationTypes.Success, transactionData.Params)
 
 	return service.playerService.UpdateCash(
 		&receiver,
@@ -239,14 +293,14 @@ func (service *financeService) SendMoney(raceId uint64, userId uint64, amount in
 	)
 }
 
-func (service *financeService) SendAssets(raceId uint64, userId uint64, data dto.SendAssetsBodyDTO) error {
+func (service *financeService) SendAssets(raceId uint64, userId uint64, data dto.SendAssetsBodyDTO, idempotencyKey string) error {
 	logger.Info("FinanceService.SendAssets", map[string]interface{}{
 		"raceId": raceId,
 		"userId": userId,
 		"dto":    data,
 	})
 
-	err, race, sender := service.raceService.GetRaceAndPlayer(raceId, userId)
+	err, race, sender := service.raceService.GetActiveRaceAndPlayer(raceId, userId)
 
 	if err != nil {
 		return err
@@ -255,48 +309,44 @@ func (service *financeService) SendAssets(raceId uint64, userId uint64, data dto
 	err, receiver := service.playerService.GetPlayerByPlayerIdAndRaceId(raceId, uint64(data.Player))
 
 	if err != nil {
-		return errors.New(storage.ErrorUndefinedReceiverPlayer)
+		return apperror.ErrUndefinedReceiverPlayer
 	}
 
 	if sender.ID == 0 {
-		return errors.New(storage.ErrorUndefinedPlayer)
+		return apperror.ErrUndefinedPlayer
 	}
 
 	if receiver.ID == 0 {
-		return errors.New(storage.ErrorUndefinedReceiverPlayer)
+		return apperror.ErrUndefinedReceiverPlayer
 	}
 
-	var transactionSenderMessage string
-	var transactionReceiverMessage string
+	var senderCode string
+	var receiverCode string
 
 	if data.Asset == "stock" {
-		transactionSenderMessage = fmt.Sprintf(
-			"Перевёл акции (%s шт.) игроку %s",
-			strconv.Itoa(data.Amount),
-			helper.CamelToCapitalize(receiver.Username),
-		)
-		transactionReceiverMessage = fmt.Sprintf(
-			"%s (#%s) перевёл Вам (%s шт.) акций",
-			helper.CamelToCapitalize(sender.Username),
-			sender.GetStringID(),
-			strconv.Itoa(data.Amount),
-		)
-		receiver.SetNotification(transactionReceiverMessage, entity.NotificationTypes.Success)
+		senderCode = storage.TransactionSentStocks
+		receiverCode = storage.TransactionReceivedStocks
+	} else if data.Asset == "business" {
+		senderCode = storage.TransactionSentBusiness
+		receiverCode = storage.TransactionReceivedBusiness
+	}
+
+	senderParams := map[string]interface{}{
+		"count":    data.Amount,
+		"username": helper.CamelToCapitalize(receiver.Username),
+	}
+	receiverParams := map[string]interface{}{
+		"count":    data.Amount,
+		"username": helper.CamelToCapitalize(sender.Username),
+		"playerId": sender.GetStringID(),
+	}
+
+	if data.Asset == "stock" {
+		receiver.SetNotificationWithParams(receiverCode, entity.NotificationTypes.Success, receiverParams)
 
 		err = service.playerService.TransferStocks(data.AssetId, sender, receiver, data.Amount)
 	} else if data.Asset == "business" {
-		transactionSenderMessage = fmt.Sprintf(
-			"Перевёл акции (%s шт.) игроку %s",
-			strconv.Itoa(data.Amount),
-			helper.CamelToCapitalize(receiver.Username),
-		)
-		transactionReceiverMessage = fmt.Sprintf(
-			"%s (#%s) перевёл Вам (%s шт.) акций",
-			helper.CamelToCapitalize(sender.Username),
-			sender.GetStringID(),
-			strconv.Itoa(data.Amount),
-		)
-		receiver.SetNotification(transactionReceiverMessage, entity.NotificationTypes.Success)
+		receiver.SetNotificationWithParams(receiverCode, entity.NotificationTypes.Success, receiverParams)
 
 		err = service.playerService.TransferBusiness(data.AssetId, sender, receiver, data.Amount)
 	}
@@ -304,14 +354,14 @@ func (service *financeService) SendAssets(raceId uint64, userId uint64, data dto
 	if err == nil {
 		transactionData := dto.TransactionDTO{
 			CardType:    entity.TransactionCardType.SendAssets,
-			Details:     transactionSenderMessage,
+			Code:        senderCode,
+			Params:      senderParams,
 			CurrentCash: sender.Cash,
 			Amount:      data.Amount,
 		}
 
-		if race.CurrentCard.ID != "" {
-			cardID := fmt.Sprintf("%s-%s", race.CurrentCard.ID, strconv.Itoa(helper.Random(1000)))
-			transactionData.CardID = cardID
+		if race.CurrentCard.ID != "" || idempotencyKey != "" {
+			transactionData.CardID = transferCardID(race.CurrentCard.ID, idempotencyKey)
 		}
 
 		err = service.playerService.SetTransaction(sender, transactionData)
@@ -321,7 +371,8 @@ func (service *financeService) SendAssets(raceId uint64, userId uint64, data dto
 		}
 
 		transactionData.CardType = entity.TransactionCardType.ReceiveAssets
-		transactionData.Details = transactionReceiverMessage
+		transactionData.Code = receiverCode
+		transactionData.Params = receiverParams
 		transactionData.CurrentCash = receiver.Cash
 
 		err = service.playerService.SetTransaction(receiver, transactionData)
@@ -330,28 +381,160 @@ func (service *financeService) SendAssets(raceId uint64, userId uint64, data dto
 	return err
 }
 
-func (service *financeService) PayLoan(raceId uint64, userId uint64, amount int) error {
+func (service *financeService) PayLoan(raceId uint64, userId uint64, liability string, amount int) error {
 	logger.Info("FinanceService.PayLoan", map[string]interface{}{
+		"raceId":    raceId,
+		"userId":    userId,
+		"liability": liability,
+		"amount":    amount,
+	})
+
+	err, race, player := service.raceService.GetActiveRaceAndPlayer(raceId, userId)
+
+	if err != nil {
+		return err
+	}
+
+	if player.ID == 0 {
+		return apperror.ErrUndefinedPlayer
+	}
+
+	return service.playerService.PayLoan(player, liability, amount, race.Options.LoanPolicy)
+}
+
+func (service *financeService) GetLoans(raceId uint64, userId uint64) (error, dto.PlayerLoansResponseDTO) {
+	logger.Info("FinanceService.GetLoans", map[string]interface{}{
+		"raceId": raceId,
+		"userId": userId,
+	})
+
+	err, race, player := service.raceService.GetRaceAndPlayer(raceId, userId)
+
+	if err != nil {
+		return err, dto.PlayerLoansResponseDTO{}
+	}
+
+	if player.ID == 0 {
+		return apperror.ErrUndefinedPlayer, dto.PlayerLoansResponseDTO{}
+	}
+
+	player.SyncLoans()
+
+	policy := race.Options.LoanPolicy
+	response := dto.PlayerLoansResponseDTO{
+		Policy:  policy,
+		Balance: player.Liabilities.BankLoan,
+		Payment: player.Expenses["bankLoan"],
+		Limit:   service.playerService.GetLoanLimit(player, policy),
+		Loans:   []dto.PlayerLoanDTO{},
+	}
+
+	for _, loan := range player.Loans {
+		response.Loans = append(response.Loans, dto.PlayerLoanDTO{
+			PlayerLoan: loan,
+			Payment:    loan.Payment(),
+			Schedule:   loan.Schedule(dto.LoanScheduleLimit),
+		})
+	}
+
+	return nil, response
+}
+
+func (service *financeService) GetBalanceSheet(raceId uint64, userId uint64) (error, dto.BalanceSheetResponseDTO) {
+	logger.Info("FinanceService.GetBalanceSheet", map[string]interface{}{
 		"raceId": raceId,
 		"userId": userId,
-		"amount": amount,
 	})
 
-	err, player := service.playerService.GetPlayerByUserIdAndRaceId(raceId, userId)
+	err, race, player := service.raceService.GetRaceAndPlayer(raceId, userId)
+
+	if err != nil {
+		return err, dto.BalanceSheetResponseDTO{}
+	}
+
+	if player.ID == 0 {
+		return apperror.ErrUndefinedPlayer, dto.BalanceSheetResponseDTO{}
+	}
+
+	history := player.NetWorthHistory
+
+	if history == nil {
+		history = []entity.NetWorthPoint{}
+	}
+
+	return nil, dto.BalanceSheetResponseDTO{
+		BalanceSheet: player.BalanceSheet(race.Market),
+		History:      history,
+	}
+}
+
+func (service *financeService) GetInsurances(raceId uint64, userId uint64) (error, dto.PlayerInsurancesResponseDTO) {
+	logger.Info("FinanceService.GetInsurances", map[string]interface{}{
+		"raceId": raceId,
+		"userId": userId,
+	})
+
+	err, race, player := service.raceService.GetRaceAndPlayer(raceId, userId)
+
+	if err != nil {
+		return err, dto.PlayerInsurancesResponseDTO{}
+	}
+
+	if player.ID == 0 {
+		return apperror.ErrUndefinedPlayer, dto.PlayerInsurancesResponseDTO{}
+	}
+
+	insurances := player.Insurances
+
+	if insurances == nil {
+		insurances = []entity.PlayerInsurance{}
+	}
+
+	return nil, dto.PlayerInsurancesResponseDTO{
+		Products:   race.Options.GetInsuranceProducts(),
+		Insurances: insurances,
+		Premiums:   player.CalculateInsurancePremiums(),
+	}
+}
+
+func (service *financeService) BuyInsurance(raceId uint64, userId uint64, insuranceType string) error {
+	logger.Info("FinanceService.BuyInsurance", map[string]interface{}{
+		"raceId": raceId,
+		"userId": userId,
+		"type":   insuranceType,
+	})
+
+	err, race, player := service.raceService.GetActiveRaceAndPlayer(raceId, userId)
 
 	if err != nil {
 		return err
 	}
 
 	if player.ID == 0 {
-		return errors.New(storage.ErrorUndefinedPlayer)
+		return apperror.ErrUndefinedPlayer
+	}
+
+	return service.playerService.BuyInsurance(player, race.Options.GetInsuranceProducts(), insuranceType)
+}
+
+func (service *financeService) CancelInsurance(raceId uint64, userId uint64, insuranceType string) error {
+	logger.Info("FinanceService.CancelInsurance", map[string]interface{}{
+		"raceId": raceId,
+		"userId": userId,
+		"type":   insuranceType,
+	})
+
+	err, _, player := service.raceService.GetActiveRaceAndPlayer(raceId, userId)
+
+	if err != nil {
+		return err
 	}
 
-	if amount%1000 != 0 {
-		return errors.New(storage.ErrorWrongAmountForPayingLoan)
+	if player.ID == 0 {
+		return apperror.ErrUndefinedPlayer
 	}
 
-	return service.playerService.PayLoan(player, "bankLoan", amount)
+	return service.playerService.CancelInsurance(player, insuranceType)
 }
 
 func (service *financeService) PayTax(raceId uint64, userId uint64, amount int) error {
@@ -361,7 +544,7 @@ func (service *financeService) PayTax(raceId uint64, userId uint64, amount int)
 		"amount": amount,
 	})
 
-	err, race, player := service.raceService.GetRaceAndPlayer(raceId, userId)
+	err, race, player := service.raceService.GetActiveRaceAndPlayer(raceId, userId)
 
 	if err != nil {
 		return err
@@ -379,7 +562,7 @@ func (service *financeService) PayTax(raceId uint64, userId uint64, amount int)
 	}
 
 	if result > amount {
-		return errors.New(storage.ErrorWrongAmount)
+		return apperror.ErrWrongAmount
 	}
 
 	err = service.playerService.UpdateCash(&player, -amount, &dto.TransactionDTO{
@@ -402,14 +585,14 @@ func (service *financeService) TakeLoan(raceId uint64, userId uint64, amount int
 		"amount": amount,
 	})
 
-	err, race, player := service.raceService.GetRaceAndPlayer(raceId, userId)
+	err, race, player := service.raceService.GetActiveRaceAndPlayer(raceId, userId)
 
 	if err != nil {
 		return err
 	}
 
 	if player.ID == 0 {
-		return errors.New(storage.ErrorUndefinedPlayer)
+		return apperror.ErrUndefinedPlayer
 	}
 
 	transaction := dto.TransactionDTO{
@@ -422,7 +605,11 @@ func (service *financeService) TakeLoan(raceId uint64, userId uint64, amount int
 	}
 
 	if trx := service.playerService.GetTransaction(transaction); trx.ID != 0 {
-		return errors.New(storage.ErrorTransactionAlreadyExists)
+		return apperror.ErrTransactionAlreadyExists
+	}
+
+	if err = service.playerService.CheckLoan(player, amount, race.Options.LoanPolicy); err != nil {
+		return err
 	}
 
 	if race.Options.EnableManager {
@@ -439,18 +626,24 @@ func (service *financeService) TakeLoan(raceId uint64, userId uint64, amount int
 			"current": player.CurrentPosition,
 		}
 
-		err, _ = service.userRequestRepository.Insert(&request)
+		err, _ = service.userRequestService.Insert(request)
 
 		if err != nil {
 			return err
 		}
 	} else {
-		if amount%1000 != 0 {
-			return errors.New(storage.ErrorWrongAmountForTakingLoan)
-		}
-
-		return service.playerService.TakeLoan(player, amount)
+		return service.playerService.TakeLoan(player, amount, race.Options.LoanPolicy)
 	}
 
 	return err
 }
+
+// transferCardID makes several transfers during one card possible: each request gets its own card id.
+// A retry carrying the same Idempotency-Key gets the same id, so the unique transaction index rejects it.
+func transferCardID(cardID string, idempotencyKey string) string {
+	if idempotencyKey == "" {
+		idempotencyKey = strconv.FormatInt(time.Now().UnixNano(), 10)
+	}
+
+	return cardID + "-" + helper.CreateHash(idempotencyKey)[:16]
+}
@@ -1 +1,42 @@
 package service
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/webjohny/cashflow-go/dto"
+	"github.com/webjohny/cashflow-go/entity"
+	"testing"
+)
+
+func TestFinanceServiceSalaryPaydays(t *testing.T) {
+	tests := []struct {
+		name        string
+		body        dto.AskMoneyBodyDto
+		countPayDay int
+		paydays     int
+		valid       bool
+	}{
+		{name: "one payday without paydays", body: dto.AskMoneyBodyDto{Amount: 1000}, countPayDay: 3, paydays: 1, valid: true},
+		{name: "every payday without paydays", body: dto.AskMoneyBodyDto{Amount: 3000}, countPayDay: 3, paydays: 3, valid: true},
+		{name: "another amount without paydays", body: dto.AskMoneyBodyDto{Amount: 2000}, countPayDay: 3, valid: false},
+		{name: "a single payday passed without paydays", body: dto.AskMoneyBodyDto{Amount: 1000}, countPayDay: 1, paydays: 1, valid: true},
+		{name: "one payday", body: dto.AskMoneyBodyDto{Amount: 1000, Paydays: 1}, countPayDay: 3, paydays: 1, valid: true},
+		{name: "every payday", body: dto.AskMoneyBodyDto{Amount: 3000, Paydays: 3}, countPayDay: 3, paydays: 3, valid: true},
+		{name: "every payday for the amount of one", body: dto.AskMoneyBodyDto{Amount: 1000, Paydays: 3}, countPayDay: 3, valid: false},
+		{name: "some of the paydays", body: dto.AskMoneyBodyDto{Amount: 2000, Paydays: 2}, countPayDay: 3, valid: false},
+		{name: "more paydays than passed", body: dto.AskMoneyBodyDto{Amount: 4000, Paydays: 4}, countPayDay: 3, valid: false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			player := entity.Player{Salary: 2000, Expenses: map[string]int{"taxes": 1000}}
+
+			paydays, valid := (&financeService{}).salaryPaydays(player, test.body, test.countPayDay)
+
+			assert.Equal(t, test.valid, valid)
+
+			if test.valid {
+				assert.Equal(t, test.paydays, paydays)
+			}
+		})
+	}
+}
@@ -1,14 +1,16 @@
 package service
 
 import (
-	"errors"
 	logger "github.com/sirupsen/logrus"
+	"github.com/webjohny/cashflow-go/apperror"
 	"github.com/webjohny/cashflow-go/dto"
 	"github.com/webjohny/cashflow-go/entity"
 	"github.com/webjohny/cashflow-go/helper"
 	"github.com/webjohny/cashflow-go/storage"
 	"log"
 	"math/rand"
+	"strconv"
+	"strings"
 	"time"
 )
 
@@ -16,10 +18,18 @@ type GameService interface {
 	Start(lobbyId uint64) (error, entity.Race)
 	RollDice(raceId uint64, userId uint64, dto dto.RollDiceDto) (error, []int)
 	GetGame(raceId uint64, userId uint64) (error, dto.GetGameResponseDTO)
+	Spectate(raceId uint64, userId uint64) (error, dto.GetGameResponseDTO)
 	ChangeTurn(raceId uint64, forced bool) error
 	Cancel(raceId uint64, userId uint64) error
 	Reset(raceId uint64, userId uint64) error
 	GetTiles(raceId uint64, isBigRace bool) []string
+	PromoteWaitList(raceId uint64, userId uint64, targetUserId uint64) (error, entity.Player)
+	Pause(raceId uint64, userId uint64) (error, entity.Race)
+	Resume(raceId uint64, userId uint64) (error, entity.Race)
+	GetPausedRaces(userId uint64) []entity.Race
+	GetManagedRace(raceId uint64, userId uint64) (error, entity.Race)
+	ConfirmRoll(raceId uint64, userId uint64, dice []int) (error, entity.Race)
+	RejectRoll(raceId uint64, userId uint64) (error, entity.Race)
 }
 
 type gameService struct {
@@ -46,11 +56,26 @@ func NewGameService(
 func (service *gameService) GetGame(raceId uint64, userId uint64) (error, dto.GetGameResponseDTO) {
 	err, player := service.playerService.GetPlayerByUserIdAndRaceId(raceId, userId)
 
+	var you dto.GetRacePlayerResponseDTO
+
 	if err != nil {
-		return err, dto.GetGameResponseDTO{}
-	}
+		lobby := service.lobbyService.GetByGameId(raceId)
 
-	you := service.playerService.GetFormattedPlayerResponse(player, true)
+		if !lobby.CanWatch(userId) {
+			return err, dto.GetGameResponseDTO{}
+		}
+
+		lobbyPlayer := lobby.GetPlayer(userId)
+		player.Username = lobbyPlayer.Username
+		you = dto.GetRacePlayerResponseDTO{
+			UserId:   lobbyPlayer.ID,
+			Username: lobbyPlayer.Username,
+			Role:     lobbyPlayer.Role,
+			Color:    lobbyPlayer.Color,
+		}
+	} else {
+		you = service.playerService.GetFormattedPlayerResponse(player, true)
+	}
 
 	response := dto.GetGameResponseDTO{
 		Username:          player.Username,
@@ -62,9 +87,16 @@ func (service *gameService) GetGame(raceId uint64, userId uint64) (error, dto.Ge
 	}
 
 	race := service.raceService.GetFormattedRaceResponse(raceId, false)
+
+	if player.ID == 0 && race.Options.HideCards {
+		race.CurrentCard = entity.Card{}
+	}
+
 	response.TurnResponses = race.TurnResponses
 	response.Players = race.Players
 	response.CurrentCard = &race.CurrentCard
+	response.Auction = race.Auction
+	response.PendingRoll = race.PendingRoll
 	response.CurrentPlayer = &race.CurrentPlayer
 	response.GameId = race.GameId
 	response.IsTurnEnded = race.IsTurnEnded
@@ -78,6 +110,60 @@ func (service *gameService) GetGame(raceId uint64, userId uint64) (error, dto.Ge
 	return nil, response
 }
 
+func (service *gameService) Spectate(raceId uint64, userId uint64) (error, dto.GetGameResponseDTO) {
+	logger.Info("GameService.Spectate", map[string]interface{}{
+		"raceId": raceId,
+		"userId": userId,
+	})
+
+	race := service.raceService.GetRaceByRaceId(raceId)
+
+	if race.ID == 0 {
+		return apperror.ErrUndefinedGame, dto.GetGameResponseDTO{}
+	}
+
+	lobby := service.lobbyService.GetByGameId(raceId)
+	lobbyPlayer := lobby.GetPlayer(userId)
+
+	if lobbyPlayer.ID == 0 {
+		return apperror.ErrPermissionDenied, dto.GetGameResponseDTO{}
+	}
+
+	formatted := service.raceService.GetFormattedRaceResponse(raceId, false)
+
+	if formatted.Options.HideCards {
+		formatted.CurrentCard = entity.Card{}
+	}
+
+	response := dto.GetGameResponseDTO{
+		Username: lobbyPlayer.Username,
+		You: dto.GetRacePlayerResponseDTO{
+			UserId:   lobbyPlayer.ID,
+			Username: lobbyPlayer.Username,
+			Role:     entity.PlayerRoles.Spectator,
+			Color:    lobbyPlayer.Color,
+		},
+		Players:           formatted.Players,
+		BankruptedPlayers: make([]dto.GetRacePlayerResponseDTO, 0),
+		TurnResponses:     formatted.TurnResponses,
+		Options:           formatted.Options,
+		Status:            formatted.Status,
+		DiceValues:        formatted.DiceValues,
+		CurrentPlayer:     &formatted.CurrentPlayer,
+		CurrentCard:       &formatted.CurrentCard,
+		Auction:           formatted.Auction,
+		PendingRoll:       formatted.PendingRoll,
+		GameId:            formatted.GameId,
+		IsMultiFlow:       formatted.IsMultiFlow,
+		IsTurnEnded:       formatted.IsTurnEnded,
+		Logs:              formatted.Logs,
+		Notifications:     make([]entity.RaceNotification, 0),
+	}
+	response.Hash = helper.CreateHashByJson(formatted)
+
+	return nil, response
+}
+
 func (service *gameService) RollDice(raceId uint64, userId uint64, dto dto.RollDiceDto) (error, []int) {
 	logger.Info("GameService.RollDice", map[string]interface{}{
 		"raceId": raceId,
@@ -85,14 +171,22 @@ func (service *gameService) RollDice(raceId uint64, userId uint64, dto dto.RollD
 		"dto":    dto,
 	})
 
-	err, race, player := service.raceService.GetRaceAndPlayer(raceId, userId)
+	err, race, player := service.raceService.GetActiveRaceAndPlayer(raceId, userId)
 
 	if err != nil {
 		return err, []int{}
 	}
 
 	if player.ID != race.CurrentPlayer.ID {
-		return errors.New(storage.ErrorItsNotYourMoveNow), []int{}
+		return apperror.ErrItsNotYourMoveNow, []int{}
+	}
+
+	if player.IsTeam() && userId != race.CurrentPlayer.UserId && !player.Team.IsCaptain(userId) {
+		return apperror.ErrItsNotYourMoveNow, []int{}
+	}
+
+	if race.PendingRoll != nil {
+		return apperror.ErrRollAwaitsConfirmation, []int{}
 	}
 
 	getDice := race.GetDice()
@@ -103,17 +197,161 @@ func (service *gameService) RollDice(raceId uint64, userId uint64, dto dto.RollD
 		dice = getDice.Roll(dto.Dices)
 	}
 
-	if dto.DiceValue > 0 {
-		dice = []int{dto.DiceValue}
+	entered := dto.DiceValues
+
+	if len(entered) == 0 && dto.DiceValue > 0 {
+		entered = []int{dto.DiceValue}
 	}
 
-	if dto.IsFinished {
+	if len(entered) > 0 {
+		if !race.Options.HandMode {
+			return apperror.ErrHandModeDisabled, []int{}
+		}
+
+		if err = validateDice(player, entered); err != nil {
+			return err, []int{}
+		}
+
+		dice = entered
+
+		if race.Options.HandModeConfirmation {
+			return service.holdRoll(race, player, dice, dto.IsFinished), dice
+		}
+	}
+
+	return service.applyRoll(race, player, dice, dto.IsFinished), dice
+}
+
+// ConfirmRoll applies the dice the current player entered in hand mode, the moderator may correct the faces.
+func (service *gameService) ConfirmRoll(raceId uint64, userId uint64, dice []int) (error, entity.Race) {
+	logger.Info("GameService.ConfirmRoll", map[string]interface{}{
+		"raceId": raceId,
+		"userId": userId,
+		"dice":   dice,
+	})
+
+	err, race := service.getPendingRoll(raceId, userId)
+
+	if err != nil {
+		return err, entity.Race{}
+	}
+
+	pending := *race.PendingRoll
+
+	err, player := service.playerService.GetPlayerByPlayerIdAndRaceId(raceId, pending.PlayerID)
+
+	if err != nil {
+		return err, entity.Race{}
+	}
+
+	if len(dice) > 0 {
+		if err = validateDice(player, dice); err != nil {
+			return err, entity.Race{}
+		}
+
+		pending.Dice = dice
+	}
+
+	race.PendingRoll = nil
+
+	if err = service.applyRoll(race, player, pending.Dice, pending.IsFinished); err != nil {
+		return err, entity.Race{}
+	}
+
+	return nil, service.raceService.GetRaceByRaceId(raceId)
+}
+
+// RejectRoll drops the dice entered in hand mode so the current player enters them again.
+func (service *gameService) RejectRoll(raceId uint64, userId uint64) (error, entity.Race) {
+	logger.Info("GameService.RejectRoll", map[string]interface{}{
+		"raceId": raceId,
+		"userId": userId,
+	})
+
+	err, race := service.getPendingRoll(raceId, userId)
+
+	if err != nil {
+		return err, entity.Race{}
+	}
+
+	pending := *race.PendingRoll
+	race.PendingRoll = nil
+
+	err, race = service.raceService.UpdateRace(&race)
+
+	if err != nil {
+		return err, entity.Race{}
+	}
+
+	if err, player := service.playerService.GetPlayerByPlayerIdAndRaceId(raceId, pending.PlayerID); err == nil {
+		player.SetNotificationWithParams(storage.MessageRollRejected, entity.NotificationTypes.Error, map[string]interface{}{
+			"dice": formatDice(pending.Dice),
+		})
+
+		if err, _ = service.playerService.UpdatePlayer(&player); err != nil {
+			logger.Error("GameService.RejectRoll", err, player.ID)
+		}
+	}
+
+	return nil, race
+}
+
+func (service *gameService) getPendingRoll(raceId uint64, userId uint64) (error, entity.Race) {
+	err, race := service.GetManagedRace(raceId, userId)
+
+	if err != nil {
+		return err, entity.Race{}
+	}
+
+	if race.Status == entity.RaceStatus.PAUSED {
+		return apperror.ErrGameIsPaused, entity.Race{}
+	}
+
+	if race.PendingRoll == nil {
+		return apperror.ErrUndefinedPendingRoll, entity.Race{}
+	}
+
+	return nil, race
+}
+
+// holdRoll keeps the entered dice on the race and asks the moderators to confirm them.
+func (service *gameService) holdRoll(race entity.Race, player entity.Player, dice []int, isFinished bool) error {
+	race.PendingRoll = &entity.RacePendingRoll{
+		PlayerID:   player.ID,
+		Username:   player.Username,
+		Dice:       dice,
+		IsFinished: isFinished,
+		CreatedAt:  time.Now(),
+	}
+
+	err, _ := service.raceService.UpdateRace(&race)
+
+	if err != nil {
+		return err
+	}
+
+	for _, moderator := range service.playerService.GetModeratorsByRaceId(race.ID) {
+		moderator.SetNotificationWithParams(storage.MessageRollAwaitsConfirmation, entity.NotificationTypes.Info, map[string]interface{}{
+			"username": player.Username,
+			"dice":     formatDice(dice),
+		})
+
+		if err, _ = service.playerService.UpdatePlayer(&moderator); err != nil {
+			logger.Error("GameService.holdRoll", err, moderator.ID)
+		}
+	}
+
+	return nil
+}
+
+func (service *gameService) applyRoll(race entity.Race, player entity.Player, dice []int, isFinished bool) error {
+	if isFinished {
 		dualDiceCount := player.DualDiceCount
 
 		var totalCount int
 
 		if len(player.Dices) > 0 {
-			if dto.Dices > 0 {
+			if len(dice) > 0 {
 				player.AddDices(dice)
 			}
 			race.Dice = player.Dices
@@ -134,10 +372,46 @@ func (service *gameService) RollDice(raceId uint64, userId uint64, dto dto.RollD
 		player.AddDices(dice)
 	}
 
-	err, _ = service.playerService.UpdatePlayer(&player)
+	err, _ := service.playerService.UpdatePlayer(&player)
 	err, _ = service.raceService.UpdateRace(&race)
 
-	return err, dice
+	return err
+}
+
+// validateDice checks the faces of the physical dice and that the player does not roll more dice this turn
+// than allowed: the extra dice of a charity bonus but at least two, two on the fast track, one otherwise.
+func validateDice(player entity.Player, dice []int) error {
+	allowed := 1
+
+	if player.DualDiceCount > 0 || player.OnBigRace {
+		allowed = 2
+	}
+
+	if player.DualDiceCount > 0 && player.ExtraDices > allowed {
+		allowed = player.ExtraDices
+	}
+
+	if len(player.Dices)+len(dice) > allowed {
+		return apperror.ErrTooManyDice.WithDetails(apperror.Details{"allowed": allowed})
+	}
+
+	for _, value := range dice {
+		if value < 1 || value > 6 {
+			return apperror.ErrInvalidDiceValue
+		}
+	}
+
+	return nil
+}
+
+func formatDice(dice []int) string {
+	faces := make([]string, 0)
+
+	for _, value := range dice {
+		faces = append(faces, strconv.Itoa(value))
+	}
+
+	return strings.Join(faces, " + ")
 }
 
 func (service *gameService) Cancel(raceId uint64, userId uint64) error {
@@ -150,12 +424,12 @@ func (service *gameService) Cancel(raceId uint64, userId uint64) error {
 	race := service.raceService.GetRaceByRaceId(raceId)
 
 	if race.ID == 0 {
-		return errors.New(storage.ErrorUndefinedGame)
+		return apperror.ErrUndefinedGame
 	}
 
 	for _, player := range players {
 		if player.UserID == userId && player.Role != entity.PlayerRoles.Owner {
-			return errors.New(storage.ErrorPermissionDenied)
+			return apperror.ErrPermissionDenied
 		}
 	}
 
@@ -181,12 +455,12 @@ func (service *gameService) Reset(raceId uint64, userId uint64) error {
 	}
 
 	if race.ID == 0 {
-		return errors.New(storage.ErrorUndefinedGame)
+		return apperror.ErrUndefinedGame
 	}
 
 	for _, player := range players {
 		if player.UserID == userId && player.Role != entity.PlayerRoles.Owner {
-			return errors.New(storage.ErrorPermissionDenied)
+			return apperror.ErrPermissionDenied
 		}
 	}
 
@@ -212,6 +486,7 @@ func (service *gameService) Reset(raceId uint64, userId uint64) error {
 			Assets:       profession.Assets,
 			Liabilities:  profession.Liabilities,
 			ProfessionID: uint8(profession.ID),
+			Team:         player.Team,
 		})
 	}
 
@@ -254,6 +529,93 @@ func (service *gameService) GetTiles(raceId uint64, isBigRace bool) []string {
 	}
 }
 
+func (service *gameService) PromoteWaitList(raceId uint64, userId uint64, targetUserId uint64) (error, entity.Player) {
+	logger.Info("GameService.PromoteWaitList", map[string]interface{}{
+		"raceId":       raceId,
+		"userId":       userId,
+		"targetUserId": targetUserId,
+	})
+
+	race := service.raceService.GetRaceByRaceId(raceId)
+
+	if race.ID == 0 {
+		return apperror.ErrUndefinedGame, entity.Player{}
+	}
+
+	if race.Status != entity.RaceStatus.STARTED {
+		return apperror.ErrGameIsFinished, entity.Player{}
+	}
+
+	lobby := service.lobbyService.GetByGameId(raceId)
+
+	if lobby.ID == 0 {
+		return apperror.ErrUndefinedLobby, entity.Player{}
+	}
+
+	if !lobby.IsManagedBy(userId) {
+		return apperror.ErrPermissionDenied, entity.Player{}
+	}
+
+	if !lobby.IsWaitListed(targetUserId) {
+		return apperror.ErrUserIsNotOnWaitList, entity.Player{}
+	}
+
+	lobbyPlayer := lobby.GetPlayer(targetUserId)
+
+	var excluded []int
+
+	for _, racePlayer := range service.playerService.GetAllStatePlayersByRaceId(raceId) {
+		excluded = append(excluded, int(racePlayer.ProfessionID))
+	}
+
+	err, profession := service.professionService.GetRandomProfession(race.Options.Language, &excluded)
+
+	if err != nil {
+		return err, entity.Player{}
+	}
+
+	profession.Assets.Business = make([]entity.CardBusiness, 0)
+	profession.Assets.Dreams = make([]entity.CardDream, 0)
+
+	err, player := service.playerService.InsertPlayer(&entity.Player{
+		UserID:       lobbyPlayer.ID,
+		RaceID:       race.ID,
+		Username:     lobbyPlayer.Username,
+		Role:         entity.PlayerRoles.Player,
+		Color:        lobbyPlayer.Color,
+		Salary:       profession.Income.Salary,
+		Babies:       uint8(profession.Babies),
+		Expenses:     profession.Expenses,
+		Assets:       profession.Assets,
+		Liabilities:  profession.Liabilities,
+		ProfessionID: uint8(profession.ID),
+		Info:         entity.PlayerInfo{Language: race.Options.Language, Conditions: race.Options.BigRaceConditions},
+		IsActive:     true,
+		CreatedAt:    time.Now(),
+	})
+
+	if err != nil {
+		return err, entity.Player{}
+	}
+
+	// The new player joins at the end of the turn order and must not block
+	// responses of the round in progress.
+	response := player.CreateResponse()
+	response.Responded = true
+	race.AddResponse(response)
+
+	err, _ = service.raceService.UpdateRace(&race)
+
+	if err != nil {
+		return err, entity.Player{}
+	}
+
+	lobby.ChangePlayerRole(targetUserId, entity.PlayerRoles.Player)
+	err, _ = service.lobbyService.Update(&lobby)
+
+	return err, player
+}
+
 func (service *gameService) ChangeTurn(raceId uint64, forced bool) error {
 	logger.Info("GameService.ChangeTurn", map[string]interface{}{
 		"raceId": raceId,
@@ -262,12 +624,99 @@ func (service *gameService) ChangeTurn(raceId uint64, forced bool) error {
 	race := service.raceService.GetRaceByRaceId(raceId)
 
 	if race.ID == 0 {
-		return errors.New(storage.ErrorUndefinedGame)
+		return apperror.ErrUndefinedGame
+	}
+
+	if race.IsPaused() {
+		return apperror.ErrGameIsPaused
 	}
 
 	return service.raceService.ChangeTurn(race, forced, 0)
 }
 
+// Pause freezes the actions of the players until the owner or a moderator resumes the race.
+func (service *gameService) Pause(raceId uint64, userId uint64) (error, entity.Race) {
+	logger.Info("GameService.Pause", map[string]interface{}{
+		"raceId": raceId,
+		"userId": userId,
+	})
+
+	err, race := service.GetManagedRace(raceId, userId)
+
+	if err != nil {
+		return err, entity.Race{}
+	}
+
+	if race.IsPaused() {
+		return apperror.ErrGameIsPaused, entity.Race{}
+	}
+
+	if race.Status != entity.RaceStatus.STARTED {
+		return apperror.ErrGameIsNotStarted, entity.Race{}
+	}
+
+	race.Pause(userId)
+
+	return service.raceService.UpdateRace(&race)
+}
+
+func (service *gameService) Resume(raceId uint64, userId uint64) (error, entity.Race) {
+	logger.Info("GameService.Resume", map[string]interface{}{
+		"raceId": raceId,
+		"userId": userId,
+	})
+
+	err, race := service.GetManagedRace(raceId, userId)
+
+	if err != nil {
+		return err, entity.Race{}
+	}
+
+	if !race.IsPaused() {
+		return apperror.ErrGameIsNotPaused, entity.Race{}
+	}
+
+	race.Resume()
+
+	return service.raceService.UpdateRace(&race)
+}
+
+// GetPausedRaces returns the paused races the user owns or moderates.
+func (service *gameService) GetPausedRaces(userId uint64) []entity.Race {
+	races := make([]entity.Race, 0)
+
+	for _, race := range service.raceService.GetRacesByStatus(entity.RaceStatus.PAUSED) {
+		lobby := service.lobbyService.GetByGameId(race.ID)
+
+		if lobby.ID != 0 && lobby.IsManagedBy(userId) {
+			races = append(races, race)
+		}
+	}
+
+	return races
+}
+
+// GetManagedRace returns the race when the user owns or moderates it.
+func (service *gameService) GetManagedRace(raceId uint64, userId uint64) (error, entity.Race) {
+	race := service.raceService.GetRaceByRaceId(raceId)
+
+	if race.ID == 0 {
+		return apperror.ErrUndefinedGame, entity.Race{}
+	}
+
+	lobby := service.lobbyService.GetByGameId(raceId)
+
+	if lobby.ID == 0 {
+		return apperror.ErrUndefinedLobby, entity.Race{}
+	}
+
+	if !lobby.IsManagedBy(userId) {
+		return apperror.ErrPermissionDenied, entity.Race{}
+	}
+
+	return nil, race
+}
+
 func (service *gameService) Start(lobbyId uint64) (error, entity.Race) {
 	logger.Info("GameService.Start", map[string]interface{}{
 		"lobbyId": lobbyId,
@@ -276,15 +725,15 @@ func (service *gameService) Start(lobbyId uint64) (error, entity.Race) {
 	lobby := service.lobbyService.GetByID(lobbyId)
 
 	if lobby.ID == 0 {
-		return errors.New(storage.ErrorUndefinedLobby), entity.Race{}
+		return apperror.ErrUndefinedLobby, entity.Race{}
 	}
 
 	if !lobby.AvailableToStart() {
-		return errors.New(storage.ErrorInsufficientPlayers), entity.Race{}
+		return apperror.ErrInsufficientPlayers, entity.Race{}
 	}
 
 	if lobby.IsStarted() {
-		return errors.New(storage.ErrorGameIsStarted), entity.Race{}
+		return apperror.ErrGameIsStarted, entity.Race{}
 	}
 
 	if lobby.Options.Language == "" {
@@ -309,7 +758,7 @@ func (service *gameService) Start(lobbyId uint64) (error, entity.Race) {
 	})
 
 	if err != nil {
-		return errors.New(storage.ErrorCannotCreatedRace), entity.Race{}
+		return apperror.ErrCannotCreatedRace, entity.Race{}
 	}
 
 	var excluded []int
@@ -323,13 +772,24 @@ func (service *gameService) Start(lobbyId uint64) (error, entity.Race) {
 
 	for i := 0; i < len(lobby.Players); i++ {
 		lobbyPlayer := lobby.Players[i]
+
+		if lobbyPlayer.CanWatch() {
+			continue
+		}
+
+		team := service.getTeamOfPlayer(lobby, lobbyPlayer.ID)
+
+		if team != nil && team.CaptainID != lobbyPlayer.ID {
+			continue
+		}
+
 		_, profession := service.professionService.GetRandomProfession(lobby.Options.Language, &excluded)
 		excluded = append(excluded, int(profession.ID))
 
 		profession.Assets.Business = make([]entity.CardBusiness, 0)
 		profession.Assets.Dreams = make([]entity.CardDream, 0)
 
-		playerErr, player := service.playerService.InsertPlayer(&entity.Player{
+		instance := entity.Player{
 			UserID:       lobbyPlayer.ID,
 			RaceID:       race.ID,
 			Username:     lobbyPlayer.Username,
@@ -341,14 +801,25 @@ func (service *gameService) Start(lobbyId uint64) (error, entity.Race) {
 			Assets:       profession.Assets,
 			Liabilities:  profession.Liabilities,
 			ProfessionID: uint8(profession.ID),
-			Info:         entity.PlayerInfo{Language: lobby.Options.Language},
+			Info:         entity.PlayerInfo{Language: lobby.Options.Language, Conditions: lobby.Options.BigRaceConditions},
 			CreatedAt:    time.Now(),
-		})
+		}
+
+		if team != nil {
+			instance.Username = team.Name
+			instance.Team = service.createPlayerTeam(lobby, *team)
+		}
+
+		playerErr, player := service.playerService.InsertPlayer(&instance)
 
 		if playerErr != nil {
 			log.Panic(playerErr)
 		}
 
+		if playerErr = service.playerService.InsertTeamMembers(player); playerErr != nil {
+			log.Panic(playerErr)
+		}
+
 		players = append(players, entity.RacePlayer{
 			ID:       player.ID,
 			UserId:   player.UserID,
@@ -358,8 +829,8 @@ func (service *gameService) Start(lobbyId uint64) (error, entity.Race) {
 		responses = append(responses, player.CreateResponse())
 	}
 
-	race.CurrentPlayer = players[0]
 	race.Responses = responses
+	race.PickCurrentPlayer(int(players[0].ID))
 
 	err, _ = service.raceService.UpdateRace(&race)
 
@@ -368,8 +839,38 @@ func (service *gameService) Start(lobbyId uint64) (error, entity.Race) {
 	err, _ = service.lobbyService.Update(&lobby)
 
 	if err != nil {
-		return errors.New(storage.ErrorProcessFailed), entity.Race{}
+		return apperror.ErrProcessFailed, entity.Race{}
 	}
 
 	return nil, race
 }
+
+// getTeamOfPlayer returns the team of the lobby player when the lobby plays in teams.
+func (service *gameService) getTeamOfPlayer(lobby entity.Lobby, userId uint64) *entity.LobbyTeam {
+	if !lobby.Options.EnableTeams {
+		return nil
+	}
+
+	return lobby.GetTeam(userId)
+}
+
+// createPlayerTeam lists the members of the team in the order they roll the dice, the captain goes first.
+func (service *gameService) createPlayerTeam(lobby entity.Lobby, team entity.LobbyTeam) *entity.PlayerTeam {
+	playerTeam := &entity.PlayerTeam{
+		CaptainID: team.CaptainID,
+		Members:   []entity.PlayerTeamMember{{UserID: team.CaptainID, Username: lobby.GetPlayer(team.CaptainID).Username}},
+	}
+
+	for _, member := range team.Members {
+		if member == team.CaptainID {
+			continue
+		}
+
+		playerTeam.Members = append(playerTeam.Members, entity.PlayerTeamMember{
+			UserID:   member,
+			Username: lobby.GetPlayer(member).Username,
+		})
+	}
+
+	return playerTeam
+}
@@ -0,0 +1,39 @@
+package service
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/webjohny/cashflow-go/apperror"
+	"github.com/webjohny/cashflow-go/entity"
+	"testing"
+)
+
+func TestValidateDice(t *testing.T) {
+	tests := []struct {
+		name   string
+		player entity.Player
+		dice   []int
+		err    error
+	}{
+		{name: "one die", player: entity.Player{}, dice: []int{4}},
+		{name: "two dice without a bonus", player: entity.Player{}, dice: []int{4, 2}, err: apperror.ErrTooManyDice},
+		{name: "two dice on the fast track", player: entity.Player{OnBigRace: true}, dice: []int{4, 2}},
+		{name: "two dice of a charity", player: entity.Player{DualDiceCount: 3, ExtraDices: 1}, dice: []int{4, 2}},
+		{name: "three dice of a charity", player: entity.Player{DualDiceCount: 3, ExtraDices: 3}, dice: []int{4, 2, 6}},
+		{name: "three dice entered one by one", player: entity.Player{DualDiceCount: 3, ExtraDices: 3, Dices: []int{4, 2}}, dice: []int{6}},
+		{name: "more dice than the charity gives", player: entity.Player{DualDiceCount: 3, ExtraDices: 3}, dice: []int{4, 2, 6, 1}, err: apperror.ErrTooManyDice},
+		{name: "extra dice of a used up charity", player: entity.Player{ExtraDices: 3}, dice: []int{4, 2}, err: apperror.ErrTooManyDice},
+		{name: "a face out of range", player: entity.Player{}, dice: []int{7}, err: apperror.ErrInvalidDiceValue},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := validateDice(test.player, test.dice)
+
+			if test.err == nil {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorIs(t, err, test.err)
+			}
+		})
+	}
+}
@@ -34,15 +34,15 @@ func TestStartGame(t *testing.T) {
 		Players:    make([]entity.LobbyPlayer, 0),
 		MaxPlayers: service.LobbyMaxPlayers,
 		Status:     entity.LobbyStatus.New,
-		Options:    make(map[string]interface{}),
+		Options:    entity.RaceOptions{},
 		CreatedAt:  time.Now(),
 	}
 
 	lobbyService := service.NewLobbyService(lobbyRepo)
 	professionService := service.NewProfessionService(professionRepo)
 	transactionService := service.NewTransactionService(transactionRepo)
-	playerService := service.NewPlayerService(playerRepo, professionService, transactionService)
-	raceService := service.NewRaceService(raceRepo, playerService, transactionService)
+	playerService := service.NewPlayerService(playerRepo, nil, professionService, transactionService, nil, nil)
+	raceService := service.NewRaceService(raceRepo, playerService, transactionService, &repository_mocks.MockUserRequestRepository{})
 	gameService := service.NewGameService(raceService, playerService, lobbyService, professionService)
 
 	userOwner := entity.LobbyPlayer{
@@ -135,15 +135,13 @@ func TestStartGame(t *testing.T) {
 				DualDiceCount:   0,
 				SkippedTurns:    0,
 				IsRolledDice:    0,
-				CanReRoll:       0,
 				OnBigRace:       false,
 				HasBankrupt:     0,
 				AboutToBankrupt: "",
-				HasMlm:          0,
 			}
 		}
 
-		raceRepo.FindRaceByIdFunc = func(ID uint64, IsBigRace bool) entity.Race {
+		raceRepo.FindRaceByIdFunc = func(ID uint64) entity.Race {
 			return entity.Race{
 				Responses: make([]entity.RaceResponse, 0),
 				Status:    entity.RaceStatus.STARTED,
@@ -197,11 +195,9 @@ func TestStartGame(t *testing.T) {
 			DualDiceCount:   0,
 			SkippedTurns:    0,
 			IsRolledDice:    0,
-			CanReRoll:       0,
-			OnBigRace:       0,
+			OnBigRace:       false,
 			HasBankrupt:     0,
 			AboutToBankrupt: "",
-			HasMlm:          0,
 			CreatedAt:       time.Time{},
 		}
 
@@ -0,0 +1,74 @@
+package service
+
+import (
+	logger "github.com/sirupsen/logrus"
+	"github.com/webjohny/cashflow-go/entity"
+	"github.com/webjohny/cashflow-go/objects"
+	"github.com/webjohny/cashflow-go/repository"
+	"sync"
+	"time"
+)
+
+const IdempotencyKeyTTL = 24 * time.Hour
+
+// IdempotencyService persists responses of requests sent with an Idempotency-Key. Keys being processed
+// are locked in memory, stored responses are shared through the database.
+type IdempotencyService interface {
+	Lock(key string) bool
+	Unlock(key string)
+	Get(key string) (objects.IdempotentResponse, bool)
+	Save(key string, response objects.IdempotentResponse)
+}
+
+type idempotencyService struct {
+	idempotencyRepository repository.IdempotencyRepository
+	pending               sync.Map
+}
+
+func NewIdempotencyService(idempotencyRepository repository.IdempotencyRepository) IdempotencyService {
+	return &idempotencyService{
+		idempotencyRepository: idempotencyRepository,
+	}
+}
+
+func (service *idempotencyService) Lock(key string) bool {
+	_, loaded := service.pending.LoadOrStore(key, true)
+
+	return !loaded
+}
+
+func (service *idempotencyService) Unlock(key string) {
+	service.pending.Delete(key)
+}
+
+func (service *idempotencyService) Get(key string) (objects.IdempotentResponse, bool) {
+	idempotencyKey := service.idempotencyRepository.FindByKey(key)
+
+	if idempotencyKey.ID == 0 || time.Since(idempotencyKey.CreatedAt) > IdempotencyKeyTTL {
+		return objects.IdempotentResponse{}, false
+	}
+
+	return objects.IdempotentResponse{
+		Fingerprint: idempotencyKey.Fingerprint,
+		Status:      idempotencyKey.Status,
+		Body:        idempotencyKey.Body,
+		CreatedAt:   idempotencyKey.CreatedAt,
+	}, true
+}
+
+func (service *idempotencyService) Save(key string, response objects.IdempotentResponse) {
+	logger.Info("IdempotencyService.Save", map[string]interface{}{
+		"key":    key,
+		"status": response.Status,
+	})
+
+	// Expired keys are dropped lazily, so an expired key can be stored again.
+	_ = service.idempotencyRepository.DeleteOlderThan(time.Now().Add(-IdempotencyKeyTTL))
+
+	_ = service.idempotencyRepository.InsertKey(&entity.IdempotencyKey{
+		Key:         key,
+		Fingerprint: response.Fingerprint,
+		Status:      response.Status,
+		Body:        response.Body,
+	})
+}
@@ -1,25 +1,28 @@
 package service
 
 import (
-	"errors"
 	logger "github.com/sirupsen/logrus"
+	"github.com/webjohny/cashflow-go/apperror"
 	"github.com/webjohny/cashflow-go/dto"
 	"github.com/webjohny/cashflow-go/entity"
 	"github.com/webjohny/cashflow-go/helper"
 	"github.com/webjohny/cashflow-go/repository"
-	"github.com/webjohny/cashflow-go/storage"
 	"log"
 	"time"
 )
 
 type LobbyService interface {
 	GetByID(lobbyId uint64) entity.Lobby
+	GetByGameId(gameId uint64) entity.Lobby
 	Create(username string, userId uint64) (error, entity.Lobby)
-	SetOptions(lobbyId uint64, body dto.SetOptionsLobbyRequestDTO) error
+	CreateSeated(moderator entity.LobbyPlayer, seats []entity.LobbyPlayer, options entity.RaceOptions) (error, entity.Lobby)
+	SetOptions(lobbyId uint64, userId uint64, options entity.RaceOptions) (error, entity.Lobby)
+	SetTeams(lobbyId uint64, userId uint64, teams []entity.LobbyTeam) (error, entity.Lobby)
 	Update(lobby *entity.Lobby) (error, entity.Lobby)
 	Leave(ID uint64, userId uint64) (error, entity.Lobby)
 	Cancel(ID uint64, userId uint64) (error, entity.Lobby)
 	Join(ID uint64, username string, userId uint64) (error, entity.LobbyPlayer)
+	Spectate(ID uint64, username string, userId uint64) (error, entity.LobbyPlayer)
 	GetLobby(lobbyId uint64, userId uint64) (error, dto.GetLobbyResponseDTO)
 	ChangeStatusByGameId(gameId uint64, status string) error
 	ChangeRoleByGameIdAndUserId(gameId uint64, userId uint64, role string) error
@@ -41,26 +44,102 @@ func (service *lobbyService) GetByID(lobbyId uint64) entity.Lobby {
 	return service.lobbyRepository.FindLobbyById(lobbyId)
 }
 
+func (service *lobbyService) GetByGameId(gameId uint64) entity.Lobby {
+	return service.lobbyRepository.FindLobbyByGameId(gameId)
+}
+
 func (service *lobbyService) Update(lobby *entity.Lobby) (error, entity.Lobby) {
 	return service.lobbyRepository.UpdateLobby(lobby)
 }
 
-func (service *lobbyService) SetOptions(lobbyId uint64, body dto.SetOptionsLobbyRequestDTO) error {
+// SetOptions replaces the options of the lobby, only its owner or moderators may change them before the start.
+func (service *lobbyService) SetOptions(lobbyId uint64, userId uint64, options entity.RaceOptions) (error, entity.Lobby) {
+	logger.Info("LobbyService.SetOptions", map[string]interface{}{
+		"lobbyId": lobbyId,
+		"userId":  userId,
+		"options": options,
+	})
+
 	lobby := service.lobbyRepository.FindLobbyById(lobbyId)
 
 	if lobby.ID == 0 {
-		return errors.New(storage.ErrorUndefinedLobby)
+		return apperror.ErrUndefinedLobby, entity.Lobby{}
+	}
+
+	if !lobby.IsManagedBy(userId) {
+		return apperror.ErrPermissionDenied, entity.Lobby{}
 	}
 
-	lobby.Options.HandMode = body.HandMode
-	lobby.Options.MeetLink = body.MeetLink
-	lobby.Options.BannerLink = body.BannerLink
-	lobby.Options.BannerImage = body.BannerImage
-	lobby.Options.Language = body.Language
+	if lobby.IsStarted() {
+		return apperror.ErrGameIsStarted, entity.Lobby{}
+	}
 
-	err, _ := service.Update(&lobby)
+	lobby.Options = options
 
-	return err
+	return service.Update(&lobby)
+}
+
+// SetTeams replaces the teams of the lobby, every team needs a unique name and players of the lobby who
+// do not watch the race and are in no other team, the first member leads a team without a captain.
+func (service *lobbyService) SetTeams(lobbyId uint64, userId uint64, teams []entity.LobbyTeam) (error, entity.Lobby) {
+	logger.Info("LobbyService.SetTeams", map[string]interface{}{
+		"lobbyId": lobbyId,
+		"userId":  userId,
+		"teams":   teams,
+	})
+
+	lobby := service.lobbyRepository.FindLobbyById(lobbyId)
+
+	if lobby.ID == 0 {
+		return apperror.ErrUndefinedLobby, entity.Lobby{}
+	}
+
+	if !lobby.IsManagedBy(userId) {
+		return apperror.ErrPermissionDenied, entity.Lobby{}
+	}
+
+	if lobby.IsStarted() {
+		return apperror.ErrGameIsStarted, entity.Lobby{}
+	}
+
+	if !lobby.Options.EnableTeams {
+		return apperror.ErrTeamModeDisabled, entity.Lobby{}
+	}
+
+	names := make(map[string]bool)
+	members := make(map[uint64]bool)
+
+	for i := range teams {
+		team := &teams[i]
+
+		if names[team.Name] || lobby.IsPlayerAlreadyJoined(team.Name) {
+			return apperror.ErrInvalidTeam.WithDetails(apperror.Details{"team": team.Name}), entity.Lobby{}
+		}
+
+		names[team.Name] = true
+
+		for _, member := range team.Members {
+			player := lobby.GetPlayer(member)
+
+			if player.ID == 0 || player.CanWatch() || members[member] {
+				return apperror.ErrInvalidTeam.WithDetails(apperror.Details{"team": team.Name}), entity.Lobby{}
+			}
+
+			members[member] = true
+		}
+
+		if team.CaptainID == 0 {
+			team.CaptainID = team.Members[0]
+		}
+
+		if !helper.Contains[uint64](team.Members, team.CaptainID) {
+			return apperror.ErrInvalidTeam.WithDetails(apperror.Details{"team": team.Name}), entity.Lobby{}
+		}
+	}
+
+	lobby.Teams = teams
+
+	return service.Update(&lobby)
 }
 
 func (service *lobbyService) ChangeRoleByGameIdAndUserId(gameId uint64, userId uint64, role string) error {
@@ -73,7 +152,7 @@ func (service *lobbyService) ChangeRoleByGameIdAndUserId(gameId uint64, userId u
 	lobby := service.lobbyRepository.FindLobbyByGameId(gameId)
 
 	if lobby.ID == 0 {
-		return errors.New(storage.ErrorUndefinedLobby)
+		return apperror.ErrUndefinedLobby
 	}
 
 	lobby.ChangePlayerRole(userId, role)
@@ -96,7 +175,7 @@ func (service *lobbyService) ChangeStatusByGameId(gameId uint64, status string)
 	lobby := service.lobbyRepository.FindLobbyByGameId(gameId)
 
 	if lobby.ID == 0 {
-		return errors.New(storage.ErrorUndefinedLobby)
+		return apperror.ErrUndefinedLobby
 	}
 
 	lobby.Status = status
@@ -120,13 +199,14 @@ func (service *lobbyService) GetLobby(lobbyId uint64, userId uint64) (error, dto
 	player := lobby.GetPlayer(userId)
 
 	if player.ID == 0 {
-		return errors.New(storage.ErrorUndefinedPlayer), dto.GetLobbyResponseDTO{}
+		return apperror.ErrUndefinedPlayer, dto.GetLobbyResponseDTO{}
 	}
 
 	response := dto.GetLobbyResponseDTO{
 		Username: player.Username,
 		You:      player,
 		Players:  lobby.Players,
+		Teams:    lobby.Teams,
 		Status:   lobby.Status,
 		LobbyId:  lobby.ID,
 		Options:  lobby.Options,
@@ -158,7 +238,50 @@ func (service *lobbyService) Create(username string, userId uint64) (error, enti
 	}
 
 	if instance.ID == 0 {
-		return errors.New(storage.ErrorUndefinedLobby), entity.Lobby{}
+		return apperror.ErrUndefinedLobby, entity.Lobby{}
+	}
+
+	return nil, instance
+}
+
+// CreateSeated creates a lobby with its players already seated, the moderator manages the lobby and owns it
+// when it has a seat too.
+func (service *lobbyService) CreateSeated(moderator entity.LobbyPlayer, seats []entity.LobbyPlayer, options entity.RaceOptions) (error, entity.Lobby) {
+	logger.Info("LobbyService.CreateSeated", map[string]interface{}{
+		"moderator": moderator,
+		"seats":     seats,
+	})
+
+	lobby := &entity.Lobby{
+		Players:    make([]entity.LobbyPlayer, 0),
+		MaxPlayers: LobbyMaxPlayers,
+		Status:     entity.LobbyStatus.New,
+		Options:    options,
+		CreatedAt:  time.Now(),
+	}
+
+	if len(seats) > LobbyMaxPlayers {
+		lobby.MaxPlayers = int8(len(seats))
+	}
+
+	for _, seat := range seats {
+		if seat.ID == moderator.ID {
+			lobby.AddOwner(seat.ID, seat.Username)
+		} else {
+			lobby.AddPlayer(seat.ID, seat.Username, entity.PlayerRoles.Player)
+		}
+	}
+
+	lobby.AddModerator(moderator.ID, moderator.Username)
+
+	err, instance := service.lobbyRepository.InsertLobby(lobby)
+
+	if err != nil {
+		return err, entity.Lobby{}
+	}
+
+	if instance.ID == 0 {
+		return apperror.ErrUndefinedLobby, entity.Lobby{}
 	}
 
 	return nil, instance
@@ -177,12 +300,26 @@ func (service *lobbyService) Join(ID uint64, username string, userId uint64) (er
 	log.Println("LobbyService.Join:", ID, username, userId)
 
 	if lobby.ID != 0 {
+		player = lobby.GetPlayer(userId)
+
+		if player.IsSpectator() && !lobby.IsStarted() && !lobby.IsFull() {
+			lobby.ChangePlayerRole(userId, entity.PlayerRoles.Guest)
+
+			err, _ := service.lobbyRepository.UpdateLobby(&lobby)
+
+			if err != nil {
+				return err, entity.LobbyPlayer{}
+			}
+
+			return nil, lobby.GetPlayer(userId)
+		}
+
 		if lobby.IsFull() {
-			return errors.New(storage.ErrorGameIsFull), entity.LobbyPlayer{}
+			return apperror.ErrGameIsFull, entity.LobbyPlayer{}
 		}
 
 		if !lobby.IsGameStarted() && lobby.IsStarted() {
-			return errors.New(storage.ErrorGameIsStarted), entity.LobbyPlayer{}
+			return apperror.ErrGameIsStarted, entity.LobbyPlayer{}
 		}
 
 		player = lobby.GetPlayer(userId)
@@ -192,8 +329,6 @@ func (service *lobbyService) Join(ID uint64, username string, userId uint64) (er
 		if player.ID == 0 {
 			if lobby.IsGameStarted() {
 				log.Println("LobbyService.Join.Waitlist:", ID, userId)
-				//@toDo add waitlist in game
-				//game.AddWaitList()
 				lobby.AddWaitList(userId, username)
 			} else if !lobby.IsStarted() {
 				log.Println("LobbyService.Join.Guest:", ID, userId)
@@ -210,12 +345,46 @@ func (service *lobbyService) Join(ID uint64, username string, userId uint64) (er
 			player = lobby.GetPlayer(userId)
 		}
 	} else {
-		return errors.New(storage.ErrorUndefinedLobby), entity.LobbyPlayer{}
+		return apperror.ErrUndefinedLobby, entity.LobbyPlayer{}
 	}
 
 	return nil, player
 }
 
+func (service *lobbyService) Spectate(ID uint64, username string, userId uint64) (error, entity.LobbyPlayer) {
+	logger.Info("LobbyService.Spectate", map[string]interface{}{
+		"lobbyId":  ID,
+		"username": username,
+		"userId":   userId,
+	})
+
+	lobby := service.lobbyRepository.FindLobbyById(ID)
+
+	if lobby.ID == 0 {
+		return apperror.ErrUndefinedLobby, entity.LobbyPlayer{}
+	}
+
+	if lobby.Status == entity.LobbyStatus.Cancelled {
+		return apperror.ErrUndefinedLobby, entity.LobbyPlayer{}
+	}
+
+	player := lobby.GetPlayer(userId)
+
+	if player.ID != 0 {
+		return nil, player
+	}
+
+	lobby.AddSpectator(userId, username)
+
+	err, _ := service.lobbyRepository.UpdateLobby(&lobby)
+
+	if err != nil {
+		return err, entity.LobbyPlayer{}
+	}
+
+	return nil, lobby.GetPlayer(userId)
+}
+
 func (service *lobbyService) Leave(ID uint64, userId uint64) (error, entity.Lobby) {
 	logger.Info("LobbyService.Leave", map[string]interface{}{
 		"lobbyId": ID,
@@ -244,7 +413,7 @@ func (service *lobbyService) Leave(ID uint64, userId uint64) (error, entity.Lobb
 		return nil, lobby
 	}
 
-	return errors.New(storage.ErrorUndefinedLobby), entity.Lobby{}
+	return apperror.ErrUndefinedLobby, entity.Lobby{}
 }
 
 func (service *lobbyService) Cancel(ID uint64, userId uint64) (error, entity.Lobby) {
@@ -267,5 +436,5 @@ func (service *lobbyService) Cancel(ID uint64, userId uint64) (error, entity.Lob
 		return nil, lobby
 	}
 
-	return errors.New(storage.ErrorUndefinedLobby), entity.Lobby{}
+	return apperror.ErrUndefinedLobby, entity.Lobby{}
 }
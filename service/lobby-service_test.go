@@ -20,7 +20,7 @@ func TestCreatingLobby(t *testing.T) {
 		Players:    make([]entity.LobbyPlayer, 0),
 		MaxPlayers: service.LobbyMaxPlayers,
 		Status:     entity.LobbyStatus.New,
-		Options:    make(map[string]interface{}),
+		Options:    entity.RaceOptions{},
 		CreatedAt:  time.Now(),
 	}
 
@@ -32,8 +32,8 @@ func TestCreatingLobby(t *testing.T) {
 		Color:    helper.PickColor(),
 	}
 
-	lobbyRepo.InsertLobbyFunc = func(l *entity.Lobby) entity.Lobby {
-		return entity.Lobby{
+	lobbyRepo.InsertLobbyFunc = func(l *entity.Lobby) (error, entity.Lobby) {
+		return nil, entity.Lobby{
 			ID: lobbyDefault.ID,
 			Players: []entity.LobbyPlayer{{
 				Username: userOwner.Username,
@@ -75,7 +75,7 @@ func TestJoinToLobby(t *testing.T) {
 		Players:    make([]entity.LobbyPlayer, 0),
 		MaxPlayers: service.LobbyMaxPlayers,
 		Status:     entity.LobbyStatus.New,
-		Options:    make(map[string]interface{}),
+		Options:    entity.RaceOptions{},
 		CreatedAt:  time.Now(),
 	}
 
@@ -97,8 +97,8 @@ func TestJoinToLobby(t *testing.T) {
 		Color:    helper.PickColor(),
 	}
 
-	lobbyRepo.InsertLobbyFunc = func(l *entity.Lobby) entity.Lobby {
-		return entity.Lobby{
+	lobbyRepo.InsertLobbyFunc = func(l *entity.Lobby) (error, entity.Lobby) {
+		return nil, entity.Lobby{
 			ID: lobbyDefault.ID,
 			Players: []entity.LobbyPlayer{{
 				Username: userOwner.Username,
@@ -143,8 +143,8 @@ func TestJoinToLobby(t *testing.T) {
 			MaxPlayers: lobby.MaxPlayers,
 			Players:    lobby.Players,
 			Status:     entity.LobbyStatus.Started,
-			Options: map[string]interface{}{
-				"enable_wait_list": true,
+			Options: entity.RaceOptions{
+				EnableWaitList: true,
 			},
 		}
 
@@ -0,0 +1,102 @@
+package service
+
+import (
+	logger "github.com/sirupsen/logrus"
+	"github.com/webjohny/cashflow-go/apperror"
+	"github.com/webjohny/cashflow-go/dto"
+	"github.com/webjohny/cashflow-go/entity"
+)
+
+type MarketService interface {
+	GetMarket(raceId uint64, userId uint64) (error, dto.MarketResponseDTO)
+	GetChart(raceId uint64, userId uint64, symbol string) (error, entity.MarketSymbol)
+	GetPortfolio(raceId uint64, userId uint64) (error, dto.PortfolioResponseDTO)
+	Valuate(market entity.RaceMarket, player entity.Player) dto.PortfolioResponseDTO
+}
+
+type marketService struct {
+	raceService RaceService
+}
+
+func NewMarketService(raceService RaceService) MarketService {
+	return &marketService{
+		raceService: raceService,
+	}
+}
+
+func (service *marketService) GetMarket(raceId uint64, userId uint64) (error, dto.MarketResponseDTO) {
+	err, race, _ := service.raceService.GetRaceAndPlayer(raceId, userId)
+
+	if err != nil {
+		return err, dto.MarketResponseDTO{}
+	}
+
+	symbols := race.Market.List()
+
+	for i := range symbols {
+		symbols[i].History = nil
+	}
+
+	return nil, dto.MarketResponseDTO{Symbols: symbols}
+}
+
+func (service *marketService) GetChart(raceId uint64, userId uint64, symbol string) (error, entity.MarketSymbol) {
+	logger.Info("MarketService.GetChart", map[string]interface{}{
+		"raceId": raceId,
+		"userId": userId,
+		"symbol": symbol,
+	})
+
+	err, race, _ := service.raceService.GetRaceAndPlayer(raceId, userId)
+
+	if err != nil {
+		return err, entity.MarketSymbol{}
+	}
+
+	stock, ok := race.Market.Symbols[symbol]
+
+	if !ok {
+		return apperror.ErrUndefinedStockSymbol, entity.MarketSymbol{}
+	}
+
+	return nil, stock
+}
+
+func (service *marketService) GetPortfolio(raceId uint64, userId uint64) (error, dto.PortfolioResponseDTO) {
+	err, race, player := service.raceService.GetRaceAndPlayer(raceId, userId)
+
+	if err != nil {
+		return err, dto.PortfolioResponseDTO{}
+	}
+
+	if player.ID == 0 {
+		return apperror.ErrUndefinedPlayer, dto.PortfolioResponseDTO{}
+	}
+
+	return nil, service.Valuate(race.Market, player)
+}
+
+// Valuate prices the player's stocks at the race market, symbols never traded in the race keep their last price.
+func (service *marketService) Valuate(market entity.RaceMarket, player entity.Player) dto.PortfolioResponseDTO {
+	portfolio := dto.PortfolioResponseDTO{
+		Holdings: []dto.PortfolioHoldingDTO{},
+	}
+
+	for _, stock := range player.Assets.Stocks {
+		holding := dto.PortfolioHoldingDTO{
+			Symbol: stock.Symbol,
+			Count:  stock.Count,
+			Cost:   stock.GetCost(),
+			Price:  market.PriceOf(stock.Symbol, stock.GetLastPrice()),
+			Value:  market.Value(stock),
+		}
+		holding.Gain = holding.Value - holding.Cost
+
+		portfolio.Holdings = append(portfolio.Holdings, holding)
+		portfolio.Cost += holding.Cost
+		portfolio.Value += holding.Value
+		portfolio.Gain += holding.Gain
+	}
+
+	return portfolio
+}
@@ -0,0 +1,57 @@
+package service
+
+import (
+	logger "github.com/sirupsen/logrus"
+	"github.com/webjohny/cashflow-go/entity"
+	"github.com/webjohny/cashflow-go/helper"
+	"github.com/webjohny/cashflow-go/repository"
+)
+
+type ModeratorAuditService interface {
+	Record(audit entity.ModeratorAudit, before interface{}, after interface{}) error
+	GetAudit(raceId uint64, playerId uint64) []entity.ModeratorAudit
+}
+
+type moderatorAuditService struct {
+	moderatorAuditRepository repository.ModeratorAuditRepository
+}
+
+func NewModeratorAuditService(moderatorAuditRepository repository.ModeratorAuditRepository) ModeratorAuditService {
+	return &moderatorAuditService{
+		moderatorAuditRepository: moderatorAuditRepository,
+	}
+}
+
+// Record stores the intervention with the diff between the JSON of the player or the race before and after it.
+func (service *moderatorAuditService) Record(audit entity.ModeratorAudit, before interface{}, after interface{}) error {
+	audit.Changes = helper.JsonDiff(before, after)
+
+	logger.Info("ModeratorAuditService.Record", map[string]interface{}{
+		"raceId":      audit.RaceID,
+		"playerId":    audit.PlayerID,
+		"moderatorId": audit.ModeratorID,
+		"action":      audit.Action,
+		"changes":     len(audit.Changes),
+	})
+
+	err, _ := service.moderatorAuditRepository.InsertModeratorAudit(&audit)
+
+	return err
+}
+
+// GetAudit returns the interventions in the race, only the ones on the player when playerId is set.
+func (service *moderatorAuditService) GetAudit(raceId uint64, playerId uint64) []entity.ModeratorAudit {
+	var audits []entity.ModeratorAudit
+
+	if playerId != 0 {
+		audits = service.moderatorAuditRepository.AllByRaceIdAndPlayerId(raceId, playerId)
+	} else {
+		audits = service.moderatorAuditRepository.AllByRaceId(raceId)
+	}
+
+	if audits == nil {
+		audits = []entity.ModeratorAudit{}
+	}
+
+	return audits
+}
@@ -0,0 +1,286 @@
+package service
+
+import (
+	logger "github.com/sirupsen/logrus"
+	"github.com/webjohny/cashflow-go/apperror"
+	"github.com/webjohny/cashflow-go/dto"
+	"github.com/webjohny/cashflow-go/entity"
+	"github.com/webjohny/cashflow-go/helper"
+	"github.com/webjohny/cashflow-go/repository"
+	"sync"
+	"time"
+)
+
+type NotificationService interface {
+	Notify(notification entity.Notification) (error, entity.Notification)
+	Persist(player entity.Player)
+	GetNotifications(raceId uint64, userId uint64, cursor uint64, limit int) (error, dto.GetNotificationsResponseDTO)
+	MarkRead(raceId uint64, userId uint64, notificationId uint64) error
+	MarkReadByUID(player entity.Player, UID string) error
+	MarkAllRead(raceId uint64, userId uint64) error
+	Subscribe(raceId uint64, userId uint64) (error, *NotificationSubscription)
+	Unsubscribe(subscription *NotificationSubscription)
+}
+
+const (
+	NotificationPageLimit    = 50
+	NotificationPageMaxLimit = 100
+	NotificationBufferSize   = 16
+)
+
+// NotificationSubscription receives the notifications of the player while a real-time connection is open.
+type NotificationSubscription struct {
+	Player        entity.Player
+	Notifications chan entity.Notification
+}
+
+type notificationService struct {
+	notificationRepository repository.NotificationRepository
+	playerRepository       repository.PlayerRepository
+	subscriptions          map[uint64]map[*NotificationSubscription]bool
+	mutex                  sync.Mutex
+}
+
+func NewNotificationService(notificationRepository repository.NotificationRepository, playerRepository repository.PlayerRepository) NotificationService {
+	return &notificationService{
+		notificationRepository: notificationRepository,
+		playerRepository:       playerRepository,
+		subscriptions:          make(map[uint64]map[*NotificationSubscription]bool),
+	}
+}
+
+func (service *notificationService) Notify(notification entity.Notification) (error, entity.Notification) {
+	logger.Info("NotificationService.Notify", map[string]interface{}{
+		"raceId":   notification.RaceID,
+		"playerId": notification.PlayerID,
+		"role":     notification.Role,
+		"message":  notification.Message,
+	})
+
+	if notification.UID == "" {
+		notification.UID = helper.Uuid("n")
+	}
+
+	err, notification := service.notificationRepository.InsertNotification(&notification)
+
+	if err != nil {
+		return err, entity.Notification{}
+	}
+
+	service.publish(notification)
+
+	return nil, notification
+}
+
+// Persist keeps the notifications shown on the player so they stay in the center after being dismissed.
+func (service *notificationService) Persist(player entity.Player) {
+	if player.ID == 0 || len(player.Notifications) == 0 {
+		return
+	}
+
+	UIDs := make([]string, 0)
+
+	for _, notification := range player.Notifications {
+		UIDs = append(UIDs, notification.ID)
+	}
+
+	existing := service.notificationRepository.FindExistingUIDs(UIDs)
+
+	for _, notification := range player.Notifications {
+		if helper.Contains[string](existing, notification.ID) {
+			continue
+		}
+
+		if err, _ := service.Notify(entity.NewPlayerNotification(player, notification)); err != nil {
+			logger.Error("NotificationService.Persist", err, player.ID, notification.ID)
+		}
+	}
+}
+
+func (service *notificationService) GetNotifications(raceId uint64, userId uint64, cursor uint64, limit int) (error, dto.GetNotificationsResponseDTO) {
+	logger.Info("NotificationService.GetNotifications", map[string]interface{}{
+		"raceId": raceId,
+		"userId": userId,
+		"cursor": cursor,
+		"limit":  limit,
+	})
+
+	err, player := service.getPlayer(raceId, userId)
+
+	if err != nil {
+		return err, dto.GetNotificationsResponseDTO{}
+	}
+
+	if limit <= 0 {
+		limit = NotificationPageLimit
+	} else if limit > NotificationPageMaxLimit {
+		limit = NotificationPageMaxLimit
+	}
+
+	notifications := service.notificationRepository.GetPlayerNotifications(player, cursor, limit+1)
+
+	response := dto.GetNotificationsResponseDTO{
+		Notifications: make([]dto.NotificationDTO, 0),
+		Unread:        len(service.notificationRepository.GetUnreadIds(player)),
+	}
+
+	if len(notifications) > limit {
+		notifications = notifications[:limit]
+		response.HasMore = true
+	}
+
+	ids := make([]uint64, 0)
+
+	for _, notification := range notifications {
+		ids = append(ids, notification.ID)
+	}
+
+	readAt := make(map[uint64]time.Time)
+
+	for _, receipt := range service.notificationRepository.GetReceipts(player.ID, ids) {
+		readAt[receipt.NotificationID] = receipt.ReadAt
+	}
+
+	for _, notification := range notifications {
+		item := dto.NotificationDTO{Notification: notification}
+
+		if at, ok := readAt[notification.ID]; ok {
+			item.Read = true
+			item.ReadAt = &at
+		}
+
+		response.Notifications = append(response.Notifications, item)
+	}
+
+	if len(notifications) > 0 {
+		response.NextCursor = notifications[len(notifications)-1].ID
+	}
+
+	return nil, response
+}
+
+func (service *notificationService) MarkRead(raceId uint64, userId uint64, notificationId uint64) error {
+	err, player := service.getPlayer(raceId, userId)
+
+	if err != nil {
+		return err
+	}
+
+	notification := service.notificationRepository.FindNotificationById(notificationId)
+
+	if notification.ID == 0 || !notification.IsFor(player) {
+		return apperror.ErrUndefinedNotification
+	}
+
+	return service.markRead(player, notification.ID)
+}
+
+func (service *notificationService) MarkReadByUID(player entity.Player, UID string) error {
+	notification := service.notificationRepository.FindNotificationByUID(UID)
+
+	if notification.ID == 0 || !notification.IsFor(player) {
+		return nil
+	}
+
+	return service.markRead(player, notification.ID)
+}
+
+func (service *notificationService) MarkAllRead(raceId uint64, userId uint64) error {
+	logger.Info("NotificationService.MarkAllRead", map[string]interface{}{
+		"raceId": raceId,
+		"userId": userId,
+	})
+
+	err, player := service.getPlayer(raceId, userId)
+
+	if err != nil {
+		return err
+	}
+
+	return service.markRead(player, service.notificationRepository.GetUnreadIds(player)...)
+}
+
+func (service *notificationService) Subscribe(raceId uint64, userId uint64) (error, *NotificationSubscription) {
+	err, player := service.getPlayer(raceId, userId)
+
+	if err != nil {
+		return err, nil
+	}
+
+	subscription := &NotificationSubscription{
+		Player:        player,
+		Notifications: make(chan entity.Notification, NotificationBufferSize),
+	}
+
+	service.mutex.Lock()
+	defer service.mutex.Unlock()
+
+	if service.subscriptions[raceId] == nil {
+		service.subscriptions[raceId] = make(map[*NotificationSubscription]bool)
+	}
+
+	service.subscriptions[raceId][subscription] = true
+
+	return nil, subscription
+}
+
+func (service *notificationService) Unsubscribe(subscription *NotificationSubscription) {
+	service.mutex.Lock()
+	defer service.mutex.Unlock()
+
+	raceId := subscription.Player.RaceID
+
+	if _, ok := service.subscriptions[raceId][subscription]; !ok {
+		return
+	}
+
+	delete(service.subscriptions[raceId], subscription)
+	close(subscription.Notifications)
+
+	if len(service.subscriptions[raceId]) == 0 {
+		delete(service.subscriptions, raceId)
+	}
+}
+
+// publish pushes the notification to the open connections of its recipients, a slow connection misses it
+// and picks it up from the center instead.
+func (service *notificationService) publish(notification entity.Notification) {
+	service.mutex.Lock()
+	defer service.mutex.Unlock()
+
+	for subscription := range service.subscriptions[notification.RaceID] {
+		if !notification.IsFor(subscription.Player) {
+			continue
+		}
+
+		select {
+		case subscription.Notifications <- notification:
+		default:
+		}
+	}
+}
+
+func (service *notificationService) markRead(player entity.Player, notificationIds ...uint64) error {
+	receipts := make([]entity.NotificationReceipt, 0)
+	now := time.Now()
+
+	for _, notificationId := range notificationIds {
+		receipts = append(receipts, entity.NotificationReceipt{
+			NotificationID: notificationId,
+			PlayerID:       player.ID,
+			ReadAt:         now,
+		})
+	}
+
+	return service.notificationRepository.InsertReceipts(receipts)
+}
+
+func (service *notificationService) getPlayer(raceId uint64, userId uint64) (error, entity.Player) {
+	player := service.playerRepository.FindPlayerByUserIdAndRaceId(raceId, userId)
+
+	if player.ID == 0 {
+		return apperror.ErrUndefinedPlayer, entity.Player{}
+	}
+
+	return nil, player
+}
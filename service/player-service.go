@@ -2,11 +2,12 @@ package service
 
 import (
 	"errors"
-	"fmt"
 	logger "github.com/sirupsen/logrus"
+	"github.com/webjohny/cashflow-go/apperror"
 	"github.com/webjohny/cashflow-go/dto"
 	"github.com/webjohny/cashflow-go/entity"
 	"github.com/webjohny/cashflow-go/helper"
+	"github.com/webjohny/cashflow-go/i18n"
 	"github.com/webjohny/cashflow-go/repository"
 	"github.com/webjohny/cashflow-go/storage"
 	"strconv"
@@ -15,16 +16,22 @@ import (
 )
 
 type playerService struct {
-	playerRepository   repository.PlayerRepository
-	professionService  ProfessionService
-	transactionService TransactionService
+	playerRepository      repository.PlayerRepository
+	sharedAssetRepository repository.SharedAssetRepository
+	professionService     ProfessionService
+	transactionService    TransactionService
+	chatService           ChatService
+	notificationService   NotificationService
 }
 
-func NewPlayerService(playerRepo repository.PlayerRepository, professionService ProfessionService, transactionService TransactionService) PlayerService {
+func NewPlayerService(playerRepo repository.PlayerRepository, sharedAssetRepo repository.SharedAssetRepository, professionService ProfessionService, transactionService TransactionService, chatService ChatService, notificationService NotificationService) PlayerService {
 	return &playerService{
-		playerRepository:   playerRepo,
-		professionService:  professionService,
-		transactionService: transactionService,
+		playerRepository:      playerRepo,
+		sharedAssetRepository: sharedAssetRepo,
+		professionService:     professionService,
+		transactionService:    transactionService,
+		chatService:           chatService,
+		notificationService:   notificationService,
 	}
 }
 
@@ -33,13 +40,35 @@ func (service *playerService) Payday(player entity.Player, card entity.Card) err
 		"playerId": player.ID,
 	})
 
-	return service.UpdateCash(&player, player.CalculateCashFlow(), &dto.TransactionDTO{
+	return service.PayPaydays(player, player.CalculateCashFlow(), 1, &dto.TransactionDTO{
 		CardID:   card.ID,
 		CardType: entity.TransactionCardType.Payday,
 		Details:  card.Heading,
 	})
 }
 
+// PayPaydays is the payday step of every way a salary is paid: the loans are served and the insurance
+// premiums charged once for each of the paydays the amount covers.
+func (service *playerService) PayPaydays(player entity.Player, amount int, paydays int, transaction *dto.TransactionDTO) error {
+	logger.Info("PlayerService.PayPaydays", map[string]interface{}{
+		"playerId": player.ID,
+		"amount":   amount,
+		"paydays":  paydays,
+	})
+
+	for i := 0; i < paydays; i++ {
+		player.ServeLoans()
+	}
+
+	err := service.UpdateCash(&player, amount, transaction)
+
+	for i := 0; i < paydays && err == nil; i++ {
+		err = service.chargeInsurancePremiums(&player, transaction.CardID, transaction.Details)
+	}
+
+	return err
+}
+
 func (service *playerService) CashFlowDay(player entity.Player, card entity.Card) error {
 	logger.Info("PlayerService.CashFlowDay", map[string]interface{}{
 		"playerId": player.ID,
@@ -61,37 +90,35 @@ func (service *playerService) AreYouBankrupt(player entity.Player) error {
 			"playerId": player.ID,
 		})
 
+		// The shared assets are settled first as that updates the partners, the players are read afterwards
+		// and saved once so that no stale copy overwrites them.
+		shared := service.leaveSharedAssets(player)
 		players := service.GetAllPlayersByRaceId(player.RaceID)
 
-		businesses := player.Assets.Business
-		realEstates := player.Assets.RealEstates
-		otherAssets := player.Assets.OtherAssets
+		for i := range players {
+			anotherPlayer := &players[i]
 
-		for _, business := range businesses {
-			if business.IsOwner {
-				for _, anotherPlayer := range players {
+			for _, business := range player.Assets.Business {
+				if business.IsOwner && !shared[business.ID] {
 					anotherPlayer.RemoveBusiness(business.ID)
-					_, _ = service.UpdatePlayer(&anotherPlayer)
 				}
 			}
-		}
 
-		for _, realEs := range realEstates {
-			if realEs.IsOwner {
-				for _, anotherPlayer := range players {
+			for _, realEs := range player.Assets.RealEstates {
+				if realEs.IsOwner && !shared[realEs.ID] {
 					anotherPlayer.RemoveRealEstate(realEs.ID)
-					_, _ = service.UpdatePlayer(&anotherPlayer)
 				}
 			}
-		}
 
-		for _, asset := range otherAssets {
-			if asset.IsOwner {
-				for _, anotherPlayer := range players {
+			for _, asset := range player.Assets.OtherAssets {
+				if asset.IsOwner && !shared[asset.ID] {
 					anotherPlayer.RemoveOtherAssetsByID(asset.ID)
-					_, _ = service.UpdatePlayer(&anotherPlayer)
 				}
 			}
+
+			if anotherPlayer.ID != player.ID {
+				_, _ = service.UpdatePlayer(anotherPlayer)
+			}
 		}
 
 		_, profession := service.professionService.GetRandomProfession(player.Info.Language, &[]int{
@@ -101,7 +128,7 @@ func (service *playerService) AreYouBankrupt(player entity.Player) error {
 		player.HasBankrupt = 1
 		player.Info.Data = entity.PlayerInfoData{}
 
-		err, _ := service.playerRepository.UpdatePlayer(&player)
+		err, _ := service.UpdatePlayer(&player)
 
 		if err != nil {
 			logger.Error(err)
@@ -109,7 +136,11 @@ func (service *playerService) AreYouBankrupt(player entity.Player) error {
 			return err
 		}
 
-		return errors.New(storage.ErrorYouAreBankrupt)
+		_ = service.chatService.SendSystemMessage(player.RaceID, storage.MessagePlayerWentBankrupt, map[string]interface{}{
+			"username": player.Username,
+		})
+
+		return apperror.ErrYouAreBankrupt
 	}
 
 	return nil
@@ -134,7 +165,7 @@ func (service *playerService) Doodad(card entity.CardDoodad, player entity.Playe
 	cost := card.Cost
 
 	if player.Cash < cost {
-		return errors.New(storage.ErrorNotEnoughMoney)
+		return apperror.ErrNotEnoughMoney
 	}
 
 	transaction := dto.TransactionDTO{
@@ -146,7 +177,7 @@ func (service *playerService) Doodad(card entity.CardDoodad, player entity.Playe
 	}
 
 	if trx := service.GetTransaction(transaction); trx.ID != 0 {
-		return errors.New(storage.ErrorTransactionAlreadyExists)
+		return apperror.ErrTransactionAlreadyExists
 	}
 
 	err := service.UpdateCash(&player, -cost, &transaction)
@@ -156,7 +187,7 @@ func (service *playerService) Doodad(card entity.CardDoodad, player entity.Playe
 	}
 
 	if card.AssetType == entity.OtherAssetTypes.HealthyInsurance && !player.HasHealthyInsurance() {
-		player.SetNotification(storage.ErrorIfHadBeenInsurance, entity.NotificationTypes.Warning)
+		player.SetNotification(apperror.CodeIfHadBeenInsurance, entity.NotificationTypes.Warning)
 		err, _ = service.UpdatePlayer(&player)
 		return err
 	}
@@ -173,7 +204,7 @@ func (service *playerService) BuyDream(card entity.CardDream, player entity.Play
 	cost := card.Cost
 
 	if player.Cash < cost {
-		return errors.New(storage.ErrorNotEnoughMoney)
+		return apperror.ErrNotEnoughMoney
 	}
 
 	player.Assets.Dreams = append(player.Assets.Dreams, card)
@@ -192,7 +223,7 @@ func (service *playerService) BuyLottery(card entity.CardLottery, player entity.
 	})
 
 	if card.Cost > player.Cash {
-		return errors.New(storage.ErrorNotEnoughMoney), false
+		return apperror.ErrNotEnoughMoney, false
 	}
 
 	if helper.Contains[int](card.Success, dice) {
@@ -242,15 +273,15 @@ func (service *playerService) SellOtherAssets(ID string, card entity.CardMarketO
 	_, asset := player.FindOtherAssetsByID(ID)
 
 	if asset.ID == "" {
-		return errors.New(storage.ErrorNotFoundAssets)
+		return apperror.ErrNotFoundAssets
 	}
 
 	if !asset.IsOwner {
-		return errors.New(storage.ErrorForbiddenByOwner)
+		return apperror.ErrForbiddenByOwner
 	}
 
 	if asset.Count < count {
-		return errors.New(storage.ErrorNotEnoughAsset)
+		return apperror.ErrNotEnoughAsset
 	}
 
 	var totalCost = card.Cost
@@ -264,12 +295,20 @@ func (service *playerService) SellOtherAssets(ID string, card entity.CardMarketO
 		player.RemoveOtherAssetsByID(ID)
 	}
 
+	transaction := dto.TransactionDTO{
+		CardID:   card.ID,
+		CardType: entity.TransactionCardType.MarketOther,
+		Details:  card.Heading,
+	}
+
+	if asset.AssetType != entity.OtherAssetTypes.Piece {
+		if ledger := service.GetSharedAsset(player.RaceID, ID); ledger.ID != 0 {
+			return service.sellSharedAsset(ledger, &player, totalCost, transaction)
+		}
+	}
+
 	if totalCost > 0 {
-		err := service.UpdateCash(&player, totalCost, &dto.TransactionDTO{
-			CardID:   card.ID,
-			CardType: entity.TransactionCardType.MarketOther,
-			Details:  card.Heading,
-		})
+		err := service.UpdateCash(&player, totalCost, &transaction)
 
 		if err != nil {
 			return err
@@ -299,6 +338,71 @@ func (service *playerService) SellOtherAssets(ID string, card entity.CardMarketO
 	return nil
 }
 
+// SellAssetToBank sells the whole asset for the amount the seller receives. The partners of a shared asset get
+// their part of it, the copies other players hold without owning it are removed.
+func (service *playerService) SellAssetToBank(assetType string, ID string, amount int, player entity.Player, data dto.TransactionDTO) error {
+	logger.Info("PlayerService.SellAssetToBank", map[string]interface{}{
+		"playerId":  player.ID,
+		"assetType": assetType,
+		"assetId":   ID,
+		"amount":    amount,
+	})
+
+	isOwner := isAssetOwner(player, assetType, ID)
+	heading, ok := player.RemoveAssetByID(assetType, ID)
+
+	if !ok {
+		return apperror.ErrNotFoundTheAsset
+	}
+
+	if !isOwner {
+		return apperror.ErrForbiddenByOwner
+	}
+
+	if data.Details == "" {
+		data.Details = heading
+	}
+
+	if ledger := service.GetSharedAsset(player.RaceID, ID); ledger.ID != 0 {
+		return service.sellSharedAsset(ledger, &player, amount, data)
+	}
+
+	if err := service.UpdateCash(&player, amount, &data); err != nil {
+		return err
+	}
+
+	for _, user := range service.GetAllPlayersByRaceId(player.RaceID) {
+		if user.ID == player.ID || isAssetOwner(user, assetType, ID) {
+			continue
+		}
+
+		if _, ok := user.RemoveAssetByID(assetType, ID); ok {
+			if err, _ := service.UpdatePlayer(&user); err != nil {
+				logger.Error("SellAssetToBank.UpdatePlayer", err, ID, user.ID, user.RaceID)
+			}
+		}
+	}
+
+	return service.AreYouBankrupt(player)
+}
+
+func isAssetOwner(player entity.Player, assetType string, ID string) bool {
+	switch assetType {
+	case entity.TxTypes.RealEstate:
+		return player.FindRealEstateByID(ID).IsOwner
+	case entity.TxTypes.Business:
+		_, business := player.FindBusinessByID(ID)
+
+		return business.IsOwner
+	case entity.TxTypes.Other:
+		_, other := player.FindOtherAssetsByID(ID)
+
+		return other.IsOwner
+	}
+
+	return false
+}
+
 func (service *playerService) Charity(card entity.CardCharity, player entity.Player) error {
 	logger.Info("PlayerService.Charity", map[string]interface{}{
 		"playerId": player.ID,
@@ -311,7 +415,7 @@ func (service *playerService) Charity(card entity.CardCharity, player entity.Pla
 	}
 
 	if player.Cash < amount {
-		return errors.New(storage.ErrorNotEnoughMoney)
+		return apperror.ErrNotEnoughMoney
 	}
 
 	player.DualDiceCount += card.Limit
@@ -360,8 +464,8 @@ func (service *playerService) Downsized(player entity.Player, card entity.Card)
 
 	amount := player.CalculateTotalExpenses()
 
-	if player.Cash < amount {
-		return errors.New(storage.ErrorNotEnoughMoney)
+	if player.Cash+player.InsuranceCover(entity.InsuranceTypes.Income, amount) < amount {
+		return apperror.ErrNotEnoughMoney
 	}
 
 	err := service.UpdateCash(&player, -amount, &dto.TransactionDTO{
@@ -374,6 +478,10 @@ func (service *playerService) Downsized(player entity.Player, card entity.Card)
 		return err
 	}
 
+	if err = service.claimInsurance(&player, entity.InsuranceTypes.Income, card.ID, card.Heading, amount); err != nil {
+		return err
+	}
+
 	return service.AreYouBankrupt(player)
 }
 
@@ -395,7 +503,7 @@ func (service *playerService) BornBaby(player entity.Player, card entity.Card) (
 	}
 
 	if trx := service.GetTransaction(transaction); trx.ID != 0 {
-		return errors.New(storage.ErrorTransactionAlreadyExists), false
+		return apperror.ErrTransactionAlreadyExists, false
 	}
 
 	var err error
@@ -440,7 +548,7 @@ func (service *playerService) SetPlayerData(raceId uint64, userId uint64, dto en
 	err, player := service.GetPlayerByUserIdAndRaceId(raceId, userId)
 
 	if err != nil {
-		return errors.New(storage.ErrorUndefinedPlayer)
+		return apperror.ErrUndefinedPlayer
 	}
 
 	player.Info.Data = dto
@@ -450,13 +558,27 @@ func (service *playerService) SetPlayerData(raceId uint64, userId uint64, dto en
 	return err
 }
 
+func (service *playerService) GetBigRaceProgress(raceId uint64, userId uint64) (error, dto.BigRaceProgressResponseDTO) {
+	err, player := service.GetPlayerByUserIdAndRaceId(raceId, userId)
+
+	if err != nil {
+		return err, dto.BigRaceProgressResponseDTO{}
+	}
+
+	return nil, dto.BigRaceProgressResponseDTO{
+		Rules:     player.BigRaceProgress(),
+		Allowed:   player.ConditionsForBigRace(),
+		OnBigRace: player.OnBigRace,
+	}
+}
+
 func (service *playerService) MoveOnBigRace(player entity.Player) error {
 	logger.Info("PlayerService.MoveOnBigRace", map[string]interface{}{
 		"playerId": player.ID,
 	})
 
 	if !player.ConditionsForBigRace() {
-		return errors.New(storage.ErrorMovingBigRaceDeclined)
+		return apperror.ErrMovingBigRaceDeclined
 	}
 
 	cashFlow := player.CalculatePassiveIncome() * 100
@@ -486,7 +608,7 @@ func (service *playerService) MoveOnBigRace(player entity.Player) error {
 		Dreams:      make([]entity.CardDream, 0),
 	}
 
-	err, _ := service.playerRepository.UpdatePlayer(&player)
+	err, _ := service.UpdatePlayer(&player)
 
 	return err
 }
@@ -501,14 +623,14 @@ func (service *playerService) SetDream(raceId uint64, userId uint64, playerDream
 	anotherPlayer := service.playerRepository.FindPlayerByRaceIdAndInfoDreamId(raceId, playerDream.ID)
 
 	if anotherPlayer.ID != 0 {
-		return errors.New(storage.ErrorDreamPlaceHasAlreadyTaken)
+		return apperror.ErrDreamPlaceHasAlreadyTaken
 	}
 
 	err, player := service.GetPlayerByUserIdAndRaceId(raceId, userId)
 
 	player.Info.Dream = playerDream
 
-	err, _ = service.playerRepository.UpdatePlayer(&player)
+	err, _ = service.UpdatePlayer(&player)
 
 	return err
 }
@@ -520,10 +642,6 @@ func (service *playerService) MarketDamage(card entity.CardMarket, player entity
 	})
 
 	if player.HasOwnRealEstates() {
-		if player.Cash < card.Cost {
-			return errors.New(storage.ErrorNotEnoughMoney)
-		}
-
 		realEstates := player.Assets.RealEstates
 
 		if card.Symbol != "ANY" {
@@ -545,6 +663,10 @@ func (service *playerService) MarketDamage(card entity.CardMarket, player entity
 			cost = card.Cost * len(realEstates)
 		}
 
+		if player.Cash+player.InsuranceCover(entity.InsuranceTypes.Property, cost) < cost {
+			return apperror.ErrNotEnoughMoney
+		}
+
 		err := service.UpdateCash(&player, -cost, &dto.TransactionDTO{
 			CardID:   card.ID,
 			CardType: entity.TransactionCardType.Damage,
@@ -554,6 +676,8 @@ func (service *playerService) MarketDamage(card entity.CardMarket, player entity
 		if err != nil {
 			return err
 		}
+
+		return service.claimInsurance(&player, entity.InsuranceTypes.Property, card.ID, card.Heading, cost)
 	}
 
 	return nil
@@ -565,6 +689,8 @@ func (service *playerService) MarketManipulation(card entity.CardMarket, player
 		"card":     card,
 	})
 
+	loss := 0
+
 	if card.Type == "inflation" {
 		realEstates := player.Assets.RealEstates
 
@@ -586,6 +712,12 @@ func (service *playerService) MarketManipulation(card entity.CardMarket, player
 
 			player.RemoveRealEstate(asset.ID)
 
+			if asset.DownPayment > 0 {
+				loss += asset.DownPayment
+			} else {
+				loss += asset.Cost - asset.Mortgage
+			}
+
 			for _, anotherPlayer := range players {
 				if anotherPlayer.ID != player.ID && anotherPlayer.FindRealEstateByID(asset.ID).ID != "" {
 					anotherPlayer.RemoveRealEstate(asset.ID)
@@ -665,6 +797,10 @@ func (service *playerService) MarketManipulation(card entity.CardMarket, player
 		return err
 	}
 
+	if err = service.claimInsurance(&player, entity.InsuranceTypes.Business, card.ID, card.Heading, loss); err != nil {
+		return err
+	}
+
 	return service.AreYouBankrupt(player)
 }
 
@@ -676,12 +812,12 @@ func (service *playerService) BuyOtherAssets(card entity.CardOtherAssets, player
 
 	if card.IsOwner {
 		if player.Cash < card.WholeCost {
-			return errors.New(storage.ErrorNotEnoughMoney)
+			return apperror.ErrNotEnoughMoney
 		}
 	}
 
 	if card.AssetType == entity.OtherAssetTypes.Piece && card.Count < count {
-		return errors.New(storage.ErrorTooManyAssets)
+		return apperror.ErrTooManyAssets
 	}
 
 	_, asset := player.FindOtherAssetsBySymbol(card.Symbol)
@@ -736,7 +872,19 @@ func (service *playerService) BuyOtherAssetsInPartnership(card entity.CardOtherA
 	}
 
 	if owner.Cash < cardCost {
-		return errors.New(storage.ErrorNotEnoughMoney)
+		return apperror.ErrNotEnoughMoney
+	}
+
+	shared := card.AssetType == entity.OtherAssetTypes.Whole
+	ledger := entity.SharedAsset{}
+
+	if shared {
+		ledger = service.newSharedAsset(entity.SharedAsset{
+			AssetID:   card.ID,
+			AssetType: entity.SharedAssetTypes.OtherAssets,
+			Heading:   card.Heading,
+			Cost:      card.Cost,
+		}, owner, players, parts, false)
 	}
 
 	for _, pl := range parts {
@@ -753,7 +901,7 @@ func (service *playerService) BuyOtherAssetsInPartnership(card entity.CardOtherA
 		} else if card.AssetType == entity.OtherAssetTypes.Whole {
 			card.Cost = pl.Amount
 		} else {
-			return errors.New(storage.ErrorForbidden)
+			return apperror.ErrForbidden
 		}
 
 		if owner.ID == currentPlayer.ID {
@@ -773,97 +921,13 @@ func (service *playerService) BuyOtherAssetsInPartnership(card entity.CardOtherA
 		}
 	}
 
-	return nil
-}
-
-func (service *playerService) TakeLoan(player entity.Player, amount int) error {
-	logger.Info("PlayerService.TakeLoan", map[string]interface{}{
-		"playerId": player.ID,
-		"amount":   amount,
-	})
-
-	player.Liabilities.BankLoan += amount
-	player.Expenses["bankLoan"] = player.Liabilities.BankLoan / 10
-
-	err := service.UpdateCash(&player, amount, &dto.TransactionDTO{
-		CardType: entity.TransactionCardType.TakeLoan,
-		Details: fmt.Sprintf(
-			"Взял(а) в кредит $%s",
-			strconv.Itoa(amount),
-		),
-	})
-
-	if err != nil {
-		return err
-	}
-
-	return service.AreYouBankrupt(player)
-}
-
-func (service *playerService) PayLoan(player entity.Player, actionType string, amount int) error {
-	logger.Info("PlayerService.PayLoan", map[string]interface{}{
-		"playerId":   player.ID,
-		"actionType": actionType,
-		"amount":     amount,
-	})
-
-	if player.Cash < amount {
-		return errors.New(storage.ErrorNotEnoughMoney)
-	}
-
-	loanMapper := map[string]string{
-		"homeMortgage":   "homeMortgage",
-		"schoolLoans":    "schoolLoans",
-		"carLoans":       "carLoans",
-		"bankLoan":       "bankLoan",
-		"creditCardDebt": "creditCardDebt",
-	}
-
-	var liabilityAmount int
-
-	if actionType == "homeMortgage" {
-		liabilityAmount = player.Liabilities.HomeMortgage
-	} else if actionType == "schoolLoans" {
-		liabilityAmount = player.Liabilities.SchoolLoans
-	} else if actionType == "carLoans" {
-		liabilityAmount = player.Liabilities.CarLoans
-	} else if actionType == "creditCardDebt" {
-		liabilityAmount = player.Liabilities.CreditCardDebt
-	} else if actionType == "bankLoan" {
-		liabilityAmount = player.Liabilities.BankLoan
-	}
-
-	if liabilityAmount >= amount {
-		liabilityAmount -= amount
-	} else {
-		liabilityAmount = 0
-	}
-
-	logger.Info("PlayerService.PayLoan: dividing", map[string]interface{}{
-		"playerId":        player.ID,
-		"amount":          amount,
-		"liabilityAmount": liabilityAmount,
-		"actionType":      loanMapper[actionType],
-	})
-
-	if actionType == "homeMortgage" {
-		player.Liabilities.HomeMortgage = liabilityAmount
-	} else if actionType == "schoolLoans" {
-		player.Liabilities.SchoolLoans = liabilityAmount
-	} else if actionType == "carLoans" {
-		player.Liabilities.CarLoans = liabilityAmount
-	} else if actionType == "creditCardDebt" {
-		player.Liabilities.CreditCardDebt = liabilityAmount
-	} else if actionType == "bankLoan" {
-		player.Liabilities.BankLoan = liabilityAmount
+	if !shared {
+		return nil
 	}
 
-	player.Expenses[loanMapper[actionType]] = liabilityAmount / 10
+	err, _ := service.sharedAssetRepository.InsertSharedAsset(&ledger)
 
-	return service.UpdateCash(&player, -amount, &dto.TransactionDTO{
-		CardType: entity.TransactionCardType.PayLoan,
-		Details:  "Оплата по кредиту",
-	})
+	return err
 }
 
 func (service *playerService) UpdateCash(player *entity.Player, amount int, data *dto.TransactionDTO) error {
@@ -889,7 +953,7 @@ func (service *playerService) UpdateCash(player *entity.Player, amount int, data
 		}
 	}
 
-	err, _ := service.playerRepository.UpdatePlayer(player)
+	err, _ := service.UpdatePlayer(player)
 
 	return err
 }
@@ -903,12 +967,12 @@ func (service *playerService) BecomeModerator(raceId uint64, userId uint64) erro
 	player := service.playerRepository.FindPlayerByUserIdAndRaceId(raceId, userId)
 
 	if player.ID == 0 {
-		return errors.New(storage.ErrorUndefinedPlayer)
+		return apperror.ErrUndefinedPlayer
 	}
 
 	player.Role = entity.PlayerRoles.Moderator
 
-	err, _ := service.playerRepository.UpdatePlayer(&player)
+	err, _ := service.UpdatePlayer(&player)
 
 	return err
 }
@@ -917,7 +981,34 @@ func (service *playerService) InsertPlayer(b *entity.Player) (error, entity.Play
 	return service.playerRepository.InsertPlayer(b)
 }
 
+// InsertTeamMembers lets every member of the team find the player of the team by its own user.
+func (service *playerService) InsertTeamMembers(player entity.Player) error {
+	if !player.IsTeam() {
+		return nil
+	}
+
+	members := make([]entity.TeamMember, 0)
+
+	for _, member := range player.Team.Members {
+		if member.UserID == player.UserID {
+			continue
+		}
+
+		members = append(members, entity.TeamMember{
+			RaceID:   player.RaceID,
+			UserID:   member.UserID,
+			PlayerID: player.ID,
+		})
+	}
+
+	return service.playerRepository.InsertTeamMembers(members)
+}
+
 func (service *playerService) SetTransaction(player entity.Player, data dto.TransactionDTO) error {
+	if data.Code != "" && data.Details == "" {
+		data.Details = i18n.Translate(player.Info.Language, data.Code, data.Params)
+	}
+
 	if data.CardID == "" {
 		cardId := helper.CreateHash(data.Details + data.CardType + strconv.Itoa(int(time.Now().Unix())))
 		data.CardID = cardId
@@ -943,7 +1034,35 @@ func (service *playerService) UpdatePlayer(b *entity.Player) (error, entity.Play
 		"p":   strconv.Itoa(int(b.LastPosition)) + "/" + strconv.Itoa(int(b.CurrentPosition)),
 	})
 
-	return service.playerRepository.UpdatePlayer(b)
+	err, player := service.playerRepository.UpdatePlayer(b)
+
+	if err == nil {
+		service.persistNotifications(b)
+	}
+
+	return err, player
+}
+
+func (service *playerService) UpdatePlayers(players ...*entity.Player) error {
+	err := service.playerRepository.UpdatePlayers(players)
+
+	if err == nil {
+		for _, player := range players {
+			service.persistNotifications(player)
+		}
+	}
+
+	return err
+}
+
+// persistNotifications stores the notifications set on the player since it was read, the service is optional.
+func (service *playerService) persistNotifications(player *entity.Player) {
+	if service.notificationService == nil || !player.HasNewNotifications() {
+		return
+	}
+
+	service.notificationService.Persist(*player)
+	player.ClearNewNotifications()
 }
 
 func (service *playerService) GetPlayerByUsername(username string) entity.Player {
@@ -954,7 +1073,7 @@ func (service *playerService) GetProfessionById(id uint8, language string) (erro
 	_, profession := service.professionService.GetByID(uint64(id), language)
 
 	if profession.ID == 0 {
-		return errors.New(storage.ErrorUndefinedProfession), entity.Profession{}
+		return apperror.ErrUndefinedProfession, entity.Profession{}
 	}
 
 	return nil, profession
@@ -972,6 +1091,10 @@ func (service *playerService) GetAllStatePlayersByRaceId(raceId uint64) []entity
 	return service.playerRepository.AllByRaceId(raceId)
 }
 
+func (service *playerService) GetModeratorsByRaceId(raceId uint64) []entity.Player {
+	return service.playerRepository.AllModeratorsByRaceId(raceId)
+}
+
 func (service *playerService) GetTransaction(data dto.TransactionDTO) entity.Transaction {
 	if data.CardID == "" {
 		return entity.Transaction{
@@ -985,7 +1108,7 @@ func (service *playerService) GetPlayerByUserIdAndRaceId(raceId uint64, userId u
 	player := service.playerRepository.FindPlayerByUserIdAndRaceId(raceId, userId)
 
 	if player.ID == 0 {
-		return errors.New(storage.ErrorUndefinedPlayer), entity.Player{}
+		return apperror.ErrUndefinedPlayer, entity.Player{}
 	}
 
 	return nil, player
@@ -995,7 +1118,7 @@ func (service *playerService) GetPlayerByPlayerIdAndRaceId(raceId uint64, player
 	player := service.playerRepository.FindPlayerByPlayerIdAndRaceId(raceId, playerId)
 
 	if player.ID == 0 {
-		return errors.New(storage.ErrorUndefinedPlayer), entity.Player{}
+		return apperror.ErrUndefinedPlayer, entity.Player{}
 	}
 
 	return nil, player
@@ -1008,7 +1131,7 @@ func (service *playerService) GetRacePlayer(raceId uint64, userId uint64, full b
 		return nil, service.GetFormattedPlayerResponse(player, full)
 	}
 
-	return errors.New(storage.ErrorUndefinedPlayer), dto.GetRacePlayerResponseDTO{}
+	return apperror.ErrUndefinedPlayer, dto.GetRacePlayerResponseDTO{}
 }
 
 func (service *playerService) GetFormattedPlayerResponse(player entity.Player, hasRestrictedFields bool) dto.GetRacePlayerResponseDTO {
@@ -1054,6 +1177,7 @@ func (service *playerService) GetFormattedPlayerResponse(player entity.Player, h
 		IsActive:          player.IsActive,
 		HasBankrupt:       player.HasBankrupt == 1,
 		AboutToBankrupt:   player.AboutToBankrupt,
+		Team:              player.Team,
 	}
 
 	if hasRestrictedFields {
@@ -1,8 +1,8 @@
 package service
 
 import (
-	"errors"
 	logger "github.com/sirupsen/logrus"
+	"github.com/webjohny/cashflow-go/apperror"
 	"github.com/webjohny/cashflow-go/dto"
 	"github.com/webjohny/cashflow-go/entity"
 	"github.com/webjohny/cashflow-go/storage"
@@ -32,7 +32,7 @@ func (service *playerService) BuyBusinessInPartnership(card entity.CardBusiness,
 		}
 
 		if fullPassiveIncome > cardCashFlow {
-			return errors.New(storage.ErrorCommonPassiveIncomeGreaterThanCashFlowOfCard)
+			return apperror.ErrCommonPassiveIncomeGreaterThanCashFlowOfCard
 		}
 
 		percent := 0
@@ -58,14 +58,30 @@ func (service *playerService) BuyBusinessInPartnership(card entity.CardBusiness,
 		}
 
 		if card.Limit > 0 && card.Limit < cardLimit {
-			return errors.New(storage.ErrorLimitedPartnership)
+			return apperror.ErrLimitedPartnership
 		}
 	}
 
 	logger.Warn("OWNER_CASH", owner.Cash, cardCost)
 
 	if owner.Cash < cardCost {
-		return errors.New(storage.ErrorNotEnoughMoney)
+		return apperror.ErrNotEnoughMoney
+	}
+
+	shared := card.AssetType != entity.BusinessTypes.Limited
+	ledger := entity.SharedAsset{}
+	cashFlows := map[uint64]int{}
+
+	if shared {
+		ledger = service.newSharedAsset(entity.SharedAsset{
+			AssetID:   card.ID,
+			AssetType: entity.SharedAssetTypes.Business,
+			Heading:   card.Heading,
+			Cost:      card.Cost,
+			Mortgage:  card.Mortgage,
+			CashFlow:  cardCashFlow,
+		}, owner, players, parts, true)
+		cashFlows = ledger.Split(ledger.CashFlow)
 	}
 
 	for _, part := range parts {
@@ -79,11 +95,13 @@ func (service *playerService) BuyBusinessInPartnership(card entity.CardBusiness,
 
 		if card.AssetType == entity.BusinessTypes.Limited && part.Amount > 0 {
 			card.Count = part.Amount
-		} else if card.AssetType != entity.BusinessTypes.Limited {
-			card.CashFlow = part.Passive
-			card.Percent = part.Percent
+		} else if shared {
+			_, share := ledger.FindShare(currentPlayer.ID)
+
+			card.CashFlow = cashFlows[currentPlayer.ID]
+			card.Percent = share.Percent
 		} else {
-			return errors.New(storage.ErrorForbidden)
+			return apperror.ErrForbidden
 		}
 
 		if owner.ID == currentPlayer.ID {
@@ -103,7 +121,13 @@ func (service *playerService) BuyBusinessInPartnership(card entity.CardBusiness,
 		}
 	}
 
-	return nil
+	if !shared {
+		return nil
+	}
+
+	err, _ := service.sharedAssetRepository.InsertSharedAsset(&ledger)
+
+	return err
 }
 
 func (service *playerService) BuyBusiness(card entity.CardBusiness, player entity.Player, count int, updateCash bool) error {
@@ -132,7 +156,7 @@ func (service *playerService) BuyBusiness(card entity.CardBusiness, player entit
 		cost = count * cardCost
 
 		if card.Limit < count {
-			return errors.New(storage.ErrorLimitedPartnership)
+			return apperror.ErrLimitedPartnership
 		}
 
 		if asset.ID != "" {
@@ -168,7 +192,7 @@ func (service *playerService) BuyBusiness(card entity.CardBusiness, player entit
 		}
 
 		if player.Cash < cost {
-			return errors.New(storage.ErrorNotEnoughMoney)
+			return apperror.ErrNotEnoughMoney
 		}
 
 		err = service.UpdateCash(&player, -cost, &dto.TransactionDTO{
@@ -176,6 +200,13 @@ func (service *playerService) BuyBusiness(card entity.CardBusiness, player entit
 			CardType: entity.TransactionCardType.Business,
 			Details:  card.Heading,
 		})
+
+		if err == nil {
+			_ = service.chatService.SendSystemMessage(player.RaceID, storage.MessagePlayerBoughtBusiness, map[string]interface{}{
+				"username": player.Username,
+				"business": card.Heading,
+			})
+		}
 	} else {
 		err, _ = service.UpdatePlayer(&player)
 	}
@@ -214,11 +245,11 @@ func (service *playerService) TransferBusiness(ID string, sender entity.Player,
 							sender.Assets.Business = append(sender.Assets.Business[:index], sender.Assets.Business[index+1:]...)
 						}
 					} else {
-						return errors.New(storage.ErrorNotEnoughAsset)
+						return apperror.ErrNotEnoughAsset
 					}
 				}
 			} else {
-				return errors.New(storage.ErrorPermissionDenied)
+				return apperror.ErrPermissionDenied
 			}
 
 			break
@@ -252,20 +283,20 @@ func (service *playerService) SellBusiness(ID string, card entity.CardMarketBusi
 	var totalCash int
 
 	if count <= 0 && card.AssetType == entity.BusinessTypes.Limited {
-		return errors.New(storage.ErrorIncorrectCount), 0
+		return apperror.ErrIncorrectCount, 0
 	}
 
 	_, business := player.FindBusinessByID(ID)
 
 	if business.ID == "" {
-		return errors.New(storage.ErrorYouHaveNoProperties), 0
+		return apperror.ErrYouHaveNoProperties, 0
 	}
 	if !business.IsOwner {
-		return errors.New(storage.ErrorForbiddenByOwner), 0
+		return apperror.ErrForbiddenByOwner, 0
 	}
 
 	if business.AssetType == entity.BusinessTypes.Limited && count > business.Count {
-		return errors.New(storage.ErrorIncorrectCount), 0
+		return apperror.ErrIncorrectCount, 0
 	}
 
 	if card.Cost < 10 {
@@ -282,23 +313,34 @@ func (service *playerService) SellBusiness(ID string, card entity.CardMarketBusi
 		player.ReduceLimitedShares(ID, count)
 	}
 
-	if business.Count <= 0 || business.AssetType != entity.BusinessTypes.Limited {
+	// Read before the removal shifts the assets behind the business.
+	isLimited := business.AssetType == entity.BusinessTypes.Limited
+
+	if business.Count <= 0 || !isLimited {
 		player.RemoveBusiness(ID)
 	}
 
+	transaction := dto.TransactionDTO{
+		CardID:   card.ID,
+		CardType: entity.TransactionCardType.MarketBusiness,
+		Details:  card.Heading,
+	}
+
+	if !isLimited {
+		if ledger := service.GetSharedAsset(player.RaceID, ID); ledger.ID != 0 {
+			return service.sellSharedAsset(ledger, &player, totalCash, transaction), totalCash
+		}
+	}
+
 	if totalCash > 0 {
-		err := service.UpdateCash(&player, totalCash, &dto.TransactionDTO{
-			CardID:   card.ID,
-			CardType: entity.TransactionCardType.MarketBusiness,
-			Details:  card.Heading,
-		})
+		err := service.UpdateCash(&player, totalCash, &transaction)
 
 		if err != nil {
 			return err, 0
 		}
 	}
 
-	if business.AssetType == entity.BusinessTypes.Limited {
+	if isLimited {
 		return nil, totalCash
 	}
 
@@ -331,7 +373,7 @@ func (service *playerService) MarketBusiness(card entity.CardMarketBusiness, pla
 	businesses := &player.Assets.Business
 
 	if len(*businesses) == 0 {
-		return errors.New(storage.ErrorNotFoundAssets)
+		return apperror.ErrNotFoundAssets
 	}
 
 	if card.CashFlow > 0 {
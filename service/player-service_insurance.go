@@ -0,0 +1,134 @@
+package service
+
+import (
+	logger "github.com/sirupsen/logrus"
+	"github.com/webjohny/cashflow-go/apperror"
+	"github.com/webjohny/cashflow-go/dto"
+	"github.com/webjohny/cashflow-go/entity"
+	"github.com/webjohny/cashflow-go/storage"
+)
+
+// BuyInsurance starts the policy of the product, the first premium is paid upfront.
+func (service *playerService) BuyInsurance(player entity.Player, products []entity.InsuranceProduct, insuranceType string) error {
+	logger.Info("PlayerService.BuyInsurance", map[string]interface{}{
+		"playerId": player.ID,
+		"type":     insuranceType,
+	})
+
+	product, ok := entity.FindInsuranceProduct(products, insuranceType)
+
+	if !ok {
+		return apperror.ErrUndefinedInsurance
+	}
+
+	if index, _ := player.FindInsurance(insuranceType); index != -1 {
+		return apperror.ErrInsuranceAlreadyActive
+	}
+
+	if player.Cash < product.Premium {
+		return apperror.ErrNotEnoughMoney
+	}
+
+	player.Insurances = append(player.Insurances, entity.NewPlayerInsurance(product))
+
+	return service.UpdateCash(&player, -product.Premium, &dto.TransactionDTO{
+		CardType: entity.TransactionCardType.Insurance,
+		Details:  insuranceType,
+		Code:     storage.TransactionInsurancePremium,
+		Params: map[string]interface{}{
+			"amount": product.Premium,
+		},
+	})
+}
+
+func (service *playerService) CancelInsurance(player entity.Player, insuranceType string) error {
+	logger.Info("PlayerService.CancelInsurance", map[string]interface{}{
+		"playerId": player.ID,
+		"type":     insuranceType,
+	})
+
+	if index, _ := player.FindInsurance(insuranceType); index == -1 {
+		return apperror.ErrUndefinedInsurance
+	}
+
+	player.RemoveInsurance(insuranceType)
+
+	err, _ := service.UpdatePlayer(&player)
+
+	return err
+}
+
+// chargeInsurancePremiums collects the premiums on payday, a policy the player cannot pay for lapses.
+func (service *playerService) chargeInsurancePremiums(player *entity.Player, cardID string, heading string) error {
+	total := 0
+	lapsed := false
+
+	for _, insurance := range append([]entity.PlayerInsurance{}, player.Insurances...) {
+		if player.Cash-total < insurance.Premium {
+			player.RemoveInsurance(insurance.Type)
+			player.SetNotificationWithParams(storage.MessageInsuranceLapsed, entity.NotificationTypes.Warning, map[string]interface{}{
+				"type":   insurance.Type,
+				"amount": insurance.Premium,
+			})
+			lapsed = true
+
+			continue
+		}
+
+		_, policy := player.FindInsurance(insurance.Type)
+		policy.PremiumsPaid += insurance.Premium
+		total += insurance.Premium
+	}
+
+	if total == 0 {
+		if lapsed {
+			err, _ := service.UpdatePlayer(player)
+
+			return err
+		}
+
+		return nil
+	}
+
+	return service.UpdateCash(player, -total, &dto.TransactionDTO{
+		CardID:   cardID,
+		CardType: entity.TransactionCardType.Insurance,
+		Details:  heading,
+		Code:     storage.TransactionInsurancePremium,
+		Params: map[string]interface{}{
+			"amount": total,
+		},
+	})
+}
+
+// claimInsurance pays the player's policy of the type out for the loss the card caused.
+func (service *playerService) claimInsurance(player *entity.Player, insuranceType string, cardID string, heading string, loss int) error {
+	paid := player.ClaimInsurance(insuranceType, cardID, loss)
+
+	if paid <= 0 {
+		return nil
+	}
+
+	logger.Info("PlayerService.claimInsurance", map[string]interface{}{
+		"playerId": player.ID,
+		"type":     insuranceType,
+		"loss":     loss,
+		"paid":     paid,
+	})
+
+	params := map[string]interface{}{
+		"type":   insuranceType,
+		"amount": paid,
+		"card":   heading,
+	}
+
+	player.SetNotificationWithParams(storage.MessageInsuranceClaimPaid, entity.NotificationTypes.Success, params)
+
+	return service.UpdateCash(player, paid, &dto.TransactionDTO{
+		CardID:   cardID,
+		CardType: entity.TransactionCardType.Insurance,
+		Details:  heading,
+		Code:     storage.TransactionInsuranceClaim,
+		Params:   params,
+	})
+}
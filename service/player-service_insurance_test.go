@@ -0,0 +1,48 @@
+package service
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/webjohny/cashflow-go/apperror"
+	"github.com/webjohny/cashflow-go/entity"
+	"testing"
+)
+
+func TestPlayerServiceMarketDamage(t *testing.T) {
+	tests := []struct {
+		name      string
+		cash      int
+		assetType string
+		err       error
+		expected  int
+	}{
+		{name: "any real estate", cash: 300, assetType: entity.MarketTypes.AnyRealEstate, expected: 100},
+		{name: "each real estate covered by the insurance", cash: 500, assetType: entity.MarketTypes.EachRealEstate, expected: 100},
+		{name: "each real estate the player can not afford", cash: 300, assetType: entity.MarketTypes.EachRealEstate, err: apperror.ErrNotEnoughMoney, expected: 300},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			player := entity.Player{
+				ID:     1,
+				RaceID: 1,
+				Cash:   test.cash,
+				Assets: entity.PlayerAssets{RealEstates: []entity.CardRealEstate{{ID: "r1", IsOwner: true}, {ID: "r2", IsOwner: true}}},
+				Insurances: []entity.PlayerInsurance{
+					{Type: entity.InsuranceTypes.Property, Coverage: 80, Limit: 5000},
+				},
+			}
+			players := &memoryPlayerRepository{players: map[uint64]entity.Player{1: player}}
+			service := &playerService{
+				playerRepository:    players,
+				transactionService:  &silentTransactionService{},
+				notificationService: &silentNotificationService{},
+			}
+
+			card := entity.CardMarket{ID: "damage", Symbol: "ANY", Cost: 1000, AssetType: test.assetType}
+			err := service.MarketDamage(card, player)
+
+			assert.Equal(t, test.err, err)
+			assert.Equal(t, test.expected, players.players[1].Cash)
+		})
+	}
+}
@@ -7,6 +7,7 @@ import (
 
 type PlayerService interface {
 	Payday(player entity.Player, card entity.Card) error
+	PayPaydays(player entity.Player, amount int, paydays int, transaction *dto.TransactionDTO) error
 	BecomeModerator(raceId uint64, userId uint64) error
 	CashFlowDay(player entity.Player, card entity.Card) error
 	Doodad(card entity.CardDoodad, player entity.Player) error
@@ -24,6 +25,7 @@ type PlayerService interface {
 	SellStocks(card entity.CardStocks, player entity.Player, count int, updateCash bool) error
 	SellRealEstate(ID string, card entity.CardMarketRealEstate, player entity.Player) error
 	SellBusiness(ID string, card entity.CardMarketBusiness, player entity.Player, count int) (error, int)
+	SellAssetToBank(assetType string, ID string, amount int, player entity.Player, data dto.TransactionDTO) error
 	TransferBusiness(ID string, sender entity.Player, receiver entity.Player, count int) error
 	TransferStocks(ID string, sender entity.Player, receiver entity.Player, count int) error
 	DecreaseStocks(card entity.CardStocks, player entity.Player) error
@@ -33,15 +35,22 @@ type PlayerService interface {
 	Downsized(player entity.Player, card entity.Card) error
 	BornBaby(player entity.Player, card entity.Card) (error, bool)
 	MoveOnBigRace(player entity.Player) error
+	GetBigRaceProgress(raceId uint64, userId uint64) (error, dto.BigRaceProgressResponseDTO)
 	SetDream(raceId uint64, userId uint64, playerDream entity.PlayerDream) error
 	MarketDamage(card entity.CardMarket, player entity.Player) error
 	MarketManipulation(card entity.CardMarket, player entity.Player, players []entity.Player) error
 	MarketBusiness(card entity.CardMarketBusiness, player entity.Player) error
 	SellAllProperties(player entity.Player) (error, int)
 	SetTransaction(player entity.Player, data dto.TransactionDTO) error
-	TakeLoan(player entity.Player, amount int) error
-	PayLoan(player entity.Player, actionType string, amount int) error
+	CheckLoan(player entity.Player, amount int, policy entity.LoanPolicy) error
+	GetLoanLimit(player entity.Player, policy entity.LoanPolicy) int
+	TakeLoan(player entity.Player, amount int, policy entity.LoanPolicy) error
+	PayLoan(player entity.Player, liability string, amount int, policy entity.LoanPolicy) error
+	BuyInsurance(player entity.Player, products []entity.InsuranceProduct, insuranceType string) error
+	CancelInsurance(player entity.Player, insuranceType string) error
 	UpdateCash(player *entity.Player, amount int, data *dto.TransactionDTO) error
+	GetSharedAsset(raceId uint64, assetId string) entity.SharedAsset
+	SyncSharedAsset(ledger entity.SharedAsset, left ...uint64) error
 	SetPlayerData(raceId uint64, userId uint64, dto entity.PlayerInfoData) error
 	GetTransaction(data dto.TransactionDTO) entity.Transaction
 	GetPlayerByUsername(username string) entity.Player
@@ -50,9 +59,12 @@ type PlayerService interface {
 	GetPlayerByPlayerIdAndRaceId(raceId uint64, playerId uint64) (error, entity.Player)
 	GetAllPlayersByRaceId(raceId uint64) []entity.Player
 	GetAllStatePlayersByRaceId(raceId uint64) []entity.Player
+	GetModeratorsByRaceId(raceId uint64) []entity.Player
 	GetProfessionById(id uint8, language string) (error, entity.Profession)
 	GetRacePlayer(raceId uint64, userId uint64, full bool) (error, dto.GetRacePlayerResponseDTO)
 	GetFormattedPlayerResponse(player entity.Player, hasRestrictedFields bool) dto.GetRacePlayerResponseDTO
 	InsertPlayer(b *entity.Player) (error, entity.Player)
+	InsertTeamMembers(player entity.Player) error
 	UpdatePlayer(b *entity.Player) (error, entity.Player)
+	UpdatePlayers(players ...*entity.Player) error
 }
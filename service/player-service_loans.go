@@ -0,0 +1,154 @@
+package service
+
+import (
+	logger "github.com/sirupsen/logrus"
+	"github.com/webjohny/cashflow-go/apperror"
+	"github.com/webjohny/cashflow-go/dto"
+	"github.com/webjohny/cashflow-go/entity"
+	"github.com/webjohny/cashflow-go/storage"
+)
+
+// liabilityExpenses maps the liabilities which can be paid off to their profession expense.
+var liabilityExpenses = map[string]string{
+	"homeMortgage":   "homeMortgagePayment",
+	"schoolLoans":    "schoolLoanPayment",
+	"carLoans":       "carLoanPayment",
+	"creditCardDebt": "creditCardPayment",
+}
+
+// GetLoanLimit returns how much the player can still borrow, -1 when the policy sets no cap.
+func (service *playerService) GetLoanLimit(player entity.Player, policy entity.LoanPolicy) int {
+	if policy.MaxCashFlowRatio <= 0 {
+		return -1
+	}
+
+	player.SyncLoans()
+
+	limit := (player.CalculateCashFlow()+player.Expenses["bankLoan"])*policy.MaxCashFlowRatio - player.Liabilities.BankLoan
+
+	if limit < 0 {
+		return 0
+	}
+
+	return limit
+}
+
+func (service *playerService) CheckLoan(player entity.Player, amount int, policy entity.LoanPolicy) error {
+	if amount <= 0 || amount%policy.GetIncrement() != 0 {
+		return apperror.ErrWrongAmountForTakingLoan.WithDetails(apperror.Details{
+			"increment": policy.GetIncrement(),
+		})
+	}
+
+	if limit := service.GetLoanLimit(player, policy); limit >= 0 && amount > limit {
+		return apperror.ErrLoanLimitExceeded.WithDetails(apperror.Details{
+			"limit": limit,
+		})
+	}
+
+	return nil
+}
+
+func (service *playerService) TakeLoan(player entity.Player, amount int, policy entity.LoanPolicy) error {
+	logger.Info("PlayerService.TakeLoan", map[string]interface{}{
+		"playerId": player.ID,
+		"amount":   amount,
+		"policy":   policy,
+	})
+
+	player.TakeLoan(entity.NewPlayerLoan(amount, policy))
+
+	err := service.UpdateCash(&player, amount, &dto.TransactionDTO{
+		CardType: entity.TransactionCardType.TakeLoan,
+		Code:     storage.TransactionTookLoan,
+		Params: map[string]interface{}{
+			"amount": amount,
+		},
+	})
+
+	if err != nil {
+		return err
+	}
+
+	return service.AreYouBankrupt(player)
+}
+
+// PayLoan pays the amount off a liability. Bank loans are repaid oldest first in the policy increments,
+// other liabilities are paid in full unless the policy allows partial repayments.
+func (service *playerService) PayLoan(player entity.Player, liability string, amount int, policy entity.LoanPolicy) error {
+	logger.Info("PlayerService.PayLoan", map[string]interface{}{
+		"playerId":  player.ID,
+		"liability": liability,
+		"amount":    amount,
+	})
+
+	if amount <= 0 {
+		return apperror.ErrWrongAmountForPayingLoan
+	}
+
+	if liability == "" || liability == "bankLoan" {
+		player.SyncLoans()
+
+		if amount > player.Liabilities.BankLoan {
+			amount = player.Liabilities.BankLoan
+		}
+
+		if amount == 0 || (amount%policy.GetIncrement() != 0 && amount != player.Liabilities.BankLoan) {
+			return apperror.ErrWrongAmountForPayingLoan.WithDetails(apperror.Details{
+				"increment": policy.GetIncrement(),
+			})
+		}
+
+		if player.Cash < amount {
+			return apperror.ErrNotEnoughMoney
+		}
+
+		player.RepayLoans(amount)
+	} else {
+		expense, ok := liabilityExpenses[liability]
+
+		if !ok {
+			return apperror.ErrValidationFailed.WithDetails(apperror.Details{
+				"field": "liability",
+			})
+		}
+
+		balance := player.GetLiability(liability)
+
+		if amount > balance {
+			amount = balance
+		}
+
+		if amount == 0 {
+			return apperror.ErrWrongAmountForPayingLoan
+		}
+
+		if amount < balance && !policy.PartialRepayment {
+			return apperror.ErrPartialRepaymentNotAllowed.WithDetails(apperror.Details{
+				"amount": balance,
+			})
+		}
+
+		if player.Cash < amount {
+			return apperror.ErrNotEnoughMoney
+		}
+
+		logger.Info("PlayerService.PayLoan: dividing", map[string]interface{}{
+			"playerId":  player.ID,
+			"amount":    amount,
+			"balance":   balance,
+			"liability": liability,
+		})
+
+		player.Expenses[expense] = player.Expenses[expense] * (balance - amount) / balance
+		player.SetLiability(liability, balance-amount)
+	}
+
+	return service.UpdateCash(&player, -amount, &dto.TransactionDTO{
+		CardType: entity.TransactionCardType.PayLoan,
+		Code:     storage.TransactionPaidLoan,
+		Params: map[string]interface{}{
+			"amount": amount,
+		},
+	})
+}
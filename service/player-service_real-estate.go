@@ -1,12 +1,11 @@
 package service
 
 import (
-	"errors"
 	logger "github.com/sirupsen/logrus"
+	"github.com/webjohny/cashflow-go/apperror"
 	"github.com/webjohny/cashflow-go/dto"
 	"github.com/webjohny/cashflow-go/entity"
 	"github.com/webjohny/cashflow-go/helper"
-	"github.com/webjohny/cashflow-go/storage"
 )
 
 func (service *playerService) BuyRealEstate(card entity.CardRealEstate, player entity.Player) error {
@@ -16,7 +15,7 @@ func (service *playerService) BuyRealEstate(card entity.CardRealEstate, player e
 	})
 
 	if player.Cash < card.DownPayment {
-		return errors.New(storage.ErrorNotEnoughMoney)
+		return apperror.ErrNotEnoughMoney
 	}
 
 	player.Assets.RealEstates = append(player.Assets.RealEstates, card)
@@ -42,7 +41,7 @@ func (service *playerService) BuyRealEstateInPartnership(card entity.CardRealEst
 	})
 
 	if owner.Cash < card.DownPayment {
-		return errors.New(storage.ErrorNotEnoughMoney)
+		return apperror.ErrNotEnoughMoney
 	}
 
 	cardCost := card.DownPayment
@@ -56,9 +55,19 @@ func (service *playerService) BuyRealEstateInPartnership(card entity.CardRealEst
 	}
 
 	if cashFlow > card.CashFlow {
-		return errors.New(storage.ErrorCommonPassiveIncomeGreaterThanCashFlowOfCard)
+		return apperror.ErrCommonPassiveIncomeGreaterThanCashFlowOfCard
 	}
 
+	ledger := service.newSharedAsset(entity.SharedAsset{
+		AssetID:   card.ID,
+		AssetType: entity.SharedAssetTypes.RealEstate,
+		Heading:   card.Heading,
+		Cost:      cost,
+		Mortgage:  mortgage,
+		CashFlow:  card.CashFlow,
+	}, owner, players, parts, false)
+	cashFlows := ledger.Split(ledger.CashFlow)
+
 	for _, pl := range parts {
 		var currentPlayer entity.Player
 
@@ -68,18 +77,14 @@ func (service *playerService) BuyRealEstateInPartnership(card entity.CardRealEst
 			}
 		}
 
-		card.CashFlow = pl.Passive
-		card.DownPayment = pl.Amount
+		_, share := ledger.FindShare(currentPlayer.ID)
 
-		if owner.ID == currentPlayer.ID {
-			card.Mortgage = mortgage
-			card.Cost = cost
-			card.IsOwner = true
-		} else {
-			card.Cost = 0
-			card.Mortgage = 0
-			card.IsOwner = false
-		}
+		card.CashFlow = cashFlows[currentPlayer.ID]
+		card.Percent = share.Percent
+		card.DownPayment = pl.Amount
+		card.Mortgage = mortgage
+		card.Cost = cost
+		card.IsOwner = owner.ID == currentPlayer.ID
 
 		currentPlayer.Assets.RealEstates = append(currentPlayer.Assets.RealEstates, card)
 
@@ -102,7 +107,9 @@ func (service *playerService) BuyRealEstateInPartnership(card entity.CardRealEst
 		}
 	}
 
-	return nil
+	err, _ := service.sharedAssetRepository.InsertSharedAsset(&ledger)
+
+	return err
 }
 
 func (service *playerService) SellAllProperties(player entity.Player) (error, int) {
@@ -113,7 +120,7 @@ func (service *playerService) SellAllProperties(player entity.Player) (error, in
 	var totalCash int
 
 	if !player.HasRealEstates() {
-		return errors.New(storage.ErrorYouHaveNoProperties), 0
+		return apperror.ErrYouHaveNoProperties, 0
 	}
 
 	for i := 0; i < len(player.Assets.RealEstates); i++ {
@@ -135,21 +142,22 @@ func (service *playerService) SellRealEstate(ID string, card entity.CardMarketRe
 
 	var totalCost int
 
-	realEstate := player.FindRealEstateByID(ID)
+	// A copy, removing the real estate shifts the assets behind it.
+	realEstate := *player.FindRealEstateByID(ID)
 
 	if realEstate.ID == "" {
-		return errors.New(storage.ErrorNotFoundAssets)
+		return apperror.ErrNotFoundAssets
 	}
 
 	if !realEstate.IsOwner {
-		return errors.New(storage.ErrorForbiddenByOwner)
+		return apperror.ErrForbiddenByOwner
 	}
 
 	if card.AssetType == entity.RealEstateTypes.Building {
 		if helper.Contains[int](card.Range, realEstate.Count) || len(card.Range) == 0 {
 			totalCost = card.Cost * realEstate.Count
 		} else {
-			return errors.New(storage.ErrorNotSuitableBuilding)
+			return apperror.ErrNotSuitableBuilding
 		}
 	} else if card.AssetType == entity.RealEstateTypes.Single {
 		totalCost = card.Cost
@@ -157,12 +165,22 @@ func (service *playerService) SellRealEstate(ID string, card entity.CardMarketRe
 
 	player.RemoveRealEstate(ID)
 
+	transaction := dto.TransactionDTO{
+		CardID:   card.ID,
+		CardType: entity.TransactionCardType.RealEstate,
+		Details:  card.Heading,
+	}
+
+	if ledger := service.GetSharedAsset(player.RaceID, ID); ledger.ID != 0 {
+		if err := service.sellSharedAsset(ledger, &player, totalCost-realEstate.Mortgage, transaction); err != nil {
+			return err
+		}
+
+		return service.AreYouBankrupt(player)
+	}
+
 	if totalCost > 0 && totalCost >= realEstate.Mortgage {
-		err := service.UpdateCash(&player, totalCost-realEstate.Mortgage, &dto.TransactionDTO{
-			CardID:   card.ID,
-			CardType: entity.TransactionCardType.RealEstate,
-			Details:  card.Heading,
-		})
+		err := service.UpdateCash(&player, totalCost-realEstate.Mortgage, &transaction)
 
 		if err != nil {
 			return err
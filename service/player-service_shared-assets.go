@@ -0,0 +1,243 @@
+package service
+
+import (
+	logger "github.com/sirupsen/logrus"
+	"github.com/webjohny/cashflow-go/dto"
+	"github.com/webjohny/cashflow-go/entity"
+	"github.com/webjohny/cashflow-go/storage"
+)
+
+// newSharedAsset builds the ledger of a partnership purchase. Shares follow the agreed percents when byPercent is
+// set, otherwise the amounts the partners put in, or their passive income when nobody put anything in.
+func (service *playerService) newSharedAsset(ledger entity.SharedAsset, owner entity.Player, players []entity.Player, parts []dto.CardPurchasePlayerActionDTO, byPercent bool) entity.SharedAsset {
+	byAmount := false
+
+	for _, part := range parts {
+		byAmount = byAmount || part.Amount > 0
+	}
+
+	shares := make([]entity.SharedAssetShare, 0, len(parts))
+
+	for _, part := range parts {
+		share := entity.SharedAssetShare{
+			PlayerID:     uint64(part.ID),
+			Contribution: part.Passive,
+		}
+
+		if byPercent {
+			share.Percent = part.Percent
+		}
+		if byAmount {
+			share.Contribution = part.Amount
+		}
+
+		for _, person := range players {
+			if person.ID == share.PlayerID {
+				share.Username = person.Username
+			}
+		}
+
+		shares = append(shares, share)
+	}
+
+	ledger.RaceID = owner.RaceID
+	ledger.OwnerID = owner.ID
+	ledger.Status = entity.SharedAssetStatuses.Active
+	ledger.SetShares(shares)
+
+	return ledger
+}
+
+// applySharedAsset brings the player's copy of the asset in line with the ledger.
+func applySharedAsset(player *entity.Player, ledger entity.SharedAsset, cashFlow int) {
+	_, share := ledger.FindShare(player.ID)
+	isOwner := player.ID == ledger.OwnerID
+
+	switch ledger.AssetType {
+	case entity.SharedAssetTypes.RealEstate:
+		if asset := player.FindRealEstateByID(ledger.AssetID); asset.ID != "" {
+			asset.Percent = share.Percent
+			asset.CashFlow = cashFlow
+			asset.IsOwner = isOwner
+		}
+	case entity.SharedAssetTypes.Business:
+		if _, asset := player.FindBusinessByID(ledger.AssetID); asset.ID != "" {
+			asset.Percent = share.Percent
+			asset.CashFlow = cashFlow
+			asset.IsOwner = isOwner
+		}
+	case entity.SharedAssetTypes.OtherAssets:
+		if _, asset := player.FindOtherAssetsByID(ledger.AssetID); asset.ID != "" {
+			asset.IsOwner = isOwner
+		}
+	}
+}
+
+func removeSharedAsset(player *entity.Player, ledger entity.SharedAsset) {
+	switch ledger.AssetType {
+	case entity.SharedAssetTypes.RealEstate:
+		player.RemoveRealEstate(ledger.AssetID)
+	case entity.SharedAssetTypes.Business:
+		player.RemoveBusiness(ledger.AssetID)
+	case entity.SharedAssetTypes.OtherAssets:
+		player.RemoveOtherAssetsByID(ledger.AssetID)
+	}
+}
+
+func (service *playerService) GetSharedAsset(raceId uint64, assetId string) entity.SharedAsset {
+	return service.sharedAssetRepository.FindActiveByAssetId(raceId, assetId)
+}
+
+// SyncSharedAsset saves the ledger and updates the copies of the partners, the players which left it lose theirs.
+func (service *playerService) SyncSharedAsset(ledger entity.SharedAsset, left ...uint64) error {
+	logger.Info("PlayerService.SyncSharedAsset", map[string]interface{}{
+		"ledgerId": ledger.ID,
+		"shares":   ledger.Shares,
+		"left":     left,
+	})
+
+	err, ledger := service.sharedAssetRepository.UpdateSharedAsset(&ledger)
+
+	if err != nil {
+		return err
+	}
+
+	cashFlows := ledger.Split(ledger.CashFlow)
+
+	for _, share := range ledger.Shares {
+		err, partner := service.GetPlayerByPlayerIdAndRaceId(ledger.RaceID, share.PlayerID)
+
+		if err != nil || partner.ID == 0 {
+			continue
+		}
+
+		applySharedAsset(&partner, ledger, cashFlows[partner.ID])
+
+		if err, _ = service.UpdatePlayer(&partner); err != nil {
+			return err
+		}
+	}
+
+	for _, playerId := range left {
+		err, partner := service.GetPlayerByPlayerIdAndRaceId(ledger.RaceID, playerId)
+
+		if err != nil || partner.ID == 0 {
+			continue
+		}
+
+		removeSharedAsset(&partner, ledger)
+
+		if err, _ = service.UpdatePlayer(&partner); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// sellSharedAsset distributes the sale proceeds to the partners by their shares and closes the ledger.
+// The seller has already dropped the asset from the sheet.
+func (service *playerService) sellSharedAsset(ledger entity.SharedAsset, seller *entity.Player, amount int, data dto.TransactionDTO) error {
+	logger.Info("PlayerService.sellSharedAsset", map[string]interface{}{
+		"ledgerId": ledger.ID,
+		"sellerId": seller.ID,
+		"amount":   amount,
+	})
+
+	if amount < 0 {
+		amount = 0
+	}
+
+	parts := ledger.Split(amount)
+
+	for _, share := range ledger.Shares {
+		transaction := data
+		transaction.Code = storage.TransactionSaleProceedsShare
+		transaction.Params = map[string]interface{}{
+			"amount":  parts[share.PlayerID],
+			"percent": share.Percent,
+			"card":    ledger.Heading,
+		}
+
+		if share.PlayerID == seller.ID {
+			var err error
+
+			if parts[share.PlayerID] > 0 {
+				err = service.UpdateCash(seller, parts[share.PlayerID], &transaction)
+			} else {
+				err, _ = service.UpdatePlayer(seller)
+			}
+
+			if err != nil {
+				return err
+			}
+
+			continue
+		}
+
+		err, partner := service.GetPlayerByPlayerIdAndRaceId(ledger.RaceID, share.PlayerID)
+
+		if err != nil || partner.ID == 0 {
+			continue
+		}
+
+		removeSharedAsset(&partner, ledger)
+
+		if parts[share.PlayerID] > 0 {
+			err = service.UpdateCash(&partner, parts[share.PlayerID], &transaction)
+		} else {
+			err, _ = service.UpdatePlayer(&partner)
+		}
+
+		if err != nil {
+			logger.Error("PlayerService.sellSharedAsset", err, partner.ID, ledger.ID)
+		}
+	}
+
+	ledger.Status = entity.SharedAssetStatuses.Sold
+	err, _ := service.sharedAssetRepository.UpdateSharedAsset(&ledger)
+
+	return err
+}
+
+// leaveSharedAssets hands the shares of a bankrupt player to the owner, or to the largest partner when the owner
+// goes bankrupt, and returns the assets it handled. A ledger without other partners is closed.
+func (service *playerService) leaveSharedAssets(player entity.Player) map[string]bool {
+	handled := make(map[string]bool)
+
+	for _, ledger := range service.sharedAssetRepository.AllActiveByRaceId(player.RaceID) {
+		_, share := ledger.FindShare(player.ID)
+
+		if share.PlayerID == 0 {
+			continue
+		}
+
+		handled[ledger.AssetID] = true
+
+		if len(ledger.Shares) == 1 {
+			ledger.Status = entity.SharedAssetStatuses.Closed
+			_, _ = service.sharedAssetRepository.UpdateSharedAsset(&ledger)
+			continue
+		}
+
+		recipient := ledger.OwnerID
+
+		if recipient == player.ID {
+			largest := 0
+
+			for _, other := range ledger.Shares {
+				if other.PlayerID != player.ID && other.Percent > largest {
+					recipient, largest = other.PlayerID, other.Percent
+				}
+			}
+		}
+
+		ledger.Transfer(player.ID, recipient, share.Percent, "")
+
+		if err := service.SyncSharedAsset(ledger); err != nil {
+			logger.Error("PlayerService.leaveSharedAssets", err, player.ID, ledger.ID)
+		}
+	}
+
+	return handled
+}
@@ -0,0 +1,181 @@
+package service
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/webjohny/cashflow-go/apperror"
+	"github.com/webjohny/cashflow-go/dto"
+	"github.com/webjohny/cashflow-go/entity"
+	"github.com/webjohny/cashflow-go/repository"
+	"testing"
+)
+
+type memoryPlayerRepository struct {
+	repository.PlayerRepository
+	players map[uint64]entity.Player
+}
+
+func (r *memoryPlayerRepository) FindPlayerByPlayerIdAndRaceId(raceId uint64, playerId uint64) entity.Player {
+	return r.players[playerId]
+}
+
+func (r *memoryPlayerRepository) UpdatePlayer(b *entity.Player) (error, entity.Player) {
+	r.players[b.ID] = *b
+
+	return nil, *b
+}
+
+func (r *memoryPlayerRepository) AllActiveByRaceId(raceId uint64) []entity.Player {
+	players := make([]entity.Player, 0, len(r.players))
+
+	for _, player := range r.players {
+		players = append(players, player)
+	}
+
+	return players
+}
+
+type sharedAssetRepository struct {
+	repository.SharedAssetRepository
+	ledger entity.SharedAsset
+}
+
+func (r *sharedAssetRepository) FindActiveByAssetId(raceId uint64, assetId string) entity.SharedAsset {
+	if r.ledger.AssetID != assetId || !r.ledger.IsActive() {
+		return entity.SharedAsset{}
+	}
+
+	return r.ledger
+}
+
+func (r *sharedAssetRepository) UpdateSharedAsset(b *entity.SharedAsset) (error, entity.SharedAsset) {
+	r.ledger = *b
+
+	return nil, *b
+}
+
+type silentTransactionService struct {
+	TransactionService
+}
+
+func (s *silentTransactionService) InsertTransaction(b dto.TransactionDTO) error {
+	return nil
+}
+
+type silentNotificationService struct {
+	NotificationService
+}
+
+func (s *silentNotificationService) Persist(player entity.Player) {}
+
+func TestPlayerServiceSellSharedRealEstate(t *testing.T) {
+	tests := []struct {
+		name    string
+		assetId string
+		cash    map[uint64]int
+		left    []string
+	}{
+		{name: "a real estate that is not the last one", assetId: "r1", cash: map[uint64]int{1: 3000, 2: 2000}, left: []string{"r2"}},
+		{name: "the last real estate", assetId: "r2", cash: map[uint64]int{1: 601, 2: 400}, left: []string{"r1"}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assets := []entity.CardRealEstate{
+				{ID: "r1", AssetType: entity.RealEstateTypes.Single, Mortgage: 1000, CashFlow: 200},
+				{ID: "r2", AssetType: entity.RealEstateTypes.Single, Mortgage: 4999, CashFlow: 100},
+			}
+			seller := entity.Player{ID: 1, RaceID: 1, Salary: 3000, Assets: entity.PlayerAssets{RealEstates: append([]entity.CardRealEstate{}, assets...)}}
+			partner := entity.Player{ID: 2, RaceID: 1, Salary: 3000, Assets: entity.PlayerAssets{RealEstates: append([]entity.CardRealEstate{}, assets...)}}
+			seller.Assets.RealEstates[0].IsOwner = true
+			seller.Assets.RealEstates[1].IsOwner = true
+
+			ledger := entity.SharedAsset{ID: 1, RaceID: 1, AssetID: test.assetId, AssetType: entity.SharedAssetTypes.RealEstate, OwnerID: 1, Status: entity.SharedAssetStatuses.Active}
+			ledger.SetShares([]entity.SharedAssetShare{{PlayerID: 1, Percent: 60}, {PlayerID: 2, Percent: 40}})
+
+			players := &memoryPlayerRepository{players: map[uint64]entity.Player{1: seller, 2: partner}}
+			ledgers := &sharedAssetRepository{ledger: ledger}
+			service := &playerService{
+				playerRepository:      players,
+				sharedAssetRepository: ledgers,
+				transactionService:    &silentTransactionService{},
+				notificationService:   &silentNotificationService{},
+			}
+
+			card := entity.CardMarketRealEstate{ID: "market", AssetType: entity.RealEstateTypes.Single, Cost: 6000}
+			err := service.SellRealEstate(test.assetId, card, seller)
+
+			assert.NoError(t, err)
+			assert.Equal(t, entity.SharedAssetStatuses.Sold, ledgers.ledger.Status)
+
+			for ID, cash := range test.cash {
+				player := players.players[ID]
+				left := make([]string, 0)
+
+				for _, realEstate := range player.Assets.RealEstates {
+					left = append(left, realEstate.ID)
+				}
+
+				assert.Equal(t, cash, player.Cash, "cash of player %d", ID)
+				assert.Equal(t, test.left, left, "real estates of player %d", ID)
+			}
+		})
+	}
+}
+
+func TestPlayerServiceSellAssetToBank(t *testing.T) {
+	tests := []struct {
+		name    string
+		assetId string
+		shared  bool
+		err     error
+		cash    map[uint64]int
+		left    map[uint64][]string
+	}{
+		{name: "an asset of the seller", assetId: "b1", cash: map[uint64]int{1: 1000, 2: 0}, left: map[uint64][]string{1: {"b2"}, 2: {"b2"}}},
+		{name: "a shared asset", assetId: "b1", shared: true, cash: map[uint64]int{1: 600, 2: 400}, left: map[uint64][]string{1: {"b2"}, 2: {"b2"}}},
+		{name: "an asset of another player", assetId: "b2", err: apperror.ErrForbiddenByOwner, cash: map[uint64]int{1: 0, 2: 0}, left: map[uint64][]string{1: {"b1", "b2"}, 2: {"b1", "b2"}}},
+		{name: "an unknown asset", assetId: "b9", err: apperror.ErrNotFoundTheAsset, cash: map[uint64]int{1: 0, 2: 0}, left: map[uint64][]string{1: {"b1", "b2"}, 2: {"b1", "b2"}}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			seller := entity.Player{ID: 1, RaceID: 1, Salary: 3000, Assets: entity.PlayerAssets{Business: []entity.CardBusiness{{ID: "b1", IsOwner: true}, {ID: "b2"}}}}
+			partner := entity.Player{ID: 2, RaceID: 1, Salary: 3000, Assets: entity.PlayerAssets{Business: []entity.CardBusiness{{ID: "b1"}, {ID: "b2", IsOwner: true}}}}
+
+			ledgers := &sharedAssetRepository{}
+
+			if test.shared {
+				ledgers.ledger = entity.SharedAsset{ID: 1, RaceID: 1, AssetID: "b1", AssetType: entity.SharedAssetTypes.Business, OwnerID: 1, Status: entity.SharedAssetStatuses.Active}
+				ledgers.ledger.SetShares([]entity.SharedAssetShare{{PlayerID: 1, Percent: 60}, {PlayerID: 2, Percent: 40}})
+			}
+
+			players := &memoryPlayerRepository{players: map[uint64]entity.Player{1: seller, 2: partner}}
+			service := &playerService{
+				playerRepository:      players,
+				sharedAssetRepository: ledgers,
+				transactionService:    &silentTransactionService{},
+				notificationService:   &silentNotificationService{},
+			}
+
+			err := service.SellAssetToBank(entity.TxTypes.Business, test.assetId, 1000, seller, dto.TransactionDTO{})
+
+			assert.Equal(t, test.err, err)
+
+			for ID, cash := range test.cash {
+				player := players.players[ID]
+				left := make([]string, 0)
+
+				for _, business := range player.Assets.Business {
+					left = append(left, business.ID)
+				}
+
+				assert.Equal(t, cash, player.Cash, "cash of player %d", ID)
+				assert.Equal(t, test.left[ID], left, "businesses of player %d", ID)
+			}
+
+			if test.shared {
+				assert.Equal(t, entity.SharedAssetStatuses.Sold, ledgers.ledger.Status)
+			}
+		})
+	}
+}
@@ -1,11 +1,10 @@
 package service
 
 import (
-	"errors"
 	logger "github.com/sirupsen/logrus"
+	"github.com/webjohny/cashflow-go/apperror"
 	"github.com/webjohny/cashflow-go/dto"
 	"github.com/webjohny/cashflow-go/entity"
-	"github.com/webjohny/cashflow-go/storage"
 	"math"
 )
 
@@ -19,7 +18,7 @@ func (service *playerService) BuyStocks(card entity.CardStocks, player entity.Pl
 	totalCost := card.Price * card.Count
 
 	if updateCash && player.Cash < totalCost {
-		return errors.New(storage.ErrorNotEnoughMoney)
+		return apperror.ErrNotEnoughMoney
 	}
 
 	key, stock := player.FindStocksBySymbol(card.Symbol)
@@ -69,13 +68,13 @@ func (service *playerService) SellStocks(card entity.CardStocks, player entity.P
 	})
 
 	if count <= 0 {
-		return errors.New(storage.ErrorIncorrectCount)
+		return apperror.ErrIncorrectCount
 	}
 
 	_, stock := player.FindStocksBySymbol(card.Symbol)
 
 	if stock.ID == "" || stock.Count < count {
-		return errors.New(storage.ErrorNotFoundStocks)
+		return apperror.ErrNotFoundStocks
 	}
 
 	totalCost := card.Price * count
@@ -111,7 +110,7 @@ func (service *playerService) DecreaseStocks(card entity.CardStocks, player enti
 	_, stock := player.FindStocksBySymbol(card.Symbol)
 
 	if stock.ID == "" {
-		return errors.New(storage.ErrorNotFoundStocks)
+		return apperror.ErrNotFoundStocks
 	}
 
 	var count int
@@ -136,7 +135,7 @@ func (service *playerService) IncreaseStocks(card entity.CardStocks, player enti
 	_, stock := player.FindStocksBySymbol(card.Symbol)
 
 	if stock.ID == "" {
-		return errors.New(storage.ErrorNotFoundStocks)
+		return apperror.ErrNotFoundStocks
 	}
 
 	stock.Count = stock.Count * card.Increase
@@ -159,7 +158,7 @@ func (service *playerService) TransferStocks(ID string, sender entity.Player, re
 	})
 
 	if count <= 0 {
-		return errors.New(storage.ErrorIncorrectCount)
+		return apperror.ErrIncorrectCount
 	}
 
 	_, item := sender.FindStocksByID(ID)
@@ -171,7 +170,7 @@ func (service *playerService) TransferStocks(ID string, sender entity.Player, re
 	})
 
 	if item.Count < count {
-		return errors.New(storage.ErrorNotEnoughStocks)
+		return apperror.ErrNotEnoughStocks
 	}
 
 	err := service.SellStocks(*item, sender, count, false)
@@ -1 +1,42 @@
 package service
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/webjohny/cashflow-go/entity"
+	"testing"
+)
+
+type countingNotificationService struct {
+	NotificationService
+	persisted int
+}
+
+func (s *countingNotificationService) Persist(player entity.Player) {
+	s.persisted++
+}
+
+func TestPlayerServiceUpdatePlayerPersistsNewNotifications(t *testing.T) {
+	notifications := &countingNotificationService{}
+	service := &playerService{
+		playerRepository:    &memoryPlayerRepository{players: map[uint64]entity.Player{}},
+		notificationService: notifications,
+	}
+	player := entity.Player{ID: 1, RaceID: 1}
+
+	_, _ = service.UpdatePlayer(&player)
+	assert.Equal(t, 0, notifications.persisted, "no notifications")
+
+	player.SetNotification("message", entity.NotificationTypes.Info)
+	_, _ = service.UpdatePlayer(&player)
+	assert.Equal(t, 1, notifications.persisted, "a new notification")
+
+	_, _ = service.UpdatePlayer(&player)
+	assert.Equal(t, 1, notifications.persisted, "the notification is already persisted")
+
+	player.SetNotification("message", entity.NotificationTypes.Info)
+	service.notificationService = nil
+
+	assert.NotPanics(t, func() {
+		_, _ = service.UpdatePlayer(&player)
+	})
+}
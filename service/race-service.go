@@ -1,14 +1,15 @@
 package service
 
 import (
-	"errors"
 	logger "github.com/sirupsen/logrus"
+	"github.com/webjohny/cashflow-go/apperror"
 	"github.com/webjohny/cashflow-go/dto"
 	"github.com/webjohny/cashflow-go/entity"
 	"github.com/webjohny/cashflow-go/helper"
 	"github.com/webjohny/cashflow-go/objects"
 	"github.com/webjohny/cashflow-go/repository"
 	"github.com/webjohny/cashflow-go/storage"
+	"time"
 )
 
 type RaceService interface {
@@ -34,6 +35,9 @@ type RaceService interface {
 	ChangeTurn(race entity.Race, forced bool, definedPlayerId int) error
 	CreateResponses(raceId uint64, currentPlayerId uint64) []entity.RaceResponse
 	GetRaceAndPlayer(raceId uint64, userId uint64) (error, entity.Race, entity.Player)
+	GetActiveRaceAndPlayer(raceId uint64, userId uint64) (error, entity.Race, entity.Player)
+	GetRacesByStatus(status string) []entity.Race
+	SetBigRaceConditions(raceId uint64, conditions entity.BigRaceConditions) (error, entity.Race)
 	GetRaceByRaceId(raceId uint64) entity.Race
 	GetRacePlayersByRaceId(raceId uint64, all bool) []dto.GetRacePlayerResponseDTO
 	GetFormattedRaceResponse(raceId uint64, hasExtraInfo bool) dto.GetRaceResponseDTO
@@ -43,16 +47,18 @@ type RaceService interface {
 }
 
 type raceService struct {
-	raceRepository     repository.RaceRepository
-	playerService      PlayerService
-	transactionService TransactionService
+	raceRepository        repository.RaceRepository
+	userRequestRepository repository.UserRequestRepository
+	playerService         PlayerService
+	transactionService    TransactionService
 }
 
-func NewRaceService(raceRepo repository.RaceRepository, playerService PlayerService, transactionService TransactionService) RaceService {
+func NewRaceService(raceRepo repository.RaceRepository, playerService PlayerService, transactionService TransactionService, userRequestRepo repository.UserRequestRepository) RaceService {
 	return &raceService{
-		raceRepository:     raceRepo,
-		playerService:      playerService,
-		transactionService: transactionService,
+		raceRepository:        raceRepo,
+		userRequestRepository: userRequestRepo,
+		playerService:         playerService,
+		transactionService:    transactionService,
 	}
 }
 
@@ -75,26 +81,37 @@ func (service *raceService) GetRaceAndPlayer(raceId uint64, userId uint64) (erro
 	if err != nil {
 		return err, entity.Race{}, entity.Player{}
 	} else if race.ID == 0 {
-		return errors.New(storage.ErrorUndefinedGame), entity.Race{}, entity.Player{}
+		return apperror.ErrUndefinedGame, entity.Race{}, entity.Player{}
 	}
 
 	return nil, race, player
 }
 
+// GetActiveRaceAndPlayer is GetRaceAndPlayer for the actions of the players, which are frozen while the race is paused.
+func (service *raceService) GetActiveRaceAndPlayer(raceId uint64, userId uint64) (error, entity.Race, entity.Player) {
+	err, race, player := service.GetRaceAndPlayer(raceId, userId)
+
+	if err == nil && race.IsPaused() {
+		return apperror.ErrGameIsPaused, entity.Race{}, entity.Player{}
+	}
+
+	return err, race, player
+}
+
 func (service *raceService) BusinessAction(raceId uint64, userId uint64, isBigRace bool, data dto.CardPurchaseActionDTO) error {
 	logger.Info("RaceService.BusinessAction", map[string]interface{}{
 		"raceId": raceId,
 		"userId": userId,
 	})
 
-	err, race, player := service.GetRaceAndPlayer(raceId, userId)
+	err, race, player := service.GetActiveRaceAndPlayer(raceId, userId)
 
 	if err != nil {
 		return err
 	}
 
 	if race.CurrentCard.Type != "business" {
-		return errors.New(storage.ErrorInvalidTypeOfCard)
+		return apperror.ErrInvalidTypeOfCard
 	}
 
 	card := entity.CardBusiness{
@@ -137,14 +154,14 @@ func (service *raceService) RealEstateAction(raceId uint64, userId uint64, isBig
 		"userId": userId,
 	})
 
-	err, race, player := service.GetRaceAndPlayer(raceId, userId)
+	err, race, player := service.GetActiveRaceAndPlayer(raceId, userId)
 
 	if err != nil {
 		return err
 	}
 
 	if race.CurrentCard.Type != "realEstate" {
-		return errors.New(storage.ErrorInvalidTypeOfCard)
+		return apperror.ErrInvalidTypeOfCard
 	}
 
 	card := entity.CardRealEstate{
@@ -192,7 +209,7 @@ func (service *raceService) DreamAction(raceId uint64, userId uint64) error {
 		"userId": userId,
 	})
 
-	err, race, player := service.GetRaceAndPlayer(raceId, userId)
+	err, race, player := service.GetActiveRaceAndPlayer(raceId, userId)
 
 	if err != nil {
 		return err
@@ -222,13 +239,13 @@ func (service *raceService) LotteryAction(raceId uint64, userId uint64, isBigRac
 		"userId": userId,
 	})
 
-	err, race, player := service.GetRaceAndPlayer(raceId, userId)
+	err, race, player := service.GetActiveRaceAndPlayer(raceId, userId)
 
 	if err != nil {
 		return err, dto.RiskResponseDTO{RolledDice: 0}
 	}
 	if race.CurrentCard.ID == "" {
-		return errors.New(storage.ErrorInvalidCard), dto.RiskResponseDTO{}
+		return apperror.ErrInvalidCard, dto.RiskResponseDTO{}
 	}
 
 	dice := objects.NewDice(1, 2, 6)
@@ -274,7 +291,7 @@ func (service *raceService) LotteryAction(raceId uint64, userId uint64, isBigRac
 	} else if !status && race.CurrentCard.Type == entity.SmallDealTypes.Lottery {
 		response.Error = storage.MessageFailLottery
 	} else {
-		return errors.New(storage.ErrorPermissionDenied), response
+		return apperror.ErrPermissionDenied, response
 	}
 
 	race.Respond(player.ID, race.CurrentPlayer.ID)
@@ -318,6 +335,7 @@ func (service *raceService) ChangeTurn(race entity.Race, forced bool, definedPla
 	}
 
 	race.CurrentCard = entity.Card{}
+	race.PendingRoll = nil
 
 	err, race = service.UpdateRace(&race)
 
@@ -325,6 +343,8 @@ func (service *raceService) ChangeTurn(race entity.Race, forced bool, definedPla
 		return err
 	}
 
+	service.expireUserRequests(race.ID)
+
 	if definedPlayerId == 0 && player.SkippedTurns > 0 {
 		player.DecrementSkippedTurns()
 		err, _ = service.playerService.UpdatePlayer(&player)
@@ -341,6 +361,38 @@ func (service *raceService) ChangeTurn(race entity.Race, forced bool, definedPla
 	return service.ChangeTurn(race, true, 0)
 }
 
+// expireUserRequests closes the requests the moderators left pending when the turn changes.
+func (service *raceService) expireUserRequests(raceId uint64) {
+	requests := service.userRequestRepository.All("race_id = ? AND status = ?", []interface{}{raceId, entity.UserRequestStatuses.Pending})
+
+	for _, request := range requests {
+		if !request.Transition(entity.UserRequestStatuses.Expired, 0) {
+			continue
+		}
+
+		if err, _ := service.userRequestRepository.Update(&request); err != nil {
+			logger.Error("RaceService.expireUserRequests", err, request.ID)
+			continue
+		}
+
+		err, player := service.playerService.GetPlayerByUserIdAndRaceId(raceId, request.UserID)
+
+		if err == nil {
+			player.SetNotificationWithParams(storage.MessageUserRequestExpired, entity.NotificationTypes.Warning, map[string]interface{}{
+				"username": player.Username,
+				"type":     request.Type,
+				"amount":   request.Amount,
+			})
+
+			if err, _ = service.playerService.UpdatePlayer(&player); err != nil {
+				logger.Error("RaceService.expireUserRequests", err, player.ID)
+			}
+		}
+
+		notifyUserRequestModerators(service.playerService, request, storage.MessageUserRequestExpired)
+	}
+}
+
 func (service *raceService) CreateResponses(raceId uint64, currentPlayerId uint64) []entity.RaceResponse {
 	logger.Info("RaceService.createResponses", map[string]interface{}{
 		"raceId":          raceId,
@@ -372,7 +424,7 @@ func (service *raceService) SellRealEstateAction(raceId uint64, userId uint64, r
 		"realEstateId": realEstateId,
 	})
 
-	err, race, player := service.GetRaceAndPlayer(raceId, userId)
+	err, race, player := service.GetActiveRaceAndPlayer(raceId, userId)
 
 	if err != nil {
 		return err
@@ -405,7 +457,7 @@ func (service *raceService) SellStocksAction(raceId uint64, userId uint64, count
 		"count":  count,
 	})
 
-	err, race, player := service.GetRaceAndPlayer(raceId, userId)
+	err, race, player := service.GetActiveRaceAndPlayer(raceId, userId)
 
 	if err != nil {
 		return err
@@ -427,6 +479,7 @@ func (service *raceService) SellStocksAction(raceId uint64, userId uint64, count
 	}, player, count, true)
 
 	if err == nil {
+		race.Market.Trade(race.CurrentCard.Symbol, race.CurrentCard.Price, count, entity.MarketEvents.Sell, race.CurrentCard.ID)
 		race.Respond(player.ID, race.CurrentPlayer.ID)
 		err, _ = service.UpdateRace(&race)
 	}
@@ -441,7 +494,7 @@ func (service *raceService) SellOtherAssetsAction(raceId uint64, userId uint64,
 		"count":  count,
 	})
 
-	err, race, player := service.GetRaceAndPlayer(raceId, userId)
+	err, race, player := service.GetActiveRaceAndPlayer(raceId, userId)
 
 	if err != nil {
 		return err
@@ -474,7 +527,7 @@ func (service *raceService) SellBusinessAction(raceId uint64, userId uint64, ass
 		"count":  count,
 	})
 
-	err, race, player := service.GetRaceAndPlayer(raceId, userId)
+	err, race, player := service.GetActiveRaceAndPlayer(raceId, userId)
 
 	if err != nil {
 		return err
@@ -508,14 +561,14 @@ func (service *raceService) StocksAction(raceId uint64, userId uint64, count int
 		"count":  count,
 	})
 
-	err, race, player := service.GetRaceAndPlayer(raceId, userId)
+	err, race, player := service.GetActiveRaceAndPlayer(raceId, userId)
 
 	if err != nil {
 		return err
 	}
 
 	if race.CurrentCard.Type != "stock" {
-		return errors.New(storage.ErrorInvalidTypeOfCard)
+		return apperror.ErrInvalidTypeOfCard
 	}
 
 	cardStocks := entity.CardStocks{}
@@ -527,6 +580,7 @@ func (service *raceService) StocksAction(raceId uint64, userId uint64, count int
 	}
 
 	if err == nil {
+		race.Market.Trade(cardStocks.Symbol, cardStocks.Price, count, entity.MarketEvents.Buy, cardStocks.ID)
 		race.Respond(player.ID, race.CurrentPlayer.ID)
 		err, _ = service.UpdateRace(&race)
 	}
@@ -541,7 +595,7 @@ func (service *raceService) OtherAssetsAction(raceId uint64, userId uint64, data
 		"dto":    data,
 	})
 
-	err, race, player := service.GetRaceAndPlayer(raceId, userId)
+	err, race, player := service.GetActiveRaceAndPlayer(raceId, userId)
 
 	if err != nil {
 		return err
@@ -587,7 +641,7 @@ func (service *raceService) MlmAction(raceId uint64, userId uint64, isBigRace bo
 		"userId": userId,
 	})
 
-	err, race, player := service.GetRaceAndPlayer(raceId, userId)
+	err, race, player := service.GetActiveRaceAndPlayer(raceId, userId)
 
 	if err == nil {
 		race.Respond(player.ID, race.CurrentPlayer.ID)
@@ -603,9 +657,19 @@ func (service *raceService) SkipAction(raceId uint64, userId uint64, isBigRace b
 		"userId": userId,
 	})
 
-	err, race, player := service.GetRaceAndPlayer(raceId, userId)
+	err, race, player := service.GetActiveRaceAndPlayer(raceId, userId)
 
 	if err == nil {
+		if race.Options.EnableAuctions && player.ID == race.CurrentPlayer.ID && race.CurrentCard.IsAuctionable() && !race.HasActiveAuction() {
+			duration := race.Options.AuctionDuration
+
+			if duration <= 0 {
+				duration = AuctionDefaultDuration
+			}
+
+			race.StartAuction(race.CurrentPlayer, time.Duration(duration)*time.Second)
+		}
+
 		race.Respond(player.ID, race.CurrentPlayer.ID)
 		err, _ = service.UpdateRace(&race)
 	}
@@ -619,7 +683,7 @@ func (service *raceService) CharityAction(raceId uint64, userId uint64, actionTy
 		"userId": userId,
 	})
 
-	err, race, player := service.GetRaceAndPlayer(raceId, userId)
+	err, race, player := service.GetActiveRaceAndPlayer(raceId, userId)
 
 	if err != nil {
 		return err
@@ -644,7 +708,7 @@ func (service *raceService) BabyAction(raceId uint64, userId uint64) (error, dto
 		"userId": userId,
 	})
 
-	err, race, player := service.GetRaceAndPlayer(raceId, userId)
+	err, race, player := service.GetActiveRaceAndPlayer(raceId, userId)
 
 	if err != nil {
 		return err, dto.MessageResponseDto{}
@@ -672,7 +736,7 @@ func (service *raceService) DoodadAction(raceId uint64, userId uint64) error {
 		"userId": userId,
 	})
 
-	err, race, player := service.GetRaceAndPlayer(raceId, userId)
+	err, race, player := service.GetActiveRaceAndPlayer(raceId, userId)
 
 	if err != nil {
 		return err
@@ -726,7 +790,7 @@ func (service *raceService) DownsizedAction(raceId uint64, userId uint64) error
 		"userId": userId,
 	})
 
-	err, race, player := service.GetRaceAndPlayer(raceId, userId)
+	err, race, player := service.GetActiveRaceAndPlayer(raceId, userId)
 
 	if err != nil {
 		return err
@@ -751,7 +815,7 @@ func (service *raceService) BigBankruptAction(raceId uint64, userId uint64) erro
 		"userId": userId,
 	})
 
-	err, race, player := service.GetRaceAndPlayer(raceId, userId)
+	err, race, player := service.GetActiveRaceAndPlayer(raceId, userId)
 
 	if err != nil {
 		return err
@@ -778,7 +842,7 @@ func (service *raceService) MarketAction(raceId uint64, userId uint64, actionTyp
 	})
 
 	// Retrieve race and player
-	err, race, player := service.GetRaceAndPlayer(raceId, userId)
+	err, race, player := service.GetActiveRaceAndPlayer(raceId, userId)
 
 	if err != nil {
 		return err
@@ -796,13 +860,13 @@ func (service *raceService) MarketAction(raceId uint64, userId uint64, actionTyp
 
 	if cardMarket.Type == entity.TransactionCardType.Damage {
 		if !player.HasOwnRealEstates() {
-			return errors.New(storage.ErrorNotFoundTheRealEstate)
+			return apperror.ErrNotFoundTheRealEstate
 		}
 
 		transactionCard.CardType = entity.TransactionCardType.Damage
 	} else if cardMarket.Type == entity.TransactionCardType.Business {
 		if !player.HasOwnRealEstates() {
-			return errors.New(storage.ErrorNotFoundTheBusiness)
+			return apperror.ErrNotFoundTheBusiness
 		}
 
 		transactionCard.CardType = entity.TransactionCardType.MarketBusiness
@@ -811,7 +875,7 @@ func (service *raceService) MarketAction(raceId uint64, userId uint64, actionTyp
 	trx := service.transactionService.GetRaceTransaction(player, transactionCard)
 
 	if trx.ID > 0 {
-		return errors.New(storage.ErrorTransactionAlreadyExists)
+		return apperror.ErrTransactionAlreadyExists
 	}
 
 	var actionErr error
@@ -846,12 +910,16 @@ func (service *raceService) PaydayAction(raceId uint64, userId uint64, actionTyp
 		"actionType": actionType,
 	})
 
-	err, race, player := service.GetRaceAndPlayer(raceId, userId)
+	err, race, player := service.GetActiveRaceAndPlayer(raceId, userId)
 
 	if err != nil {
 		return err
 	}
 
+	if actionType == "payday" || actionType == "cashFlowDay" {
+		player.RecordNetWorth(race.Market)
+	}
+
 	if actionType == "payday" {
 		return service.playerService.Payday(player, race.CurrentCard)
 	} else if actionType == "cashFlowDay" {
@@ -865,6 +933,59 @@ func (service *raceService) GetRaceByRaceId(raceId uint64) entity.Race {
 	return service.raceRepository.FindRaceById(raceId)
 }
 
+func (service *raceService) GetRacesByStatus(status string) []entity.Race {
+	return service.raceRepository.AllByStatus(status)
+}
+
+// SetBigRaceConditions changes the rules to enter the fast track for the race and every player already in it.
+func (service *raceService) SetBigRaceConditions(raceId uint64, conditions entity.BigRaceConditions) (error, entity.Race) {
+	logger.Info("RaceService.SetBigRaceConditions", map[string]interface{}{
+		"raceId":     raceId,
+		"conditions": conditions,
+	})
+
+	if err := validateBigRaceConditions(conditions); err != nil {
+		return err, entity.Race{}
+	}
+
+	race := service.GetRaceByRaceId(raceId)
+
+	if race.ID == 0 {
+		return apperror.ErrUndefinedGame, entity.Race{}
+	}
+
+	race.Options.BigRaceConditions = conditions
+
+	err, race := service.UpdateRace(&race)
+
+	if err != nil {
+		return err, entity.Race{}
+	}
+
+	players := service.playerService.GetAllPlayersByRaceId(raceId)
+	updates := make([]*entity.Player, 0)
+
+	for i := range players {
+		players[i].Info.Conditions = conditions
+		updates = append(updates, &players[i])
+	}
+
+	return service.playerService.UpdatePlayers(updates...), race
+}
+
+// validateBigRaceConditions rejects the rules the engine cannot check.
+func validateBigRaceConditions(conditions entity.BigRaceConditions) error {
+	for _, rule := range conditions.Rules {
+		if !rule.IsValid() {
+			return apperror.ErrUndefinedBigRaceRule.WithDetails(apperror.Details{
+				"type": rule.Type,
+			})
+		}
+	}
+
+	return nil
+}
+
 func (service *raceService) GetRacePlayersByRaceId(raceId uint64, all bool) []dto.GetRacePlayerResponseDTO {
 	players := make([]entity.Player, 0)
 
@@ -890,7 +1011,7 @@ func (service *raceService) GetFormattedRaceResponse(raceId uint64, hasExtraInfo
 	var logs []entity.RaceLog
 
 	if hasExtraInfo {
-		logs = service.transactionService.GetRaceLogs(raceId)
+		logs = service.transactionService.GetRaceLogs(raceId, race.Options.Language)
 	}
 
 	players := service.GetRacePlayersByRaceId(raceId, hasExtraInfo)
@@ -912,6 +1033,8 @@ func (service *raceService) GetFormattedRaceResponse(raceId uint64, hasExtraInfo
 		DiceValues:    race.Dice,
 		CurrentPlayer: player,
 		CurrentCard:   race.CurrentCard,
+		Auction:       race.Auction,
+		PendingRoll:   race.PendingRoll,
 		Options:       race.Options,
 		GameId:        race.ID,
 		IsTurnEnded:   race.IsReceived(player.Username),
@@ -0,0 +1,229 @@
+package service
+
+import (
+	logger "github.com/sirupsen/logrus"
+	"github.com/webjohny/cashflow-go/apperror"
+	"github.com/webjohny/cashflow-go/dto"
+	"github.com/webjohny/cashflow-go/entity"
+	"github.com/webjohny/cashflow-go/helper"
+	"github.com/webjohny/cashflow-go/i18n"
+	"github.com/webjohny/cashflow-go/repository"
+)
+
+type RaceTemplateService interface {
+	GetTemplates(userId uint64) []entity.RaceTemplate
+	GetTemplate(code string, userId uint64) (error, entity.RaceTemplate)
+	Create(userId uint64, body dto.RaceTemplateBodyDTO) (error, entity.RaceTemplate)
+	Update(code string, userId uint64, body dto.RaceTemplateBodyDTO) (error, entity.RaceTemplate)
+	Delete(code string, userId uint64) error
+	Validate(options entity.RaceOptions) error
+	SetLobbyOptions(lobbyId uint64, userId uint64, options entity.RaceOptions) (error, entity.Lobby)
+	UpdateLobbyOptions(lobbyId uint64, userId uint64, body dto.SetOptionsLobbyRequestDTO) (error, entity.Lobby)
+	ApplyToLobby(lobbyId uint64, userId uint64, code string) (error, entity.Lobby)
+}
+
+const DefaultCardCollection = "default"
+
+type raceTemplateService struct {
+	raceTemplateRepository repository.RaceTemplateRepository
+	lobbyService           LobbyService
+	cardService            CardService
+}
+
+func NewRaceTemplateService(raceTemplateRepository repository.RaceTemplateRepository, lobbyService LobbyService, cardService CardService) RaceTemplateService {
+	return &raceTemplateService{
+		raceTemplateRepository: raceTemplateRepository,
+		lobbyService:           lobbyService,
+		cardService:            cardService,
+	}
+}
+
+// GetTemplates returns the presets followed by the templates saved by the user.
+func (service *raceTemplateService) GetTemplates(userId uint64) []entity.RaceTemplate {
+	templates := make([]entity.RaceTemplate, 0)
+	templates = append(templates, entity.RaceTemplatePresets...)
+
+	return append(templates, service.raceTemplateRepository.AllByUserId(userId)...)
+}
+
+// GetTemplate finds a preset, a template of the user or a template another user shared by its code.
+func (service *raceTemplateService) GetTemplate(code string, userId uint64) (error, entity.RaceTemplate) {
+	template := entity.GetRaceTemplatePreset(code)
+
+	if template.Code == "" {
+		template = service.raceTemplateRepository.FindRaceTemplateByCode(code)
+	}
+
+	if template.Code == "" || !template.IsAvailableFor(userId) {
+		return apperror.ErrUndefinedRaceTemplate, entity.RaceTemplate{}
+	}
+
+	return nil, template
+}
+
+func (service *raceTemplateService) Create(userId uint64, body dto.RaceTemplateBodyDTO) (error, entity.RaceTemplate) {
+	logger.Info("RaceTemplateService.Create", map[string]interface{}{
+		"userId": userId,
+		"dto":    body,
+	})
+
+	if err := service.Validate(body.Options); err != nil {
+		return err, entity.RaceTemplate{}
+	}
+
+	return service.raceTemplateRepository.InsertRaceTemplate(&entity.RaceTemplate{
+		Code:        helper.Uuid("t"),
+		UserID:      userId,
+		Name:        body.Name,
+		Description: body.Description,
+		Options:     body.Options,
+		IsShared:    body.IsShared,
+	})
+}
+
+func (service *raceTemplateService) Update(code string, userId uint64, body dto.RaceTemplateBodyDTO) (error, entity.RaceTemplate) {
+	logger.Info("RaceTemplateService.Update", map[string]interface{}{
+		"code":   code,
+		"userId": userId,
+		"dto":    body,
+	})
+
+	err, template := service.getOwnTemplate(code, userId)
+
+	if err != nil {
+		return err, entity.RaceTemplate{}
+	}
+
+	if err = service.Validate(body.Options); err != nil {
+		return err, entity.RaceTemplate{}
+	}
+
+	template.Name = body.Name
+	template.Description = body.Description
+	template.Options = body.Options
+	template.IsShared = body.IsShared
+
+	return service.raceTemplateRepository.UpdateRaceTemplate(&template)
+}
+
+func (service *raceTemplateService) Delete(code string, userId uint64) error {
+	logger.Info("RaceTemplateService.Delete", map[string]interface{}{
+		"code":   code,
+		"userId": userId,
+	})
+
+	err, template := service.getOwnTemplate(code, userId)
+
+	if err != nil {
+		return err
+	}
+
+	return service.raceTemplateRepository.DeleteRaceTemplate(&template)
+}
+
+// Validate checks the fast track rules, the language and that the card collection is loaded in that language,
+// the default collection is always accepted as the cards of a race fall back to it.
+func (service *raceTemplateService) Validate(options entity.RaceOptions) error {
+	if err := validateBigRaceConditions(options.BigRaceConditions); err != nil {
+		return err
+	}
+
+	if options.Language != "" && !i18n.IsSupported(options.Language) {
+		return apperror.ErrUnsupportedLanguage.WithDetails(apperror.Details{
+			"language": options.Language,
+		})
+	}
+
+	if options.CardCollection == "" || options.CardCollection == DefaultCardCollection {
+		return nil
+	}
+
+	languages, ok := service.cardService.GetCollections()[options.CardCollection]
+
+	if !ok || (options.Language != "" && !helper.Contains[string](languages, options.Language)) {
+		return apperror.ErrUndefinedCardCollection.WithDetails(apperror.Details{
+			"collection": options.CardCollection,
+			"language":   options.Language,
+		})
+	}
+
+	return nil
+}
+
+func (service *raceTemplateService) SetLobbyOptions(lobbyId uint64, userId uint64, options entity.RaceOptions) (error, entity.Lobby) {
+	if err := service.Validate(options); err != nil {
+		return err, entity.Lobby{}
+	}
+
+	return service.lobbyService.SetOptions(lobbyId, userId, options)
+}
+
+// UpdateLobbyOptions changes the room settings of the lobby and keeps the other options.
+func (service *raceTemplateService) UpdateLobbyOptions(lobbyId uint64, userId uint64, body dto.SetOptionsLobbyRequestDTO) (error, entity.Lobby) {
+	lobby := service.lobbyService.GetByID(lobbyId)
+
+	if lobby.ID == 0 {
+		return apperror.ErrUndefinedLobby, entity.Lobby{}
+	}
+
+	options := lobby.Options
+	options.HandMode = body.HandMode
+	options.MeetLink = body.MeetLink
+	options.BannerLink = body.BannerLink
+	options.BannerImage = body.BannerImage
+	options.Language = body.Language
+
+	return service.SetLobbyOptions(lobbyId, userId, options)
+}
+
+// ApplyToLobby replaces the options of the lobby with the template, the links of the lobby are kept
+// unless the template sets its own.
+func (service *raceTemplateService) ApplyToLobby(lobbyId uint64, userId uint64, code string) (error, entity.Lobby) {
+	logger.Info("RaceTemplateService.ApplyToLobby", map[string]interface{}{
+		"lobbyId": lobbyId,
+		"userId":  userId,
+		"code":    code,
+	})
+
+	err, template := service.GetTemplate(code, userId)
+
+	if err != nil {
+		return err, entity.Lobby{}
+	}
+
+	lobby := service.lobbyService.GetByID(lobbyId)
+
+	if lobby.ID == 0 {
+		return apperror.ErrUndefinedLobby, entity.Lobby{}
+	}
+
+	options := template.Options
+
+	if options.MeetLink == "" {
+		options.MeetLink = lobby.Options.MeetLink
+	}
+
+	if options.BannerLink == "" {
+		options.BannerLink = lobby.Options.BannerLink
+	}
+
+	if options.BannerImage == "" {
+		options.BannerImage = lobby.Options.BannerImage
+	}
+
+	if options.Language == "" {
+		options.Language = lobby.Options.Language
+	}
+
+	return service.SetLobbyOptions(lobbyId, userId, options)
+}
+
+func (service *raceTemplateService) getOwnTemplate(code string, userId uint64) (error, entity.RaceTemplate) {
+	template := service.raceTemplateRepository.FindRaceTemplateByCode(code)
+
+	if template.ID == 0 || !template.IsOwnedBy(userId) {
+		return apperror.ErrUndefinedRaceTemplate, entity.RaceTemplate{}
+	}
+
+	return nil, template
+}
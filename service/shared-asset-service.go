@@ -0,0 +1,255 @@
+package service
+
+import (
+	logger "github.com/sirupsen/logrus"
+	"github.com/webjohny/cashflow-go/apperror"
+	"github.com/webjohny/cashflow-go/dto"
+	"github.com/webjohny/cashflow-go/entity"
+	"github.com/webjohny/cashflow-go/helper"
+	"github.com/webjohny/cashflow-go/repository"
+	"github.com/webjohny/cashflow-go/storage"
+	"time"
+)
+
+type SharedAssetService interface {
+	GetSharedAssets(raceId uint64, userId uint64) (error, []entity.SharedAsset)
+	ProposeBuyout(raceId uint64, userId uint64, sharedAssetId uint64, body dto.SharedAssetBuyoutBodyDTO) (error, entity.SharedAsset)
+	AcceptBuyout(raceId uint64, userId uint64, sharedAssetId uint64, buyoutId string) (error, entity.SharedAsset)
+	DeclineBuyout(raceId uint64, userId uint64, sharedAssetId uint64, buyoutId string) (error, entity.SharedAsset)
+}
+
+type sharedAssetService struct {
+	sharedAssetRepository repository.SharedAssetRepository
+	raceService           RaceService
+	playerService         PlayerService
+}
+
+func NewSharedAssetService(sharedAssetRepository repository.SharedAssetRepository, raceService RaceService, playerService PlayerService) SharedAssetService {
+	return &sharedAssetService{
+		sharedAssetRepository: sharedAssetRepository,
+		raceService:           raceService,
+		playerService:         playerService,
+	}
+}
+
+func (service *sharedAssetService) GetSharedAssets(raceId uint64, userId uint64) (error, []entity.SharedAsset) {
+	err, _, player := service.raceService.GetRaceAndPlayer(raceId, userId)
+
+	if err != nil {
+		return err, []entity.SharedAsset{}
+	}
+
+	ledgers := make([]entity.SharedAsset, 0)
+
+	for _, ledger := range service.sharedAssetRepository.AllActiveByRaceId(raceId) {
+		if _, share := ledger.FindShare(player.ID); share.PlayerID != 0 {
+			ledgers = append(ledgers, ledger)
+		}
+	}
+
+	return nil, ledgers
+}
+
+// ProposeBuyout offers the seller a price for a part of their share, the cash moves once the seller accepts.
+func (service *sharedAssetService) ProposeBuyout(raceId uint64, userId uint64, sharedAssetId uint64, body dto.SharedAssetBuyoutBodyDTO) (error, entity.SharedAsset) {
+	logger.Info("SharedAssetService.ProposeBuyout", map[string]interface{}{
+		"raceId":        raceId,
+		"userId":        userId,
+		"sharedAssetId": sharedAssetId,
+		"body":          body,
+	})
+
+	err, race, buyer := service.raceService.GetActiveRaceAndPlayer(raceId, userId)
+
+	if err != nil {
+		return err, entity.SharedAsset{}
+	}
+
+	if race.Status != entity.RaceStatus.STARTED {
+		return apperror.ErrGameIsNotStarted, entity.SharedAsset{}
+	}
+
+	err, ledger := service.getSharedAsset(raceId, buyer.ID, sharedAssetId)
+
+	if err != nil {
+		return err, entity.SharedAsset{}
+	}
+
+	if body.SellerId == buyer.ID {
+		return apperror.ErrCannotTradeWithYourself, entity.SharedAsset{}
+	}
+
+	_, share := ledger.FindShare(body.SellerId)
+
+	if share.PlayerID == 0 {
+		return apperror.ErrNotAPartner, entity.SharedAsset{}
+	}
+
+	if body.Percent <= 0 || body.Percent > share.Percent || body.Price < 0 {
+		return apperror.ErrIncorrectCount, entity.SharedAsset{}
+	}
+
+	if buyer.Cash < body.Price {
+		return apperror.ErrNotEnoughMoney, entity.SharedAsset{}
+	}
+
+	ledger.Buyouts = append(ledger.Buyouts, entity.SharedAssetBuyout{
+		ID:        helper.Uuid("buyout"),
+		BuyerID:   buyer.ID,
+		SellerID:  share.PlayerID,
+		Percent:   body.Percent,
+		Price:     body.Price,
+		Status:    entity.SharedAssetBuyoutStatuses.Pending,
+		CreatedAt: time.Now(),
+	})
+
+	return service.sharedAssetRepository.UpdateSharedAsset(&ledger)
+}
+
+// AcceptBuyout pays the seller and moves the percent to the buyer, a seller left without a share leaves the asset.
+func (service *sharedAssetService) AcceptBuyout(raceId uint64, userId uint64, sharedAssetId uint64, buyoutId string) (error, entity.SharedAsset) {
+	logger.Info("SharedAssetService.AcceptBuyout", map[string]interface{}{
+		"raceId":        raceId,
+		"userId":        userId,
+		"sharedAssetId": sharedAssetId,
+		"buyoutId":      buyoutId,
+	})
+
+	err, seller, ledger, buyout := service.getBuyout(raceId, userId, sharedAssetId, buyoutId)
+
+	if err != nil {
+		return err, entity.SharedAsset{}
+	}
+
+	if buyout.SellerID != seller.ID {
+		return apperror.ErrPermissionDenied, entity.SharedAsset{}
+	}
+
+	_, share := ledger.FindShare(seller.ID)
+
+	if share.Percent < buyout.Percent {
+		return apperror.ErrIncorrectCount, entity.SharedAsset{}
+	}
+
+	err, buyer := service.playerService.GetPlayerByPlayerIdAndRaceId(raceId, buyout.BuyerID)
+
+	if err != nil || buyer.ID == 0 {
+		return apperror.ErrUndefinedReceiverPlayer, entity.SharedAsset{}
+	}
+
+	if buyer.Cash < buyout.Price {
+		return apperror.ErrNotEnoughMoney, entity.SharedAsset{}
+	}
+
+	params := map[string]interface{}{
+		"amount":  buyout.Price,
+		"percent": buyout.Percent,
+		"card":    ledger.Heading,
+	}
+
+	err = service.playerService.UpdateCash(&buyer, -buyout.Price, &dto.TransactionDTO{
+		CardID:   buyout.ID,
+		CardType: entity.TransactionCardType.Trade,
+		Details:  ledger.Heading,
+		Code:     storage.TransactionBoughtOutShare,
+		Params:   params,
+	})
+
+	if err != nil {
+		return err, entity.SharedAsset{}
+	}
+
+	err = service.playerService.UpdateCash(&seller, buyout.Price, &dto.TransactionDTO{
+		CardID:   buyout.ID,
+		CardType: entity.TransactionCardType.Trade,
+		Details:  ledger.Heading,
+		Code:     storage.TransactionSoldShare,
+		Params:   params,
+	})
+
+	if err != nil {
+		return err, entity.SharedAsset{}
+	}
+
+	buyout.Status = entity.SharedAssetBuyoutStatuses.Accepted
+	ledger.Transfer(seller.ID, buyer.ID, buyout.Percent, buyer.Username)
+
+	var left []uint64
+
+	if _, share = ledger.FindShare(seller.ID); share.PlayerID == 0 {
+		left = append(left, seller.ID)
+	}
+
+	if err = service.playerService.SyncSharedAsset(ledger, left...); err != nil {
+		return err, entity.SharedAsset{}
+	}
+
+	return nil, service.sharedAssetRepository.FindSharedAssetById(ledger.ID)
+}
+
+// DeclineBuyout lets the seller decline the buyout or the buyer cancel it.
+func (service *sharedAssetService) DeclineBuyout(raceId uint64, userId uint64, sharedAssetId uint64, buyoutId string) (error, entity.SharedAsset) {
+	logger.Info("SharedAssetService.DeclineBuyout", map[string]interface{}{
+		"raceId":        raceId,
+		"userId":        userId,
+		"sharedAssetId": sharedAssetId,
+		"buyoutId":      buyoutId,
+	})
+
+	err, player, ledger, buyout := service.getBuyout(raceId, userId, sharedAssetId, buyoutId)
+
+	if err != nil {
+		return err, entity.SharedAsset{}
+	}
+
+	switch player.ID {
+	case buyout.SellerID:
+		buyout.Status = entity.SharedAssetBuyoutStatuses.Declined
+	case buyout.BuyerID:
+		buyout.Status = entity.SharedAssetBuyoutStatuses.Cancelled
+	default:
+		return apperror.ErrPermissionDenied, entity.SharedAsset{}
+	}
+
+	return service.sharedAssetRepository.UpdateSharedAsset(&ledger)
+}
+
+func (service *sharedAssetService) getSharedAsset(raceId uint64, playerId uint64, sharedAssetId uint64) (error, entity.SharedAsset) {
+	ledger := service.sharedAssetRepository.FindSharedAssetById(sharedAssetId)
+
+	if ledger.ID == 0 || ledger.RaceID != raceId || !ledger.IsActive() {
+		return apperror.ErrUndefinedSharedAsset, entity.SharedAsset{}
+	}
+
+	if _, share := ledger.FindShare(playerId); share.PlayerID == 0 {
+		return apperror.ErrNotAPartner, entity.SharedAsset{}
+	}
+
+	return nil, ledger
+}
+
+func (service *sharedAssetService) getBuyout(raceId uint64, userId uint64, sharedAssetId uint64, buyoutId string) (error, entity.Player, entity.SharedAsset, *entity.SharedAssetBuyout) {
+	err, _, player := service.raceService.GetActiveRaceAndPlayer(raceId, userId)
+
+	if err != nil {
+		return err, entity.Player{}, entity.SharedAsset{}, nil
+	}
+
+	err, ledger := service.getSharedAsset(raceId, player.ID, sharedAssetId)
+
+	if err != nil {
+		return err, entity.Player{}, entity.SharedAsset{}, nil
+	}
+
+	buyout := ledger.FindBuyout(buyoutId)
+
+	if buyout.ID == "" {
+		return apperror.ErrUndefinedBuyout, entity.Player{}, entity.SharedAsset{}, nil
+	}
+
+	if buyout.Status != entity.SharedAssetBuyoutStatuses.Pending {
+		return apperror.ErrBuyoutIsNotPending, entity.Player{}, entity.SharedAsset{}, nil
+	}
+
+	return nil, player, ledger, buyout
+}
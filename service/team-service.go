@@ -0,0 +1,261 @@
+package service
+
+import (
+	"encoding/json"
+	logger "github.com/sirupsen/logrus"
+	"github.com/webjohny/cashflow-go/apperror"
+	"github.com/webjohny/cashflow-go/dto"
+	"github.com/webjohny/cashflow-go/entity"
+	"github.com/webjohny/cashflow-go/repository"
+	"github.com/webjohny/cashflow-go/storage"
+)
+
+type TeamService interface {
+	Purchase(actionType string, raceId uint64, userId uint64, isBigRace bool, body dto.CardPurchaseActionDTO) (error, interface{})
+	Selling(actionType string, raceId uint64, userId uint64, isBigRace bool, body dto.CardSellingActionDTO) (error, interface{})
+	GetDecisions(raceId uint64, userId uint64) (error, []entity.TeamDecision)
+	Vote(raceId uint64, userId uint64, decisionId uint64, approve bool) (error, entity.TeamDecision)
+}
+
+type teamService struct {
+	teamDecisionRepository repository.TeamDecisionRepository
+	raceService            RaceService
+	playerService          PlayerService
+	cardService            CardService
+}
+
+func NewTeamService(teamDecisionRepository repository.TeamDecisionRepository, raceService RaceService, playerService PlayerService, cardService CardService) TeamService {
+	return &teamService{
+		teamDecisionRepository: teamDecisionRepository,
+		raceService:            raceService,
+		playerService:          playerService,
+		cardService:            cardService,
+	}
+}
+
+// Purchase buys the card right away unless the player is a team whose deals need approval, then the
+// purchase is proposed to the team.
+func (service *teamService) Purchase(actionType string, raceId uint64, userId uint64, isBigRace bool, body dto.CardPurchaseActionDTO) (error, interface{}) {
+	err, race, player := service.raceService.GetActiveRaceAndPlayer(raceId, userId)
+
+	if err != nil || !service.needsDecision(race, player, userId) {
+		return service.cardService.Purchase(actionType, raceId, userId, isBigRace, body)
+	}
+
+	return service.propose(race, player, userId, entity.TeamDecisionActions.Purchase, actionType, isBigRace, body)
+}
+
+// Selling sells right away unless the player is a team whose deals need approval, then the sale is
+// proposed to the team.
+func (service *teamService) Selling(actionType string, raceId uint64, userId uint64, isBigRace bool, body dto.CardSellingActionDTO) (error, interface{}) {
+	err, race, player := service.raceService.GetActiveRaceAndPlayer(raceId, userId)
+
+	if err != nil || !service.needsDecision(race, player, userId) {
+		return service.cardService.Selling(actionType, raceId, userId, isBigRace, body)
+	}
+
+	return service.propose(race, player, userId, entity.TeamDecisionActions.Selling, actionType, isBigRace, body)
+}
+
+// GetDecisions returns the decisions of the user's team, the pending ones of a card that is gone expire.
+func (service *teamService) GetDecisions(raceId uint64, userId uint64) (error, []entity.TeamDecision) {
+	err, race, player := service.raceService.GetRaceAndPlayer(raceId, userId)
+
+	if err != nil {
+		return err, []entity.TeamDecision{}
+	}
+
+	if !player.IsTeam() {
+		return apperror.ErrTeamModeDisabled, []entity.TeamDecision{}
+	}
+
+	decisions := service.teamDecisionRepository.AllByPlayerId(raceId, player.ID)
+
+	for i := range decisions {
+		service.expire(race, &decisions[i])
+	}
+
+	return nil, decisions
+}
+
+// Vote records the vote of a member, the captain decides alone in captain mode and the majority of the
+// members in vote mode. An approved deal is carried out on behalf of the member who proposed it.
+func (service *teamService) Vote(raceId uint64, userId uint64, decisionId uint64, approve bool) (error, entity.TeamDecision) {
+	logger.Info("TeamService.Vote", map[string]interface{}{
+		"raceId":     raceId,
+		"userId":     userId,
+		"decisionId": decisionId,
+		"approve":    approve,
+	})
+
+	err, race, player := service.raceService.GetActiveRaceAndPlayer(raceId, userId)
+
+	if err != nil {
+		return err, entity.TeamDecision{}
+	}
+
+	if !player.IsTeam() {
+		return apperror.ErrTeamModeDisabled, entity.TeamDecision{}
+	}
+
+	decision := service.teamDecisionRepository.FindTeamDecisionById(decisionId, raceId)
+
+	if decision.ID == 0 || decision.PlayerID != player.ID {
+		return apperror.ErrUndefinedTeamDecision, entity.TeamDecision{}
+	}
+
+	if service.expire(race, &decision) || !decision.IsPending() {
+		return apperror.ErrTeamDecisionIsNotPending, decision
+	}
+
+	isVote := race.Options.GetTeamDecision() == entity.TeamDecisionModes.Vote
+
+	if !isVote && !player.Team.IsCaptain(userId) {
+		return apperror.ErrPermissionDenied, entity.TeamDecision{}
+	}
+
+	if decision.Votes == nil {
+		decision.Votes = make(map[uint64]bool)
+	}
+
+	decision.Votes[userId] = approve
+
+	return service.resolve(race, player, decision)
+}
+
+func (service *teamService) needsDecision(race entity.Race, player entity.Player, userId uint64) bool {
+	if !race.Options.EnableTeams || !player.IsTeam() {
+		return false
+	}
+
+	return race.Options.GetTeamDecision() == entity.TeamDecisionModes.Vote || !player.Team.IsCaptain(userId)
+}
+
+func (service *teamService) propose(race entity.Race, player entity.Player, userId uint64, action string, actionType string, isBigRace bool, body interface{}) (error, interface{}) {
+	logger.Info("TeamService.propose", map[string]interface{}{
+		"raceId":     race.ID,
+		"userId":     userId,
+		"action":     action,
+		"actionType": actionType,
+	})
+
+	payload, err := json.Marshal(body)
+
+	if err != nil {
+		return err, nil
+	}
+
+	err, decision := service.teamDecisionRepository.InsertTeamDecision(&entity.TeamDecision{
+		RaceID:     race.ID,
+		PlayerID:   player.ID,
+		ProposedBy: userId,
+		CardID:     race.CurrentCard.ID,
+		Action:     action,
+		ActionType: actionType,
+		IsBigRace:  isBigRace,
+		Payload:    payload,
+		Votes:      map[uint64]bool{userId: true},
+		Status:     entity.TeamDecisionStatuses.Pending,
+	})
+
+	if err != nil {
+		return err, nil
+	}
+
+	service.notify(player, storage.MessageTeamDecisionProposed, race.CurrentCard.Heading, service.getMemberName(player, userId))
+
+	err, decision = service.resolve(race, player, decision)
+
+	return err, decision
+}
+
+// resolve carries out or rejects the decision once the votes settle it and keeps it pending otherwise.
+func (service *teamService) resolve(race entity.Race, player entity.Player, decision entity.TeamDecision) (error, entity.TeamDecision) {
+	approvals, rejections := decision.CountVotes()
+	members := len(player.Team.Members)
+
+	if race.Options.GetTeamDecision() == entity.TeamDecisionModes.Captain {
+		approvals, rejections = 0, 0
+
+		if approve, ok := decision.Votes[player.Team.CaptainID]; ok && approve {
+			approvals = members
+		} else if ok {
+			rejections = members
+		}
+	}
+
+	if approvals*2 > members {
+		if err := service.execute(decision); err != nil {
+			return err, decision
+		}
+
+		decision.Resolve(entity.TeamDecisionStatuses.Approved)
+		service.notify(player, storage.MessageTeamDecisionApproved, race.CurrentCard.Heading, "")
+	} else if rejections*2 >= members {
+		decision.Resolve(entity.TeamDecisionStatuses.Rejected)
+		service.notify(player, storage.MessageTeamDecisionRejected, race.CurrentCard.Heading, "")
+	}
+
+	return service.teamDecisionRepository.UpdateTeamDecision(&decision)
+}
+
+func (service *teamService) execute(decision entity.TeamDecision) error {
+	var err error
+
+	if decision.Action == entity.TeamDecisionActions.Selling {
+		var body dto.CardSellingActionDTO
+
+		if err = json.Unmarshal(decision.Payload, &body); err == nil {
+			err, _ = service.cardService.Selling(decision.ActionType, decision.RaceID, decision.ProposedBy, decision.IsBigRace, body)
+		}
+	} else {
+		var body dto.CardPurchaseActionDTO
+
+		if err = json.Unmarshal(decision.Payload, &body); err == nil {
+			err, _ = service.cardService.Purchase(decision.ActionType, decision.RaceID, decision.ProposedBy, decision.IsBigRace, body)
+		}
+	}
+
+	return err
+}
+
+// expire closes a pending decision of a card that is no longer on the table.
+func (service *teamService) expire(race entity.Race, decision *entity.TeamDecision) bool {
+	if !decision.IsPending() || race.CurrentCard.ID == decision.CardID {
+		return false
+	}
+
+	decision.Resolve(entity.TeamDecisionStatuses.Expired)
+
+	if err, _ := service.teamDecisionRepository.UpdateTeamDecision(decision); err != nil {
+		logger.Error("TeamService.expire", err, decision.ID)
+	}
+
+	return true
+}
+
+func (service *teamService) getMemberName(player entity.Player, userId uint64) string {
+	for _, member := range player.Team.Members {
+		if member.UserID == userId {
+			return member.Username
+		}
+	}
+
+	return player.Username
+}
+
+// notify reloads the player as a carried out deal changes it before the notification is saved.
+func (service *teamService) notify(player entity.Player, message string, card string, username string) {
+	err, player := service.playerService.GetPlayerByPlayerIdAndRaceId(player.RaceID, player.ID)
+
+	if err != nil || player.ID == 0 {
+		return
+	}
+
+	player.SetNotificationWithParams(message, entity.NotificationTypes.Info, map[string]interface{}{
+		"card":     card,
+		"username": username,
+	})
+
+	_, _ = service.playerService.UpdatePlayer(&player)
+}
@@ -0,0 +1,492 @@
+package service
+
+import (
+	logger "github.com/sirupsen/logrus"
+	"github.com/webjohny/cashflow-go/apperror"
+	"github.com/webjohny/cashflow-go/dto"
+	"github.com/webjohny/cashflow-go/entity"
+	"github.com/webjohny/cashflow-go/helper"
+	"github.com/webjohny/cashflow-go/repository"
+	"math/rand"
+	"time"
+)
+
+type TournamentService interface {
+	GetTournaments() []entity.Tournament
+	GetTournament(tournamentId uint64) (error, dto.TournamentResponseDTO)
+	GetStandings(tournamentId uint64) (error, []entity.TournamentParticipant)
+	Create(userId uint64, body dto.TournamentBodyDTO) (error, entity.Tournament)
+	Join(tournamentId uint64, userId uint64, username string) (error, entity.Tournament)
+	Leave(tournamentId uint64, userId uint64) (error, entity.Tournament)
+	Cancel(tournamentId uint64, userId uint64) (error, entity.Tournament)
+	StartRound(tournamentId uint64, userId uint64, username string) (error, []entity.TournamentTable)
+	CloseTable(tournamentId uint64, tableId uint64, userId uint64) (error, entity.TournamentTable)
+}
+
+type tournamentService struct {
+	tournamentRepository repository.TournamentRepository
+	lobbyService         LobbyService
+	raceService          RaceService
+	playerService        PlayerService
+	raceTemplateService  RaceTemplateService
+}
+
+func NewTournamentService(tournamentRepository repository.TournamentRepository, lobbyService LobbyService, raceService RaceService, playerService PlayerService, raceTemplateService RaceTemplateService) TournamentService {
+	return &tournamentService{
+		tournamentRepository: tournamentRepository,
+		lobbyService:         lobbyService,
+		raceService:          raceService,
+		playerService:        playerService,
+		raceTemplateService:  raceTemplateService,
+	}
+}
+
+// GetTournaments returns the tournaments open to join and the ones being played.
+func (service *tournamentService) GetTournaments() []entity.Tournament {
+	return service.tournamentRepository.AllByStatuses(entity.TournamentStatuses.New, entity.TournamentStatuses.Started)
+}
+
+func (service *tournamentService) GetTournament(tournamentId uint64) (error, dto.TournamentResponseDTO) {
+	err, tournament, tables := service.sync(tournamentId)
+
+	if err != nil {
+		return err, dto.TournamentResponseDTO{}
+	}
+
+	return nil, dto.TournamentResponseDTO{
+		Tournament: tournament,
+		Tables:     tables,
+		Standings:  tournament.GetStandings(),
+	}
+}
+
+func (service *tournamentService) GetStandings(tournamentId uint64) (error, []entity.TournamentParticipant) {
+	err, tournament, _ := service.sync(tournamentId)
+
+	if err != nil {
+		return err, []entity.TournamentParticipant{}
+	}
+
+	return nil, tournament.GetStandings()
+}
+
+// Create validates the format and the race options of the tournament, the races are played by single players
+// so teams and the wait list are turned off.
+func (service *tournamentService) Create(userId uint64, body dto.TournamentBodyDTO) (error, entity.Tournament) {
+	logger.Info("TournamentService.Create", map[string]interface{}{
+		"userId": userId,
+		"dto":    body,
+	})
+
+	tournament := entity.Tournament{
+		OwnerID:      userId,
+		Name:         body.Name,
+		Format:       body.Format,
+		AdvanceBy:    body.AdvanceBy,
+		TableSize:    body.TableSize,
+		Advancing:    body.Advancing,
+		Rounds:       body.Rounds,
+		Options:      body.Options,
+		Participants: make([]entity.TournamentParticipant, 0),
+		Status:       entity.TournamentStatuses.New,
+	}
+
+	if !tournament.IsValid() || tournament.TableSize > LobbyMaxPlayers {
+		return apperror.ErrInvalidTournament, entity.Tournament{}
+	}
+
+	if err := service.raceTemplateService.Validate(tournament.Options); err != nil {
+		return err, entity.Tournament{}
+	}
+
+	tournament.Options.EnableTeams = false
+	tournament.Options.EnableWaitList = false
+
+	return service.tournamentRepository.InsertTournament(&tournament)
+}
+
+func (service *tournamentService) Join(tournamentId uint64, userId uint64, username string) (error, entity.Tournament) {
+	logger.Info("TournamentService.Join", map[string]interface{}{
+		"tournamentId": tournamentId,
+		"userId":       userId,
+	})
+
+	err, tournament := service.getNewTournament(tournamentId)
+
+	if err != nil {
+		return err, entity.Tournament{}
+	}
+
+	tournament.AddParticipant(userId, username)
+
+	return service.tournamentRepository.UpdateTournament(&tournament)
+}
+
+func (service *tournamentService) Leave(tournamentId uint64, userId uint64) (error, entity.Tournament) {
+	logger.Info("TournamentService.Leave", map[string]interface{}{
+		"tournamentId": tournamentId,
+		"userId":       userId,
+	})
+
+	err, tournament := service.getNewTournament(tournamentId)
+
+	if err != nil {
+		return err, entity.Tournament{}
+	}
+
+	if tournament.GetParticipant(userId) == nil {
+		return apperror.ErrUndefinedPlayer, entity.Tournament{}
+	}
+
+	tournament.RemoveParticipant(userId)
+
+	return service.tournamentRepository.UpdateTournament(&tournament)
+}
+
+// Cancel stops the tournament together with the lobbies and races of the tables still played.
+func (service *tournamentService) Cancel(tournamentId uint64, userId uint64) (error, entity.Tournament) {
+	logger.Info("TournamentService.Cancel", map[string]interface{}{
+		"tournamentId": tournamentId,
+		"userId":       userId,
+	})
+
+	err, tournament := service.getOwnTournament(tournamentId, userId)
+
+	if err != nil {
+		return err, entity.Tournament{}
+	}
+
+	for _, table := range service.tournamentRepository.AllTablesByTournamentId(tournament.ID) {
+		if table.Round == tournament.CurrentRound && !table.IsFinished {
+			service.cancelTable(table, userId)
+		}
+	}
+
+	tournament.Status = entity.TournamentStatuses.Cancelled
+
+	return service.tournamentRepository.UpdateTournament(&tournament)
+}
+
+// StartRound seats the participants who are still in the tournament and creates a lobby for every table,
+// the organizer moderates the lobbies. The first round is seated at random, the next ones by the standings.
+func (service *tournamentService) StartRound(tournamentId uint64, userId uint64, username string) (error, []entity.TournamentTable) {
+	logger.Info("TournamentService.StartRound", map[string]interface{}{
+		"tournamentId": tournamentId,
+		"userId":       userId,
+	})
+
+	err, tournament := service.getOwnTournament(tournamentId, userId)
+
+	if err != nil {
+		return err, []entity.TournamentTable{}
+	}
+
+	err, tournament, tables := service.sync(tournamentId)
+
+	if err != nil {
+		return err, []entity.TournamentTable{}
+	}
+
+	if tournament.Status == entity.TournamentStatuses.Finished {
+		return apperror.ErrTournamentIsFinished, []entity.TournamentTable{}
+	}
+
+	for _, table := range tables {
+		if !table.IsFinished {
+			return apperror.ErrTournamentRoundInProgress.WithDetails(apperror.Details{
+				"round": table.Round,
+			}), []entity.TournamentTable{}
+		}
+	}
+
+	contenders := tournament.GetContenders()
+
+	if len(contenders) < 2 {
+		return apperror.ErrInsufficientPlayers, []entity.TournamentTable{}
+	}
+
+	if tournament.CurrentRound == 0 {
+		r := rand.New(rand.NewSource(time.Now().UnixNano()))
+		r.Shuffle(len(contenders), func(i, j int) {
+			contenders[i], contenders[j] = contenders[j], contenders[i]
+		})
+	}
+
+	tournament.CurrentRound++
+	tournament.Status = entity.TournamentStatuses.Started
+
+	moderator := entity.LobbyPlayer{ID: userId, Username: username}
+	created := make([]entity.TournamentTable, 0)
+
+	for number, seated := range tournament.SeatContenders(contenders) {
+		seats := make([]entity.LobbyPlayer, 0)
+		table := entity.TournamentTable{
+			TournamentID: tournament.ID,
+			Round:        tournament.CurrentRound,
+			Number:       number + 1,
+			Seats:        make([]uint64, 0),
+			Results:      make([]entity.TournamentResult, 0),
+		}
+
+		for _, participant := range seated {
+			seats = append(seats, entity.LobbyPlayer{ID: participant.UserID, Username: participant.Username})
+			table.Seats = append(table.Seats, participant.UserID)
+		}
+
+		err, lobby := service.lobbyService.CreateSeated(moderator, seats, tournament.Options)
+
+		if err != nil {
+			return err, []entity.TournamentTable{}
+		}
+
+		table.LobbyID = lobby.ID
+
+		err, table = service.tournamentRepository.InsertTournamentTable(&table)
+
+		if err != nil {
+			return err, []entity.TournamentTable{}
+		}
+
+		created = append(created, table)
+	}
+
+	err, _ = service.tournamentRepository.UpdateTournament(&tournament)
+
+	if err != nil {
+		return err, []entity.TournamentTable{}
+	}
+
+	return nil, created
+}
+
+// CloseTable lets the organizer end the race of a table of the current round, the seated players are ranked
+// as they stand.
+func (service *tournamentService) CloseTable(tournamentId uint64, tableId uint64, userId uint64) (error, entity.TournamentTable) {
+	logger.Info("TournamentService.CloseTable", map[string]interface{}{
+		"tournamentId": tournamentId,
+		"tableId":      tableId,
+		"userId":       userId,
+	})
+
+	err, tournament := service.getOwnTournament(tournamentId, userId)
+
+	if err != nil {
+		return err, entity.TournamentTable{}
+	}
+
+	var table entity.TournamentTable
+
+	for _, item := range service.tournamentRepository.AllTablesByTournamentId(tournament.ID) {
+		if item.ID == tableId && item.Round == tournament.CurrentRound && !item.IsFinished {
+			table = item
+		}
+	}
+
+	if table.ID == 0 {
+		return apperror.ErrUndefinedTournamentTable, entity.TournamentTable{}
+	}
+
+	lobby := service.lobbyService.GetByID(table.LobbyID)
+
+	if lobby.GameId == 0 {
+		return apperror.ErrUndefinedGame, entity.TournamentTable{}
+	}
+
+	race := service.raceService.GetRaceByRaceId(lobby.GameId)
+
+	if race.Status != entity.RaceStatus.FINISHED {
+		if err = service.finishRace(race); err != nil {
+			return err, entity.TournamentTable{}
+		}
+	}
+
+	err, _, tables := service.sync(tournament.ID)
+
+	if err != nil {
+		return err, entity.TournamentTable{}
+	}
+
+	for _, item := range tables {
+		if item.ID == table.ID {
+			table = item
+		}
+	}
+
+	return nil, table
+}
+
+func (service *tournamentService) getNewTournament(tournamentId uint64) (error, entity.Tournament) {
+	tournament := service.tournamentRepository.FindTournamentById(tournamentId)
+
+	if tournament.ID == 0 {
+		return apperror.ErrUndefinedTournament, entity.Tournament{}
+	}
+
+	if !tournament.IsNew() {
+		return apperror.ErrTournamentIsStarted, entity.Tournament{}
+	}
+
+	return nil, tournament
+}
+
+func (service *tournamentService) getOwnTournament(tournamentId uint64, userId uint64) (error, entity.Tournament) {
+	tournament := service.tournamentRepository.FindTournamentById(tournamentId)
+
+	if tournament.ID == 0 {
+		return apperror.ErrUndefinedTournament, entity.Tournament{}
+	}
+
+	if !tournament.IsOwnedBy(userId) {
+		return apperror.ErrPermissionDenied, entity.Tournament{}
+	}
+
+	if tournament.Status == entity.TournamentStatuses.Finished || tournament.Status == entity.TournamentStatuses.Cancelled {
+		return apperror.ErrTournamentIsFinished, entity.Tournament{}
+	}
+
+	return nil, tournament
+}
+
+// sync takes the results of the tables whose race has finished, the tournament is over once the last swiss
+// round or the final table of the bracket is played. A table is finished by one request only, so concurrent
+// reads apply its results once.
+func (service *tournamentService) sync(tournamentId uint64) (error, entity.Tournament, []entity.TournamentTable) {
+	tournament := service.tournamentRepository.FindTournamentById(tournamentId)
+
+	if tournament.ID == 0 {
+		return apperror.ErrUndefinedTournament, entity.Tournament{}, []entity.TournamentTable{}
+	}
+
+	tables := service.tournamentRepository.AllTablesByTournamentId(tournament.ID)
+
+	if !tournament.IsStarted() {
+		return nil, tournament, tables
+	}
+
+	changed := false
+
+	for i := range tables {
+		table := tables[i]
+
+		if table.Round != tournament.CurrentRound || table.IsFinished || !service.collectResults(tournament, &table) {
+			continue
+		}
+
+		err, finished := service.tournamentRepository.FinishTournamentTable(&table, func(locked *entity.Tournament, all []entity.TournamentTable) {
+			locked.ApplyResults(table.Results)
+
+			if isTournamentOver(*locked, all) {
+				locked.Status = entity.TournamentStatuses.Finished
+			}
+		})
+
+		if err != nil {
+			return err, entity.Tournament{}, []entity.TournamentTable{}
+		}
+
+		changed = changed || finished
+	}
+
+	if !changed {
+		return nil, tournament, tables
+	}
+
+	return nil, service.tournamentRepository.FindTournamentById(tournament.ID), service.tournamentRepository.AllTablesByTournamentId(tournament.ID)
+}
+
+// isTournamentOver tells whether every table of the current round is finished and the round is the last one.
+func isTournamentOver(tournament entity.Tournament, tables []entity.TournamentTable) bool {
+	round := 0
+
+	for _, table := range tables {
+		if table.Round != tournament.CurrentRound {
+			continue
+		}
+
+		if !table.IsFinished {
+			return false
+		}
+
+		round++
+	}
+
+	return (tournament.Format == entity.TournamentFormats.Swiss && tournament.CurrentRound >= tournament.Rounds) ||
+		(tournament.Format == entity.TournamentFormats.Bracket && round == 1)
+}
+
+// collectResults ranks the seated players of the table once the race of its lobby has finished, the race is
+// finished as soon as one of them has completed the fast track.
+func (service *tournamentService) collectResults(tournament entity.Tournament, table *entity.TournamentTable) bool {
+	lobby := service.lobbyService.GetByID(table.LobbyID)
+
+	if lobby.GameId == 0 {
+		return false
+	}
+
+	table.RaceID = lobby.GameId
+	race := service.raceService.GetRaceByRaceId(table.RaceID)
+	players := make([]entity.Player, 0)
+	isCompleted := false
+
+	for _, player := range service.playerService.GetAllStatePlayersByRaceId(race.ID) {
+		if helper.Contains[uint64](table.Seats, player.UserID) {
+			players = append(players, player)
+			isCompleted = isCompleted || player.ConditionsForCompletedBigRace()
+		}
+	}
+
+	if race.Status != entity.RaceStatus.FINISHED {
+		if !isCompleted {
+			return false
+		}
+
+		if err := service.finishRace(race); err != nil {
+			logger.Error("TournamentService.collectResults", err, race.ID)
+
+			return false
+		}
+	}
+
+	table.Results = entity.RankTournamentResults(players, race.Market, tournament.AdvanceBy)
+	table.IsFinished = true
+
+	return true
+}
+
+// cancelTable cancels the lobby of the table, or its race once the lobby has started, a failure is only logged
+// so that the other tables are cancelled too.
+func (service *tournamentService) cancelTable(table entity.TournamentTable, userId uint64) {
+	lobby := service.lobbyService.GetByID(table.LobbyID)
+
+	if lobby.GameId == 0 {
+		if err, _ := service.lobbyService.Cancel(lobby.ID, userId); err != nil {
+			logger.Error("TournamentService.cancelTable", err, table.ID)
+		}
+
+		return
+	}
+
+	race := service.raceService.GetRaceByRaceId(lobby.GameId)
+
+	if race.Status == entity.RaceStatus.FINISHED || race.Status == entity.RaceStatus.CANCELLED {
+		return
+	}
+
+	race.Status = entity.RaceStatus.CANCELLED
+
+	_ = service.lobbyService.ChangeStatusByGameId(race.ID, entity.LobbyStatus.Cancelled)
+
+	if err, _ := service.raceService.UpdateRace(&race); err != nil {
+		logger.Error("TournamentService.cancelTable", err, table.ID)
+	}
+}
+
+func (service *tournamentService) finishRace(race entity.Race) error {
+	race.Status = entity.RaceStatus.FINISHED
+
+	_ = service.lobbyService.ChangeStatusByGameId(race.ID, entity.LobbyStatus.Cancelled)
+
+	err, _ := service.raceService.UpdateRace(&race)
+
+	return err
+}
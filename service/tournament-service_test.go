@@ -0,0 +1,191 @@
+package service
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/webjohny/cashflow-go/entity"
+	"github.com/webjohny/cashflow-go/repository"
+	"testing"
+)
+
+type tournamentRepository struct {
+	repository.TournamentRepository
+	tournament entity.Tournament
+	tables     []entity.TournamentTable
+	// read is what the requests read, it stays behind the saved tables like a read made before another
+	// request finished them.
+	read []entity.TournamentTable
+}
+
+func (r *tournamentRepository) FindTournamentById(ID uint64) entity.Tournament {
+	return r.tournament
+}
+
+func (r *tournamentRepository) UpdateTournament(b *entity.Tournament) (error, entity.Tournament) {
+	r.tournament = *b
+
+	return nil, *b
+}
+
+func (r *tournamentRepository) AllTablesByTournamentId(tournamentId uint64) []entity.TournamentTable {
+	if r.read != nil {
+		return append([]entity.TournamentTable{}, r.read...)
+	}
+
+	return append([]entity.TournamentTable{}, r.tables...)
+}
+
+func (r *tournamentRepository) FinishTournamentTable(b *entity.TournamentTable, apply func(tournament *entity.Tournament, tables []entity.TournamentTable)) (error, bool) {
+	for i := range r.tables {
+		if r.tables[i].ID == b.ID {
+			if r.tables[i].IsFinished {
+				return nil, false
+			}
+
+			b.IsFinished = true
+			r.tables[i] = *b
+		}
+	}
+
+	apply(&r.tournament, r.tables)
+
+	return nil, true
+}
+
+type tournamentLobbyService struct {
+	LobbyService
+	lobbies   map[uint64]entity.Lobby
+	cancelled []uint64
+}
+
+func (s *tournamentLobbyService) GetByID(lobbyId uint64) entity.Lobby {
+	return s.lobbies[lobbyId]
+}
+
+func (s *tournamentLobbyService) Cancel(ID uint64, userId uint64) (error, entity.Lobby) {
+	s.cancelled = append(s.cancelled, ID)
+
+	return nil, s.lobbies[ID]
+}
+
+func (s *tournamentLobbyService) ChangeStatusByGameId(gameId uint64, status string) error {
+	for ID, lobby := range s.lobbies {
+		if lobby.GameId == gameId && status == entity.LobbyStatus.Cancelled {
+			s.cancelled = append(s.cancelled, ID)
+		}
+	}
+
+	return nil
+}
+
+type tournamentRaceService struct {
+	RaceService
+	races map[uint64]entity.Race
+}
+
+func (s *tournamentRaceService) GetRaceByRaceId(raceId uint64) entity.Race {
+	return s.races[raceId]
+}
+
+func (s *tournamentRaceService) UpdateRace(b *entity.Race) (error, entity.Race) {
+	s.races[b.ID] = *b
+
+	return nil, *b
+}
+
+type tournamentPlayerService struct {
+	PlayerService
+	players []entity.Player
+}
+
+func (s *tournamentPlayerService) GetAllStatePlayersByRaceId(raceId uint64) []entity.Player {
+	players := make([]entity.Player, 0)
+
+	for _, player := range s.players {
+		if player.RaceID == raceId {
+			players = append(players, player)
+		}
+	}
+
+	return players
+}
+
+// newTournamentService seats five players at three tables of a bracket: the race of the first table is finished,
+// the race of the second one is played and the lobby of the third one has not started yet.
+func newTournamentService() (*tournamentService, *tournamentRepository, *tournamentLobbyService, *tournamentRaceService) {
+	tournaments := &tournamentRepository{
+		tournament: entity.Tournament{
+			ID:           1,
+			OwnerID:      9,
+			Format:       entity.TournamentFormats.Bracket,
+			AdvanceBy:    entity.TournamentAdvancements.NetWorth,
+			TableSize:    2,
+			Advancing:    1,
+			CurrentRound: 1,
+			Status:       entity.TournamentStatuses.Started,
+			Participants: []entity.TournamentParticipant{{UserID: 1}, {UserID: 2}, {UserID: 3}, {UserID: 4}, {UserID: 5}},
+		},
+		tables: []entity.TournamentTable{
+			{ID: 1, TournamentID: 1, Round: 1, Number: 1, LobbyID: 1, Seats: []uint64{1, 2}},
+			{ID: 2, TournamentID: 1, Round: 1, Number: 2, LobbyID: 2, Seats: []uint64{3, 4}},
+			{ID: 3, TournamentID: 1, Round: 1, Number: 3, LobbyID: 3, Seats: []uint64{5}},
+		},
+	}
+	lobbies := &tournamentLobbyService{lobbies: map[uint64]entity.Lobby{
+		1: {ID: 1, GameId: 11},
+		2: {ID: 2, GameId: 12},
+		3: {ID: 3},
+	}}
+	races := &tournamentRaceService{races: map[uint64]entity.Race{
+		11: {ID: 11, Status: entity.RaceStatus.FINISHED},
+		12: {ID: 12, Status: entity.RaceStatus.STARTED},
+	}}
+	players := &tournamentPlayerService{players: []entity.Player{
+		{ID: 1, UserID: 1, RaceID: 11, Cash: 5000},
+		{ID: 2, UserID: 2, RaceID: 11, Cash: 1000},
+		{ID: 3, UserID: 3, RaceID: 12, Cash: 1000},
+		{ID: 4, UserID: 4, RaceID: 12, Cash: 1000},
+	}}
+
+	return &tournamentService{
+		tournamentRepository: tournaments,
+		lobbyService:         lobbies,
+		raceService:          races,
+		playerService:        players,
+	}, tournaments, lobbies, races
+}
+
+func TestTournamentServiceSyncAppliesResultsOnce(t *testing.T) {
+	service, tournaments, _, _ := newTournamentService()
+	tournaments.read = append([]entity.TournamentTable{}, tournaments.tables...)
+
+	for i := 0; i < 3; i++ {
+		err, _, _ := service.sync(1)
+
+		assert.NoError(t, err)
+	}
+
+	winner := tournaments.tournament.GetParticipant(1)
+	loser := tournaments.tournament.GetParticipant(2)
+
+	assert.Equal(t, 1, winner.Rounds)
+	assert.Equal(t, 1, winner.Wins)
+	assert.Equal(t, 1, loser.Rounds)
+	assert.True(t, loser.Eliminated)
+	assert.Equal(t, 0, tournaments.tournament.GetParticipant(3).Rounds)
+	assert.True(t, tournaments.tables[0].IsFinished)
+	assert.False(t, tournaments.tables[1].IsFinished)
+	assert.Equal(t, entity.TournamentStatuses.Started, tournaments.tournament.Status)
+}
+
+func TestTournamentServiceCancel(t *testing.T) {
+	service, tournaments, lobbies, races := newTournamentService()
+	tournaments.tables[0].IsFinished = true
+
+	err, tournament := service.Cancel(1, 9)
+
+	assert.NoError(t, err)
+	assert.Equal(t, entity.TournamentStatuses.Cancelled, tournament.Status)
+	assert.ElementsMatch(t, []uint64{2, 3}, lobbies.cancelled)
+	assert.Equal(t, entity.RaceStatus.FINISHED, races.races[11].Status)
+	assert.Equal(t, entity.RaceStatus.CANCELLED, races.races[12].Status)
+}
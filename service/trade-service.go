@@ -0,0 +1,428 @@
+package service
+
+import (
+	logger "github.com/sirupsen/logrus"
+	"github.com/webjohny/cashflow-go/apperror"
+	"github.com/webjohny/cashflow-go/dto"
+	"github.com/webjohny/cashflow-go/entity"
+	"github.com/webjohny/cashflow-go/helper"
+	"github.com/webjohny/cashflow-go/repository"
+	"github.com/webjohny/cashflow-go/storage"
+)
+
+type TradeService interface {
+	GetOffers(raceId uint64, userId uint64) (error, []entity.TradeOffer)
+	Propose(raceId uint64, userId uint64, body dto.TradeOfferBodyDTO) (error, entity.TradeOffer)
+	Counter(raceId uint64, userId uint64, offerId uint64, body dto.TradeOfferBodyDTO) (error, entity.TradeOffer)
+	Accept(raceId uint64, userId uint64, offerId uint64) (error, entity.TradeOffer)
+	Decline(raceId uint64, userId uint64, offerId uint64) (error, entity.TradeOffer)
+	Cancel(raceId uint64, userId uint64, offerId uint64) (error, entity.TradeOffer)
+}
+
+type tradeService struct {
+	tradeRepository repository.TradeRepository
+	raceService     RaceService
+	playerService   PlayerService
+}
+
+func NewTradeService(tradeRepository repository.TradeRepository, raceService RaceService, playerService PlayerService) TradeService {
+	return &tradeService{
+		tradeRepository: tradeRepository,
+		raceService:     raceService,
+		playerService:   playerService,
+	}
+}
+
+func (service *tradeService) GetOffers(raceId uint64, userId uint64) (error, []entity.TradeOffer) {
+	err, _, player := service.raceService.GetRaceAndPlayer(raceId, userId)
+
+	if err != nil {
+		return err, []entity.TradeOffer{}
+	}
+
+	return nil, service.tradeRepository.AllByRaceIdAndPlayerId(raceId, player.ID)
+}
+
+func (service *tradeService) Propose(raceId uint64, userId uint64, body dto.TradeOfferBodyDTO) (error, entity.TradeOffer) {
+	logger.Info("TradeService.Propose", map[string]interface{}{
+		"raceId": raceId,
+		"userId": userId,
+		"body":   body,
+	})
+
+	err, race, sender := service.raceService.GetActiveRaceAndPlayer(raceId, userId)
+
+	if err != nil {
+		return err, entity.TradeOffer{}
+	}
+
+	if race.Status != entity.RaceStatus.STARTED {
+		return apperror.ErrGameIsNotStarted, entity.TradeOffer{}
+	}
+
+	err, receiver := service.playerService.GetPlayerByPlayerIdAndRaceId(raceId, body.PlayerId)
+
+	if err != nil || receiver.ID == 0 {
+		return apperror.ErrUndefinedReceiverPlayer, entity.TradeOffer{}
+	}
+
+	return service.create(sender, receiver, body, 0)
+}
+
+func (service *tradeService) Counter(raceId uint64, userId uint64, offerId uint64, body dto.TradeOfferBodyDTO) (error, entity.TradeOffer) {
+	logger.Info("TradeService.Counter", map[string]interface{}{
+		"raceId":  raceId,
+		"userId":  userId,
+		"offerId": offerId,
+		"body":    body,
+	})
+
+	err, player, offer := service.getReceivedOffer(raceId, userId, offerId)
+
+	if err != nil {
+		return err, entity.TradeOffer{}
+	}
+
+	err, sender := service.playerService.GetPlayerByPlayerIdAndRaceId(raceId, offer.SenderID)
+
+	if err != nil || sender.ID == 0 {
+		return apperror.ErrUndefinedReceiverPlayer, entity.TradeOffer{}
+	}
+
+	err, counter := service.create(player, sender, body, offer.ID)
+
+	if err != nil {
+		return err, entity.TradeOffer{}
+	}
+
+	offer.Status = entity.TradeOfferStatuses.Countered
+
+	err, _ = service.tradeRepository.UpdateTradeOffer(&offer)
+
+	if err != nil {
+		return err, entity.TradeOffer{}
+	}
+
+	return nil, counter
+}
+
+func (service *tradeService) Accept(raceId uint64, userId uint64, offerId uint64) (error, entity.TradeOffer) {
+	logger.Info("TradeService.Accept", map[string]interface{}{
+		"raceId":  raceId,
+		"userId":  userId,
+		"offerId": offerId,
+	})
+
+	err, receiver, offer := service.getReceivedOffer(raceId, userId, offerId)
+
+	if err != nil {
+		return err, entity.TradeOffer{}
+	}
+
+	err, sender := service.playerService.GetPlayerByPlayerIdAndRaceId(raceId, offer.SenderID)
+
+	if err != nil || sender.ID == 0 {
+		return apperror.ErrUndefinedReceiverPlayer, entity.TradeOffer{}
+	}
+
+	err = service.moveBundle(offer.Offer, &sender, &receiver)
+
+	if err != nil {
+		return err, entity.TradeOffer{}
+	}
+
+	err = service.moveBundle(offer.Request, &receiver, &sender)
+
+	if err != nil {
+		return err, entity.TradeOffer{}
+	}
+
+	sender.SetNotification(storage.MessageTradeOfferAccepted, entity.NotificationTypes.Success)
+
+	err = service.playerService.UpdatePlayers(&sender, &receiver)
+
+	if err != nil {
+		return err, entity.TradeOffer{}
+	}
+
+	offer.Status = entity.TradeOfferStatuses.Accepted
+
+	err, offer = service.tradeRepository.UpdateTradeOffer(&offer)
+
+	if err != nil {
+		return err, entity.TradeOffer{}
+	}
+
+	service.setTransaction(offer, sender, receiver, offer.Request.Cash-offer.Offer.Cash)
+	service.setTransaction(offer, receiver, sender, offer.Offer.Cash-offer.Request.Cash)
+
+	return nil, offer
+}
+
+func (service *tradeService) Decline(raceId uint64, userId uint64, offerId uint64) (error, entity.TradeOffer) {
+	logger.Info("TradeService.Decline", map[string]interface{}{
+		"raceId":  raceId,
+		"userId":  userId,
+		"offerId": offerId,
+	})
+
+	err, _, offer := service.getReceivedOffer(raceId, userId, offerId)
+
+	if err != nil {
+		return err, entity.TradeOffer{}
+	}
+
+	offer.Status = entity.TradeOfferStatuses.Declined
+
+	err, offer = service.tradeRepository.UpdateTradeOffer(&offer)
+
+	if err != nil {
+		return err, entity.TradeOffer{}
+	}
+
+	service.notify(raceId, offer.SenderID, storage.MessageTradeOfferDeclined, entity.NotificationTypes.Warning)
+
+	return nil, offer
+}
+
+func (service *tradeService) Cancel(raceId uint64, userId uint64, offerId uint64) (error, entity.TradeOffer) {
+	logger.Info("TradeService.Cancel", map[string]interface{}{
+		"raceId":  raceId,
+		"userId":  userId,
+		"offerId": offerId,
+	})
+
+	err, player, offer := service.getOffer(raceId, userId, offerId)
+
+	if err != nil {
+		return err, entity.TradeOffer{}
+	}
+
+	if offer.SenderID != player.ID {
+		return apperror.ErrPermissionDenied, entity.TradeOffer{}
+	}
+
+	offer.Status = entity.TradeOfferStatuses.Cancelled
+
+	return service.tradeRepository.UpdateTradeOffer(&offer)
+}
+
+func (service *tradeService) create(sender entity.Player, receiver entity.Player, body dto.TradeOfferBodyDTO, parentId uint64) (error, entity.TradeOffer) {
+	if sender.ID == receiver.ID {
+		return apperror.ErrCannotTradeWithYourself, entity.TradeOffer{}
+	}
+
+	if body.Offer.IsEmpty() && body.Request.IsEmpty() {
+		return apperror.ErrEmptyTradeOffer, entity.TradeOffer{}
+	}
+
+	// Dry run both sides on copies so that an offer which can never be executed is rejected upfront.
+	senderCopy := helper.Clone(sender)
+	receiverCopy := helper.Clone(receiver)
+
+	err := service.moveBundle(body.Offer, &senderCopy, &receiverCopy)
+
+	if err != nil {
+		return err, entity.TradeOffer{}
+	}
+
+	err = service.moveBundle(body.Request, &receiverCopy, &senderCopy)
+
+	if err != nil {
+		return err, entity.TradeOffer{}
+	}
+
+	err, offer := service.tradeRepository.InsertTradeOffer(&entity.TradeOffer{
+		RaceID:     sender.RaceID,
+		SenderID:   sender.ID,
+		ReceiverID: receiver.ID,
+		ParentID:   parentId,
+		Offer:      body.Offer,
+		Request:    body.Request,
+		Message:    body.Message,
+		Status:     entity.TradeOfferStatuses.Pending,
+	})
+
+	if err != nil {
+		return err, entity.TradeOffer{}
+	}
+
+	message := storage.MessageYouReceivedTradeOffer
+
+	if parentId > 0 {
+		message = storage.MessageTradeOfferCountered
+	}
+
+	service.notify(receiver.RaceID, receiver.ID, message, entity.NotificationTypes.Info)
+
+	return nil, offer
+}
+
+func (service *tradeService) getOffer(raceId uint64, userId uint64, offerId uint64) (error, entity.Player, entity.TradeOffer) {
+	err, _, player := service.raceService.GetActiveRaceAndPlayer(raceId, userId)
+
+	if err != nil {
+		return err, entity.Player{}, entity.TradeOffer{}
+	}
+
+	offer := service.tradeRepository.FindTradeOfferById(offerId)
+
+	if offer.ID == 0 || offer.RaceID != raceId || !offer.IsParticipant(player.ID) {
+		return apperror.ErrUndefinedTradeOffer, entity.Player{}, entity.TradeOffer{}
+	}
+
+	if !offer.IsPending() {
+		return apperror.ErrTradeOfferIsNotPending, entity.Player{}, entity.TradeOffer{}
+	}
+
+	return nil, player, offer
+}
+
+func (service *tradeService) getReceivedOffer(raceId uint64, userId uint64, offerId uint64) (error, entity.Player, entity.TradeOffer) {
+	err, player, offer := service.getOffer(raceId, userId, offerId)
+
+	if err != nil {
+		return err, entity.Player{}, entity.TradeOffer{}
+	}
+
+	if offer.ReceiverID != player.ID {
+		return apperror.ErrPermissionDenied, entity.Player{}, entity.TradeOffer{}
+	}
+
+	return nil, player, offer
+}
+
+func (service *tradeService) moveBundle(bundle entity.TradeBundle, from *entity.Player, to *entity.Player) error {
+	if bundle.Cash < 0 {
+		return apperror.ErrIncorrectCount
+	}
+
+	if from.Cash < bundle.Cash {
+		return apperror.ErrNotEnoughMoney
+	}
+
+	from.Cash -= bundle.Cash
+	to.Cash += bundle.Cash
+
+	for _, item := range bundle.Stocks {
+		if item.Count <= 0 {
+			return apperror.ErrIncorrectCount
+		}
+
+		_, stock := from.FindStocksBySymbol(item.Symbol)
+
+		if stock.ID == "" {
+			return apperror.ErrNotFoundStocks
+		}
+
+		if stock.Count < item.Count {
+			return apperror.ErrNotEnoughStocks
+		}
+
+		transferred := *stock
+		transferred.Count = item.Count
+		transferred.History = make([]entity.CardHistory, 0)
+
+		stock.Count -= item.Count
+
+		if stock.Count <= 0 {
+			from.RemoveStocks(stock.Symbol)
+		} else {
+			from.ReduceStocks(stock.Symbol, item.Count)
+		}
+
+		_, existing := to.FindStocksBySymbol(item.Symbol)
+
+		if existing.ID != "" {
+			existing.Count += item.Count
+			existing.SetCardHistory(entity.CardHistory{
+				Price: transferred.Price,
+				Count: item.Count,
+			})
+		} else {
+			transferred.SetCardHistory(entity.CardHistory{
+				Price: transferred.Price,
+				Count: item.Count,
+			})
+			to.Assets.Stocks = append(to.Assets.Stocks, transferred)
+		}
+	}
+
+	for _, ID := range bundle.RealEstates {
+		realEstate := from.FindRealEstateByID(ID)
+
+		if realEstate.ID == "" {
+			return apperror.ErrNotFoundTheRealEstate
+		}
+
+		if to.FindRealEstateByID(ID).ID != "" {
+			return apperror.ErrAssetAlreadyOwned
+		}
+
+		to.Assets.RealEstates = append(to.Assets.RealEstates, *realEstate)
+		from.RemoveRealEstate(ID)
+	}
+
+	for _, item := range bundle.Business {
+		index, business := from.FindBusinessByID(item.ID)
+
+		if index == -1 {
+			return apperror.ErrNotFoundTheBusiness
+		}
+
+		transferred := *business
+		_, existing := to.FindBusinessByID(item.ID)
+
+		if item.Count > 0 && item.Count < business.Count {
+			transferred.Count = item.Count
+			transferred.History = make([]entity.CardHistory, 0)
+
+			from.ReduceLimitedShares(item.ID, item.Count)
+			business.Count -= item.Count
+		} else if item.Count > business.Count {
+			return apperror.ErrNotEnoughAsset
+		} else {
+			from.RemoveBusiness(item.ID)
+		}
+
+		if existing.ID != "" {
+			existing.Count += transferred.Count
+		} else {
+			to.AddBusiness(transferred)
+		}
+	}
+
+	return nil
+}
+
+func (service *tradeService) setTransaction(offer entity.TradeOffer, player entity.Player, counterparty entity.Player, amount int) {
+	err := service.playerService.SetTransaction(player, dto.TransactionDTO{
+		CardID:   offer.GetCardID(),
+		CardType: entity.TransactionCardType.Trade,
+		Amount:   amount,
+		Code:     storage.TransactionTrade,
+		Params: map[string]interface{}{
+			"offerId":  offer.ID,
+			"username": helper.CamelToCapitalize(counterparty.Username),
+		},
+	})
+
+	if err != nil {
+		logger.Error(err, map[string]interface{}{
+			"offerId":  offer.ID,
+			"playerId": player.ID,
+		})
+	}
+}
+
+func (service *tradeService) notify(raceId uint64, playerId uint64, message string, typeMessage string) {
+	err, player := service.playerService.GetPlayerByPlayerIdAndRaceId(raceId, playerId)
+
+	if err != nil || player.ID == 0 {
+		return
+	}
+
+	player.SetNotification(message, typeMessage)
+
+	_, _ = service.playerService.UpdatePlayer(&player)
+}
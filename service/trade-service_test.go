@@ -0,0 +1,111 @@
+package service
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/webjohny/cashflow-go/apperror"
+	"github.com/webjohny/cashflow-go/entity"
+	"testing"
+)
+
+func tradeHoldings(player entity.Player) map[string]int {
+	holdings := map[string]int{"cash": player.Cash}
+
+	for _, stock := range player.Assets.Stocks {
+		holdings[stock.Symbol] = stock.Count
+	}
+
+	for _, realEstate := range player.Assets.RealEstates {
+		holdings[realEstate.ID] = 1
+	}
+
+	for _, business := range player.Assets.Business {
+		holdings[business.ID] = business.Count
+	}
+
+	return holdings
+}
+
+func TestTradeServiceMoveBundle(t *testing.T) {
+	tests := []struct {
+		name   string
+		bundle entity.TradeBundle
+		err    error
+		from   map[string]int
+		to     map[string]int
+	}{
+		{
+			name:   "cash",
+			bundle: entity.TradeBundle{Cash: 300},
+			from:   map[string]int{"cash": 700, "ON2U": 10, "r1": 1, "r2": 1, "b1": 5},
+			to:     map[string]int{"cash": 400, "MYT4U": 3, "r2": 1, "r3": 1},
+		},
+		{
+			name:   "part of the stocks",
+			bundle: entity.TradeBundle{Stocks: []entity.TradeStock{{Symbol: "ON2U", Count: 4}}},
+			from:   map[string]int{"cash": 1000, "ON2U": 6, "r1": 1, "r2": 1, "b1": 5},
+			to:     map[string]int{"cash": 100, "MYT4U": 3, "ON2U": 4, "r2": 1, "r3": 1},
+		},
+		{
+			name:   "every stock",
+			bundle: entity.TradeBundle{Stocks: []entity.TradeStock{{Symbol: "ON2U", Count: 10}}},
+			from:   map[string]int{"cash": 1000, "r1": 1, "r2": 1, "b1": 5},
+			to:     map[string]int{"cash": 100, "MYT4U": 3, "ON2U": 10, "r2": 1, "r3": 1},
+		},
+		{
+			name:   "a real estate that is not the last one",
+			bundle: entity.TradeBundle{RealEstates: []string{"r1"}},
+			from:   map[string]int{"cash": 1000, "ON2U": 10, "r2": 1, "b1": 5},
+			to:     map[string]int{"cash": 100, "MYT4U": 3, "r1": 1, "r2": 1, "r3": 1},
+		},
+		{
+			name:   "part of the business shares",
+			bundle: entity.TradeBundle{Business: []entity.TradeBusiness{{ID: "b1", Count: 2}}},
+			from:   map[string]int{"cash": 1000, "ON2U": 10, "r1": 1, "r2": 1, "b1": 3},
+			to:     map[string]int{"cash": 100, "MYT4U": 3, "r2": 1, "r3": 1, "b1": 2},
+		},
+		{
+			name:   "the whole business",
+			bundle: entity.TradeBundle{Business: []entity.TradeBusiness{{ID: "b1"}}},
+			from:   map[string]int{"cash": 1000, "ON2U": 10, "r1": 1, "r2": 1},
+			to:     map[string]int{"cash": 100, "MYT4U": 3, "r2": 1, "r3": 1, "b1": 5},
+		},
+		{name: "negative cash", bundle: entity.TradeBundle{Cash: -1}, err: apperror.ErrIncorrectCount},
+		{name: "more cash than the sender has", bundle: entity.TradeBundle{Cash: 1001}, err: apperror.ErrNotEnoughMoney},
+		{name: "no stocks to give", bundle: entity.TradeBundle{Stocks: []entity.TradeStock{{Symbol: "OK4U", Count: 1}}}, err: apperror.ErrNotFoundStocks},
+		{name: "more stocks than the sender has", bundle: entity.TradeBundle{Stocks: []entity.TradeStock{{Symbol: "ON2U", Count: 11}}}, err: apperror.ErrNotEnoughStocks},
+		{name: "no stocks counted", bundle: entity.TradeBundle{Stocks: []entity.TradeStock{{Symbol: "ON2U"}}}, err: apperror.ErrIncorrectCount},
+		{name: "unknown real estate", bundle: entity.TradeBundle{RealEstates: []string{"r9"}}, err: apperror.ErrNotFoundTheRealEstate},
+		{name: "real estate the receiver owns", bundle: entity.TradeBundle{RealEstates: []string{"r2"}}, err: apperror.ErrAssetAlreadyOwned},
+		{name: "unknown business", bundle: entity.TradeBundle{Business: []entity.TradeBusiness{{ID: "b9"}}}, err: apperror.ErrNotFoundTheBusiness},
+		{name: "more business shares than the sender has", bundle: entity.TradeBundle{Business: []entity.TradeBusiness{{ID: "b1", Count: 6}}}, err: apperror.ErrNotEnoughAsset},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			from := entity.Player{
+				Cash: 1000,
+				Assets: entity.PlayerAssets{
+					Stocks:      []entity.CardStocks{{ID: "s1", Symbol: "ON2U", Price: 5, Count: 10, History: []entity.CardHistory{{Price: 5, Count: 10, Cost: 50}}}},
+					RealEstates: []entity.CardRealEstate{{ID: "r1"}, {ID: "r2"}},
+					Business:    []entity.CardBusiness{{ID: "b1", Count: 5, History: []entity.CardHistory{{Price: 100, Count: 5, Cost: 500}}}},
+				},
+			}
+			to := entity.Player{
+				Cash: 100,
+				Assets: entity.PlayerAssets{
+					Stocks:      []entity.CardStocks{{ID: "s2", Symbol: "MYT4U", Price: 10, Count: 3}},
+					RealEstates: []entity.CardRealEstate{{ID: "r2"}, {ID: "r3"}},
+				},
+			}
+
+			err := (&tradeService{}).moveBundle(test.bundle, &from, &to)
+
+			assert.Equal(t, test.err, err)
+
+			if test.err == nil {
+				assert.Equal(t, test.from, tradeHoldings(from))
+				assert.Equal(t, test.to, tradeHoldings(to))
+			}
+		})
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"github.com/mashingan/smapping"
 	"github.com/webjohny/cashflow-go/dto"
 	"github.com/webjohny/cashflow-go/entity"
+	"github.com/webjohny/cashflow-go/i18n"
 	"github.com/webjohny/cashflow-go/repository"
 	"log"
 )
@@ -18,7 +19,7 @@ type TransactionService interface {
 	GetRaceTransaction(player entity.Player, data dto.TransactionCardDTO) entity.Transaction
 	GetPlayerTransactions(playerId uint64) []entity.Transaction
 	GetRaceTransactions(raceId uint64) []entity.Transaction
-	GetRaceLogs(raceId uint64) []entity.RaceLog
+	GetRaceLogs(raceId uint64, language string) []entity.RaceLog
 }
 
 type transactionService struct {
@@ -67,6 +68,8 @@ func (service *transactionService) InsertTransaction(b dto.TransactionDTO) error
 	trx.PlayerID = &b.PlayerID
 	trx.TransactionType = entity.TransactionType.PLAYER
 	trx.Details = b.Details
+	trx.Code = b.Code
+	trx.Params = b.Params
 	trx.Data = &entity.TransactionData{
 		Color:           b.Color,
 		Username:        b.Username,
@@ -95,11 +98,17 @@ func (service *transactionService) GetRaceTransactions(raceId uint64) []entity.T
 	return service.transactionRepository.GetRaceTransactions(raceId)
 }
 
-func (service *transactionService) GetRaceLogs(raceId uint64) []entity.RaceLog {
+func (service *transactionService) GetRaceLogs(raceId uint64, language string) []entity.RaceLog {
 	logs := make([]entity.RaceLog, 0)
 	transactions := service.GetRaceTransactions(raceId)
 
 	for _, transaction := range transactions {
+		message := transaction.Details
+
+		if transaction.Code != "" {
+			message = i18n.Translate(language, transaction.Code, transaction.Params)
+		}
+
 		logs = append(logs, entity.RaceLog{
 			Username:        transaction.Data.Username,
 			PlayerId:        int(*transaction.PlayerID),
@@ -108,7 +117,9 @@ func (service *transactionService) GetRaceLogs(raceId uint64) []entity.RaceLog {
 			UpdatedCash:     transaction.Data.UpdatedCash,
 			CurrentCashFlow: transaction.Data.CurrentCashFlow,
 			UpdatedCashFlow: transaction.Data.UpdatedCashFlow,
-			Message:         transaction.Details,
+			Message:         message,
+			Code:            transaction.Code,
+			Params:          transaction.Params,
 		})
 	}
 
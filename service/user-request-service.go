@@ -1,11 +1,12 @@
 package service
 
 import (
+	logger "github.com/sirupsen/logrus"
+	"github.com/webjohny/cashflow-go/apperror"
 	"github.com/webjohny/cashflow-go/dto"
 	"github.com/webjohny/cashflow-go/entity"
 	"github.com/webjohny/cashflow-go/repository"
 	"github.com/webjohny/cashflow-go/storage"
-	"gopkg.in/errgo.v2/errors"
 	"log"
 )
 
@@ -13,18 +14,40 @@ type UserRequestService interface {
 	GetOneById(userRequestId uint64) entity.UserRequest
 	Update(userRequest entity.UserRequest) entity.UserRequest
 	Insert(userRequest entity.UserRequest) (error, entity.UserRequest)
-	HandleUserRequest(data dto.HandleUserRequestBodyDto) (error, entity.UserRequest)
+	Create(raceId uint64, userId uint64, data dto.UserRequestBodyDTO) (error, entity.UserRequest)
+	Cancel(raceId uint64, userId uint64, userRequestId uint64) error
+	HandleUserRequest(data dto.HandleUserRequestBodyDto, moderatorId uint64) (error, entity.UserRequest)
 	GetAllByRaceId(raceId uint64) []entity.UserRequest
+	GetAllByPlayer(raceId uint64, userId uint64) []entity.UserRequest
 }
 
+// userRequestHandler applies an approved request of its type to the player with the approved amount.
+type userRequestHandler func(race entity.Race, player entity.Player, request entity.UserRequest, amount int) error
+
 type userRequestService struct {
 	userRequestRepository repository.UserRequestRepository
+	raceService           RaceService
+	playerService         PlayerService
+	handlers              map[string]userRequestHandler
 }
 
-func NewUserRequestService(userReqRepo repository.UserRequestRepository) UserRequestService {
-	return &userRequestService{
+func NewUserRequestService(userReqRepo repository.UserRequestRepository, raceService RaceService, playerService PlayerService) UserRequestService {
+	service := &userRequestService{
 		userRequestRepository: userReqRepo,
+		raceService:           raceService,
+		playerService:         playerService,
+	}
+
+	service.handlers = map[string]userRequestHandler{
+		entity.UserRequestTypes.Salary:        service.approveSalary,
+		entity.UserRequestTypes.Baby:          service.approveCash,
+		entity.UserRequestTypes.Loan:          service.approveLoan,
+		entity.UserRequestTypes.LoanRepayment: service.approveLoanRepayment,
+		entity.UserRequestTypes.Charity:       service.approveCharity,
+		entity.UserRequestTypes.SellAsset:     service.approveSellAsset,
 	}
+
+	return service
 }
 
 func (service *userRequestService) Update(userRequest entity.UserRequest) entity.UserRequest {
@@ -35,11 +58,15 @@ func (service *userRequestService) Update(userRequest entity.UserRequest) entity
 	return updatedUserRequest
 }
 
+// Insert saves the request and lets the moderators of the race know about it.
 func (service *userRequestService) Insert(userRequest entity.UserRequest) (error, entity.UserRequest) {
 	err, insertedUserRequest := service.userRequestRepository.Insert(&userRequest)
 	if err != nil {
 		log.Fatalf("Failed to map: %v", err)
 	}
+
+	service.notifyModerators(insertedUserRequest, storage.MessageUserRequestReceived)
+
 	return nil, insertedUserRequest
 }
 
@@ -48,23 +75,254 @@ func (service *userRequestService) GetOneById(userRequestId uint64) entity.UserR
 }
 
 func (service *userRequestService) GetAllByRaceId(raceId uint64) []entity.UserRequest {
-	requests := service.userRequestRepository.All("race_id = ? AND status = 0", []interface{}{raceId})
-	return requests
+	return service.userRequestRepository.All("race_id = ? AND status = ?", []interface{}{raceId, entity.UserRequestStatuses.Pending})
+}
+
+func (service *userRequestService) GetAllByPlayer(raceId uint64, userId uint64) []entity.UserRequest {
+	return service.userRequestRepository.All("race_id = ? AND user_id = ?", []interface{}{raceId, userId})
+}
+
+func (service *userRequestService) Create(raceId uint64, userId uint64, data dto.UserRequestBodyDTO) (error, entity.UserRequest) {
+	logger.Info("UserRequestService.Create", map[string]interface{}{
+		"raceId": raceId,
+		"userId": userId,
+		"dto":    data,
+	})
+
+	err, race, player := service.raceService.GetActiveRaceAndPlayer(raceId, userId)
+
+	if err != nil {
+		return err, entity.UserRequest{}
+	}
+
+	if player.ID == 0 {
+		return apperror.ErrUndefinedPlayer, entity.UserRequest{}
+	}
+
+	if !race.Options.EnableManager {
+		return apperror.ErrManagerModeDisabled, entity.UserRequest{}
+	}
+
+	if _, ok := service.handlers[data.Type]; !ok {
+		return apperror.ErrUndefinedUserRequestType, entity.UserRequest{}
+	}
+
+	if data.Data == nil {
+		data.Data = make(map[string]interface{})
+	}
+
+	data.Data["last"] = player.LastPosition
+	data.Data["current"] = player.CurrentPosition
+
+	return service.Insert(entity.UserRequest{
+		Type:        data.Type,
+		CurrentCard: race.CurrentCard.ID,
+		RaceID:      raceId,
+		UserID:      userId,
+		Message:     data.Message,
+		Amount:      data.Amount,
+		Data:        data.Data,
+	})
 }
 
-func (service *userRequestService) HandleUserRequest(data dto.HandleUserRequestBodyDto) (error, entity.UserRequest) {
-	var err error
+// Cancel withdraws the player's own pending request.
+func (service *userRequestService) Cancel(raceId uint64, userId uint64, userRequestId uint64) error {
+	logger.Info("UserRequestService.Cancel", map[string]interface{}{
+		"raceId":        raceId,
+		"userId":        userId,
+		"userRequestId": userRequestId,
+	})
+
+	userRequest := service.GetOneById(userRequestId)
+
+	if userRequest.ID == 0 || userRequest.RaceID != raceId || userRequest.UserID != userId {
+		return apperror.ErrUndefinedUserRequest
+	}
+
+	if !userRequest.Transition(entity.UserRequestStatuses.Cancelled, userId) {
+		return apperror.ErrUserRequestIsNotPending
+	}
+
+	err, userRequest := service.userRequestRepository.Update(&userRequest)
+
+	if err != nil {
+		return err
+	}
+
+	service.notifyModerators(userRequest, storage.MessageUserRequestCancelled)
+
+	return nil
+}
+
+func (service *userRequestService) HandleUserRequest(data dto.HandleUserRequestBodyDto, moderatorId uint64) (error, entity.UserRequest) {
+	logger.Info("UserRequestService.HandleUserRequest", map[string]interface{}{
+		"moderatorId": moderatorId,
+		"dto":         data,
+	})
+
 	userRequest := service.GetOneById(data.UserRequestId)
 
-	if userRequest.ID > 0 && userRequest.Status == 0 {
-		userRequest.Status = data.Status
-		userRequest.RejectMessage = data.Message
-		return nil, service.Update(userRequest)
-	} else if userRequest.Status > 0 {
-		err = errors.New(storage.ErrorUserRequestHasBeenAlreadyApproved)
-	} else {
-		err = errors.New(storage.ErrorUndefinedUserRequest)
+	if userRequest.ID == 0 {
+		return apperror.ErrUndefinedUserRequest, entity.UserRequest{}
+	}
+
+	if userRequest.Status == entity.UserRequestStatuses.Approved {
+		return apperror.ErrUserRequestHasBeenAlreadyApproved, entity.UserRequest{}
+	}
+
+	if !userRequest.IsPending() {
+		return apperror.ErrUserRequestIsNotPending, entity.UserRequest{}
+	}
+
+	err, player := service.playerService.GetPlayerByUserIdAndRaceId(userRequest.RaceID, userRequest.UserID)
+
+	if err != nil {
+		return err, entity.UserRequest{}
+	}
+
+	switch data.Status {
+	case entity.UserRequestStatuses.Approved:
+		err = service.approve(&userRequest, player, data.Amount)
+	case entity.UserRequestStatuses.Rejected:
+		err = service.reject(&userRequest, player, data.Message)
+	default:
+		return apperror.ErrInvalidUserRequestStatus, entity.UserRequest{}
+	}
+
+	if err != nil {
+		return err, entity.UserRequest{}
 	}
 
-	return err, entity.UserRequest{}
+	userRequest.Transition(data.Status, moderatorId)
+
+	return nil, service.Update(userRequest)
+}
+
+func (service *userRequestService) approve(userRequest *entity.UserRequest, player entity.Player, amount int) error {
+	handler, ok := service.handlers[userRequest.Type]
+
+	if !ok {
+		return apperror.ErrUndefinedUserRequestType
+	}
+
+	if amount == 0 {
+		amount = userRequest.Amount
+	}
+
+	if amount < 0 || amount > userRequest.Amount {
+		return apperror.ErrWrongAmount
+	}
+
+	race := service.raceService.GetRaceByRaceId(userRequest.RaceID)
+
+	userRequest.ApprovedAmount = amount
+
+	player.SetNotificationWithParams(storage.MessageUserRequestApproved, entity.NotificationTypes.Success, map[string]interface{}{
+		"type":   userRequest.Type,
+		"amount": amount,
+	})
+
+	return handler(race, player, *userRequest, amount)
+}
+
+func (service *userRequestService) reject(userRequest *entity.UserRequest, player entity.Player, reason string) error {
+	userRequest.RejectMessage = reason
+
+	player.SetNotificationWithParams(storage.MessageUserRequestRejected, entity.NotificationTypes.Error, map[string]interface{}{
+		"type":   userRequest.Type,
+		"reason": reason,
+	})
+
+	err, _ := service.playerService.UpdatePlayer(&player)
+
+	return err
+}
+
+func (service *userRequestService) approveSalary(race entity.Race, player entity.Player, request entity.UserRequest, amount int) error {
+	player.RecordNetWorth(race.Market)
+
+	paydays := request.GetDataInt("paydays")
+
+	if paydays < 1 {
+		paydays = 1
+	}
+
+	return service.playerService.PayPaydays(player, amount, paydays, &dto.TransactionDTO{
+		CardType: request.Type,
+		Details:  request.Message,
+	})
+}
+
+func (service *userRequestService) approveCash(race entity.Race, player entity.Player, request entity.UserRequest, amount int) error {
+	return service.playerService.UpdateCash(&player, amount, &dto.TransactionDTO{
+		CardType: request.Type,
+		Details:  request.Message,
+	})
+}
+
+func (service *userRequestService) approveLoan(race entity.Race, player entity.Player, request entity.UserRequest, amount int) error {
+	return service.playerService.TakeLoan(player, amount, race.Options.LoanPolicy)
+}
+
+func (service *userRequestService) approveLoanRepayment(race entity.Race, player entity.Player, request entity.UserRequest, amount int) error {
+	liability := request.GetDataString("liability")
+
+	if liability == "" {
+		liability = "bankLoan"
+	}
+
+	return service.playerService.PayLoan(player, liability, amount, race.Options.LoanPolicy)
+}
+
+// approveCharity donates the amount, the extra dice come from the charity card the request was made on.
+func (service *userRequestService) approveCharity(race entity.Race, player entity.Player, request entity.UserRequest, amount int) error {
+	card := entity.CardCharity{
+		ID:      request.CurrentCard,
+		Heading: request.Message,
+		Cost:    amount,
+		Type:    entity.TransactionCardType.Charity,
+	}
+
+	if race.CurrentCard.ID == request.CurrentCard && race.CurrentCard.Name == entity.TransactionCardType.Charity {
+		card.Heading = race.CurrentCard.Heading
+		card.Limit = race.CurrentCard.Limit
+		card.ExtraDices = race.CurrentCard.ExtraDices
+	}
+
+	return service.playerService.Charity(card, player)
+}
+
+// approveSellAsset confirms the sale of the player's asset to the bank, the amount is what the player receives.
+func (service *userRequestService) approveSellAsset(race entity.Race, player entity.Player, request entity.UserRequest, amount int) error {
+	return service.playerService.SellAssetToBank(request.GetDataString("assetType"), request.GetDataString("assetId"), amount, player, dto.TransactionDTO{
+		CardType: request.Type,
+	})
+}
+
+func (service *userRequestService) notifyModerators(userRequest entity.UserRequest, message string) {
+	notifyUserRequestModerators(service.playerService, userRequest, message)
+}
+
+// notifyUserRequestModerators tells the moderators of the race what happened to the request.
+func notifyUserRequestModerators(playerService PlayerService, userRequest entity.UserRequest, message string) {
+	players := playerService.GetAllPlayersByRaceId(userRequest.RaceID)
+	username := ""
+
+	for _, player := range players {
+		if player.UserID == userRequest.UserID {
+			username = player.Username
+		}
+	}
+
+	for _, player := range playerService.GetModeratorsByRaceId(userRequest.RaceID) {
+		player.SetNotificationWithParams(message, entity.NotificationTypes.Info, map[string]interface{}{
+			"username": username,
+			"type":     userRequest.Type,
+			"amount":   userRequest.Amount,
+		})
+
+		if err, _ := playerService.UpdatePlayer(&player); err != nil {
+			logger.Error("UserRequestService.notifyModerators", err, player.ID)
+		}
+	}
 }
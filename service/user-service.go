@@ -7,11 +7,11 @@ import (
 	"os"
 	"path/filepath"
 
+	"github.com/google/uuid"
+	"github.com/mashingan/smapping"
 	"github.com/webjohny/cashflow-go/dto"
 	"github.com/webjohny/cashflow-go/entity"
 	"github.com/webjohny/cashflow-go/repository"
-	"github.com/google/uuid"
-	"github.com/mashingan/smapping"
 )
 
 type UserService interface {
@@ -1,53 +0,0 @@
-package storage
-
-const (
-	ErrorUndefinedUser                                = "error undefined user"
-	ErrorUndefinedUserRequest                         = "error undefined user request"
-	ErrorUserRequestHasBeenAlreadyApproved            = "error user request has been already approved"
-	ErrorUndefinedPlayer                              = "error undefined player"
-	ErrorUndefinedReceiverPlayer                      = "error undefined receiver player"
-	ErrorUndefinedGame                                = "error undefined game"
-	ErrorGameIsFull                                   = "error game is full"
-	ErrorGameIsStarted                                = "error game is started"
-	ErrorGameIsFinished                               = "error game is finished"
-	ErrorUndefinedLobby                               = "error undefined lobby"
-	ErrorCannotCreatedRace                            = "error cannot created race"
-	ErrorCannotTakeBigDeals                           = "error cannot take big deals"
-	ErrorForbidden                                    = "error forbidden"
-	ErrorProcessFailed                                = "error process failed"
-	ErrorIsNotValidCountValue                         = "error is not valid count value"
-	ErrorIsNotValidRealEstate                         = "error is not valid real estate"
-	ErrorIsNotValidBusiness                           = "error is not valid business"
-	ErrorIsNotValidOtherAssets                        = "error is not valid other assets"
-	ErrorInvalidTypeOfCard                            = "error invalid type of card"
-	ErrorWrongAmount                                  = "error wrong amount"
-	ErrorTooManyAssets                                = "error too many assets"
-	ErrorNotFoundAssets                               = "error not found assets"
-	ErrorNotEnoughMoney                               = "error not enough money"
-	ErrorNotFoundStocks                               = "error not found stocks"
-	ErrorNotEnoughStocks                              = "error not enough stocks"
-	ErrorUndefinedProfession                          = "error undefined profession"
-	ErrorInvalidCard                                  = "error invalid card"
-	ErrorDreamPlaceHasAlreadyTaken                    = "error dream place has already taken"
-	ErrorNotEnoughAsset                               = "error not enough asset"
-	ErrorPermissionDenied                             = "error permission denied"
-	ErrorTransactionDeclined                          = "error transaction declined"
-	ErrorWrongAmountForTakingLoan                     = "error wrong amount for taking loan"
-	ErrorWrongAmountForPayingLoan                     = "error wrong amount for paying loan"
-	ErrorCommonPassiveIncomeGreaterThanCashFlowOfCard = "error common passive income greater than cash flow of the card"
-	ErrorYouHaveNoProperties                          = "error you have no properties"
-	ErrorNotSuitableBuilding                          = "error not suitable building"
-	ErrorLimitedPartnership                           = "error limited partnership"
-	ErrorIncorrectCount                               = "error incorrect count"
-	ErrorCardsNotFound                                = "error cards not found"
-	ErrorProfessionsNotFound                          = "error professions not found"
-	ErrorNotFoundTheRealEstate                        = "error not found the real-estate"
-	ErrorNotFoundTheBusiness                          = "error not found the business"
-	ErrorTransactionAlreadyExists                     = "error transaction already exists"
-	ErrorItsNotYourMoveNow                            = "error its not your move now"
-	ErrorInsufficientPlayers                          = "error insufficient players"
-	ErrorMovingBigRaceDeclined                        = "error moving big race declined"
-	ErrorYouAreBankrupt                               = "error you are bankrupt"
-	ErrorForbiddenByOwner                             = "error forbidden by owner"
-	ErrorIfHadBeenInsurance                           = "error if had been insurance"
-)
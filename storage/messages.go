@@ -18,4 +18,43 @@ const (
 	MessageYouHaveTooManyBabies     = "you have too many babies"
 	YouHaveNoBabies                 = "you have no babies"
 	YouHaveHealthyInsurance         = "you have healthy insurance"
+	MessagePlayerBoughtBusiness     = "player bought a business"
+	MessagePlayerWentBankrupt       = "player went bankrupt"
+	MessageYouReceivedTradeOffer    = "you received a trade offer"
+	MessageTradeOfferAccepted       = "trade offer was accepted"
+	MessageTradeOfferDeclined       = "trade offer was declined"
+	MessageTradeOfferCountered      = "trade offer was countered"
+	MessageInsuranceClaimPaid       = "insurance claim was paid"
+	MessageInsuranceLapsed          = "insurance lapsed"
+	MessageUserRequestReceived      = "user request was received"
+	MessageUserRequestApproved      = "user request was approved"
+	MessageUserRequestRejected      = "user request was rejected"
+	MessageUserRequestExpired       = "user request expired"
+	MessageUserRequestCancelled     = "user request was cancelled"
+	MessageRollAwaitsConfirmation   = "roll awaits confirmation"
+	MessageRollRejected             = "roll was rejected"
+	MessageTeamDecisionProposed     = "team decision was proposed"
+	MessageTeamDecisionApproved     = "team decision was approved"
+	MessageTeamDecisionRejected     = "team decision was rejected"
+
+	TransactionSentMoneyToBank        = "transaction sent money to bank"
+	TransactionSentMoney              = "transaction sent money"
+	TransactionReceivedMoney          = "transaction received money"
+	TransactionSentStocks             = "transaction sent stocks"
+	TransactionReceivedStocks         = "transaction received stocks"
+	TransactionSentBusiness           = "transaction sent business"
+	TransactionReceivedBusiness       = "transaction received business"
+	TransactionTookLoan               = "transaction took loan"
+	TransactionPaidLoan               = "transaction paid loan"
+	TransactionTrade                  = "transaction trade"
+	TransactionAuctionPremiumPaid     = "transaction auction premium paid"
+	TransactionAuctionPremium         = "transaction auction premium received"
+	TransactionAuctionPremiumReturned = "transaction auction premium returned"
+	TransactionAuctionPremiumRefunded = "transaction auction premium refunded"
+	TransactionSaleProceedsShare      = "transaction sale proceeds share"
+	TransactionBoughtOutShare         = "transaction bought out share"
+	TransactionSoldShare              = "transaction sold share"
+	TransactionInsurancePremium       = "transaction insurance premium"
+	TransactionInsuranceClaim         = "transaction insurance claim"
+	TransactionModeratorAdjusted      = "transaction moderator adjusted balance"
 )